@@ -0,0 +1,164 @@
+package api
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"net"
+	"net/http"
+
+	authenticationv1 "k8s.io/api/authentication/v1"
+
+	"github.com/longhorn/longhorn-manager/audit"
+	"github.com/longhorn/longhorn-manager/types"
+)
+
+const auditAnonymousUser = "system:anonymous"
+
+type auditContextKey struct{}
+
+// auditContext is shared by the middlewares and handlers of a request to fill the audit event
+type auditContext struct {
+	user      *authenticationv1.UserInfo
+	forwarded bool
+}
+
+func getAuditContext(req *http.Request) *auditContext {
+	ac, _ := req.Context().Value(auditContextKey{}).(*auditContext)
+	return ac
+}
+
+// setAuditUser sets the user authenticated by the AuthMiddleware to the audit event of the request
+func setAuditUser(req *http.Request, user authenticationv1.UserInfo) {
+	if ac := getAuditContext(req); ac != nil {
+		ac.user = &user
+	}
+}
+
+// setAuditForwarded marks the request as forwarded to another node, which records the request instead
+func setAuditForwarded(req *http.Request) {
+	if ac := getAuditContext(req); ac != nil {
+		ac.forwarded = true
+	}
+}
+
+type auditResponseWriter struct {
+	http.ResponseWriter
+	statusCode int
+	// body keeps a copy of the response if it is not nil
+	body *bytes.Buffer
+}
+
+func (w *auditResponseWriter) WriteHeader(statusCode int) {
+	if w.statusCode == 0 {
+		w.statusCode = statusCode
+	}
+	w.ResponseWriter.WriteHeader(statusCode)
+}
+
+func (w *auditResponseWriter) Write(data []byte) (int, error) {
+	if w.statusCode == 0 {
+		w.statusCode = http.StatusOK
+	}
+	if w.body != nil {
+		w.body.Write(data)
+	}
+	return w.ResponseWriter.Write(data)
+}
+
+func (w *auditResponseWriter) Flush() {
+	if flusher, ok := w.ResponseWriter.(http.Flusher); ok {
+		flusher.Flush()
+	}
+}
+
+// AuditMiddleware records the mutating API requests with the user, the resource, the action and the result.
+// For the settings, the old and new values are recorded as well. The new value is taken from the updated setting in
+// the response, since the lister may not have observed the update yet.
+func AuditMiddleware(s *Server) func(http.Handler) http.Handler {
+	return func(next http.Handler) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			attrs := getAPIRequestAttributes(r)
+			if s.auditLogger == nil || attrs == nil || attrs.readOnly {
+				next.ServeHTTP(w, r)
+				return
+			}
+
+			isSetting := attrs.resource == "settings" && attrs.name != ""
+
+			var oldValue *string
+			if isSetting {
+				oldValue = s.getSettingValueForAudit(attrs.name)
+			}
+
+			ac := &auditContext{}
+			rw := &auditResponseWriter{ResponseWriter: w}
+			if isSetting {
+				rw.body = &bytes.Buffer{}
+			}
+			next.ServeHTTP(rw, r.WithContext(context.WithValue(r.Context(), auditContextKey{}, ac)))
+
+			if ac.forwarded {
+				return
+			}
+
+			event := &audit.Event{
+				Source:     audit.SourceAPI,
+				User:       auditAnonymousUser,
+				SourceIP:   getRemoteIP(r),
+				Resource:   attrs.resource,
+				Name:       attrs.name,
+				Action:     attrs.verb,
+				StatusCode: rw.statusCode,
+				Result:     audit.ResultSucceeded,
+			}
+			if event.StatusCode == 0 {
+				event.StatusCode = http.StatusOK
+			}
+			if event.StatusCode >= http.StatusBadRequest {
+				event.Result = audit.ResultFailed
+			}
+			if ac.user != nil {
+				event.User = ac.user.Username
+				event.Groups = ac.user.Groups
+			} else if username, _, ok := r.BasicAuth(); ok && username != "" {
+				// The username is not verified, so it is only recorded as claimed
+				event.ClaimedUser = username
+			}
+			if isSetting {
+				event.OldValue = oldValue
+				if event.Result == audit.ResultSucceeded {
+					event.NewValue = getSettingValueFromResponse(rw.body.Bytes())
+				}
+			}
+			s.auditLogger.Record(event)
+		})
+	}
+}
+
+func (s *Server) getSettingValueForAudit(name string) *string {
+	setting, err := s.m.GetSetting(types.SettingName(name))
+	if err != nil {
+		return nil
+	}
+	return &setting.Value
+}
+
+// getSettingValueFromResponse returns the value of the setting resource written to the response
+func getSettingValueFromResponse(body []byte) *string {
+	setting := struct {
+		Value *string `json:"value"`
+	}{}
+	if err := json.Unmarshal(body, &setting); err != nil {
+		return nil
+	}
+	return setting.Value
+}
+
+func getRemoteIP(req *http.Request) string {
+	host, _, err := net.SplitHostPort(req.RemoteAddr)
+	if err != nil {
+		return req.RemoteAddr
+	}
+	return host
+}
@@ -0,0 +1,362 @@
+package api
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"net/http"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/sirupsen/logrus"
+
+	authenticationv1 "k8s.io/api/authentication/v1"
+	authorizationv1 "k8s.io/api/authorization/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	clientset "k8s.io/client-go/kubernetes"
+
+	"github.com/longhorn/longhorn-manager/types"
+
+	longhorn "github.com/longhorn/longhorn-manager/k8s/pkg/apis/longhorn/v1beta2"
+)
+
+const (
+	// accessReviewCacheTTL is how long the TokenReview and SubjectAccessReview results are reused,
+	// to avoid sending reviews to the Kubernetes API server for every request of the same client.
+	accessReviewCacheTTL = 10 * time.Second
+	// accessReviewCacheMaxSize bounds the number of cached review results
+	accessReviewCacheMaxSize = 1024
+	accessReviewTimeout      = 10 * time.Second
+)
+
+// readOnlyActions are the POST actions that do not mutate any resource
+var readOnlyActions = map[string]struct{}{
+	"explainScheduling":  {},
+	"snapshotList":       {},
+	"snapshotGet":        {},
+	"snapshotCRList":     {},
+	"snapshotCRGet":      {},
+	"recurringJobList":   {},
+	"backupList":         {},
+	"backupListByVolume": {},
+	"backupGet":          {},
+}
+
+// apiRequestAttributes is the synthetic resource and verb of an API request used for the SubjectAccessReview
+type apiRequestAttributes struct {
+	resource string
+	name     string
+	verb     string
+	readOnly bool
+}
+
+// getAPIRequestAttributes maps an API request to the resource and verb, for example:
+//   - GET /v1/volumes is verb list on resource volumes
+//   - POST /v1/volumes/vol-1?action=detach is verb detach on resource volumes
+//   - PUT /v1/settings/name is verb update on resource settings
+//   - /v1/ws/volumes is verb watch on resource volumes
+//
+// It returns nil for the requests that do not access any resource, such as the API discovery and metrics.
+func getAPIRequestAttributes(req *http.Request) *apiRequestAttributes {
+	parts := strings.Split(strings.Trim(req.URL.Path, "/"), "/")
+	if len(parts) < 2 || parts[0] != "v1" {
+		return nil
+	}
+	switch parts[1] {
+	case "apiversions", "schemas":
+		return nil
+	case "ws":
+		// /v1/ws/[{period}/]<resources>[/{name}]
+		parts = parts[2:]
+		if len(parts) == 0 {
+			return nil
+		}
+		if len(parts) > 1 {
+			if _, err := time.ParseDuration(parts[0]); err == nil {
+				parts = parts[1:]
+			}
+		}
+		attrs := &apiRequestAttributes{
+			resource: parts[0],
+			verb:     "watch",
+			readOnly: true,
+		}
+		if len(parts) > 1 {
+			attrs.name = parts[1]
+		}
+		return attrs
+	}
+
+	attrs := &apiRequestAttributes{
+		resource: parts[1],
+	}
+	if len(parts) > 2 {
+		attrs.name = parts[2]
+	}
+
+	switch req.Method {
+	case http.MethodGet, http.MethodHead:
+		attrs.verb = "get"
+		if attrs.name == "" {
+			attrs.verb = "list"
+		}
+		attrs.readOnly = true
+	case http.MethodPost:
+		action := req.URL.Query().Get("action")
+		if action == "" {
+			attrs.verb = "create"
+		} else if _, ok := readOnlyActions[action]; ok {
+			attrs.verb = "get"
+			attrs.readOnly = true
+		} else {
+			attrs.verb = action
+		}
+	case http.MethodPut:
+		attrs.verb = "update"
+	case http.MethodDelete:
+		attrs.verb = "delete"
+	default:
+		attrs.verb = strings.ToLower(req.Method)
+	}
+	return attrs
+}
+
+// getBearerToken returns the bearer token of the request. The token can also be passed as the password of
+// the basic authentication, since the Longhorn API clients only support the basic authentication.
+func getBearerToken(req *http.Request) string {
+	authorization := req.Header.Get("Authorization")
+	if token, ok := strings.CutPrefix(authorization, "Bearer "); ok {
+		return strings.TrimSpace(token)
+	}
+	if _, password, ok := req.BasicAuth(); ok {
+		return password
+	}
+	return ""
+}
+
+type accessReviewResult struct {
+	allowed   bool
+	user      authenticationv1.UserInfo
+	reason    string
+	expiresAt time.Time
+}
+
+// AccessReviewer authenticates and authorizes the API requests by the TokenReview and SubjectAccessReview
+// of the Kubernetes API server
+type AccessReviewer struct {
+	kubeClient clientset.Interface
+	namespace  string
+
+	lock  sync.Mutex
+	cache map[string]*accessReviewResult
+}
+
+func NewAccessReviewer(kubeClient clientset.Interface, namespace string) *AccessReviewer {
+	return &AccessReviewer{
+		kubeClient: kubeClient,
+		namespace:  namespace,
+		cache:      map[string]*accessReviewResult{},
+	}
+}
+
+func (r *AccessReviewer) getCache(key string) *accessReviewResult {
+	r.lock.Lock()
+	defer r.lock.Unlock()
+
+	result, ok := r.cache[key]
+	if !ok || time.Now().After(result.expiresAt) {
+		return nil
+	}
+	return result
+}
+
+func (r *AccessReviewer) setCache(key string, result *accessReviewResult) {
+	r.lock.Lock()
+	defer r.lock.Unlock()
+
+	if len(r.cache) >= accessReviewCacheMaxSize {
+		now := time.Now()
+		for k, v := range r.cache {
+			if now.After(v.expiresAt) {
+				delete(r.cache, k)
+			}
+		}
+		if len(r.cache) >= accessReviewCacheMaxSize {
+			r.cache = map[string]*accessReviewResult{}
+		}
+	}
+	result.expiresAt = time.Now().Add(accessReviewCacheTTL)
+	r.cache[key] = result
+}
+
+// authenticate validates the bearer token by a TokenReview
+func (r *AccessReviewer) authenticate(token string) (*accessReviewResult, error) {
+	checksum := sha256.Sum256([]byte(token))
+	key := "token/" + hex.EncodeToString(checksum[:])
+	if result := r.getCache(key); result != nil {
+		return result, nil
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), accessReviewTimeout)
+	defer cancel()
+
+	review, err := r.kubeClient.AuthenticationV1().TokenReviews().Create(ctx, &authenticationv1.TokenReview{
+		Spec: authenticationv1.TokenReviewSpec{
+			Token: token,
+		},
+	}, metav1.CreateOptions{})
+	if err != nil {
+		return nil, err
+	}
+
+	result := &accessReviewResult{
+		allowed: review.Status.Authenticated,
+		user:    review.Status.User,
+		reason:  review.Status.Error,
+	}
+	r.setCache(key, result)
+	return result, nil
+}
+
+// authorize checks if the user is allowed to do the verb on the resource by a SubjectAccessReview
+func (r *AccessReviewer) authorize(user authenticationv1.UserInfo, attrs *apiRequestAttributes) (*accessReviewResult, error) {
+	key := fmt.Sprintf("access/%s/%s/%s/%s/%s", user.UID, user.Username, attrs.verb, attrs.resource, attrs.name)
+	if result := r.getCache(key); result != nil {
+		return result, nil
+	}
+
+	extra := map[string]authorizationv1.ExtraValue{}
+	for k, v := range user.Extra {
+		extra[k] = authorizationv1.ExtraValue(v)
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), accessReviewTimeout)
+	defer cancel()
+
+	review, err := r.kubeClient.AuthorizationV1().SubjectAccessReviews().Create(ctx, &authorizationv1.SubjectAccessReview{
+		Spec: authorizationv1.SubjectAccessReviewSpec{
+			ResourceAttributes: &authorizationv1.ResourceAttributes{
+				Namespace: r.namespace,
+				Group:     longhorn.SchemeGroupVersion.Group,
+				Resource:  attrs.resource,
+				Name:      attrs.name,
+				Verb:      attrs.verb,
+			},
+			User:   user.Username,
+			Groups: user.Groups,
+			UID:    user.UID,
+			Extra:  extra,
+		},
+	}, metav1.CreateOptions{})
+	if err != nil {
+		return nil, err
+	}
+
+	result := &accessReviewResult{
+		allowed: review.Status.Allowed && !review.Status.Denied,
+		user:    user,
+		reason:  review.Status.Reason,
+	}
+	r.setCache(key, result)
+	return result, nil
+}
+
+// isPublicAPIRequest returns true for the requests that are served without authentication in every mode,
+// which are the API discovery and the metrics.
+func isPublicAPIRequest(req *http.Request) bool {
+	path := strings.TrimSuffix(req.URL.Path, "/")
+	switch path {
+	case "", "/metrics", "/v1", "/v1/apiversions", "/v1/schemas":
+		return true
+	}
+	return strings.HasPrefix(path, "/v1/apiversions/") || strings.HasPrefix(path, "/v1/schemas/")
+}
+
+// AuthMiddleware authenticates and authorizes the API requests according to the api-access-mode setting.
+func AuthMiddleware(s *Server) func(http.Handler) http.Handler {
+	getAccessMode := func() (types.APIAccessMode, error) {
+		setting, err := s.m.GetSetting(types.SettingNameAPIAccessMode)
+		if err != nil {
+			return "", err
+		}
+		return types.APIAccessMode(setting.Value), nil
+	}
+	return newAuthMiddleware(getAccessMode, s.accessReviewer)
+}
+
+// newAuthMiddleware fails closed: the requests are rejected if the access mode cannot be read, or if a request
+// cannot be mapped to an API resource while the access is restricted.
+func newAuthMiddleware(getAccessMode func() (types.APIAccessMode, error), accessReviewer *AccessReviewer) func(http.Handler) http.Handler {
+	return func(next http.Handler) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			mode, err := getAccessMode()
+			if err != nil {
+				logrus.WithError(err).Warnf("Failed to get %v setting for API request", types.SettingNameAPIAccessMode)
+				writeAuthError(w, http.StatusServiceUnavailable, fmt.Sprintf("failed to get %v setting", types.SettingNameAPIAccessMode))
+				return
+			}
+
+			if mode == types.APIAccessModeUnrestricted || isPublicAPIRequest(r) {
+				next.ServeHTTP(w, r)
+				return
+			}
+
+			attrs := getAPIRequestAttributes(r)
+			if attrs == nil {
+				writeAuthError(w, http.StatusForbidden, fmt.Sprintf("request %v %v is not mapped to an API resource", r.Method, r.URL.Path))
+				return
+			}
+
+			token := getBearerToken(r)
+			if token == "" {
+				if mode == types.APIAccessModeReadOnly && attrs.readOnly {
+					next.ServeHTTP(w, r)
+					return
+				}
+				writeAuthError(w, http.StatusUnauthorized, "bearer token is required")
+				return
+			}
+
+			if accessReviewer == nil {
+				writeAuthError(w, http.StatusInternalServerError, "access reviewer is not available")
+				return
+			}
+
+			authn, err := accessReviewer.authenticate(token)
+			if err != nil {
+				logrus.WithError(err).Warn("Failed to review the bearer token of API request")
+				writeAuthError(w, http.StatusInternalServerError, "failed to review the bearer token")
+				return
+			}
+			if !authn.allowed {
+				writeAuthError(w, http.StatusUnauthorized, fmt.Sprintf("invalid bearer token: %v", authn.reason))
+				return
+			}
+
+			setAuditUser(r, authn.user)
+
+			authz, err := accessReviewer.authorize(authn.user, attrs)
+			if err != nil {
+				logrus.WithError(err).Warnf("Failed to review the access of user %v to API request", authn.user.Username)
+				writeAuthError(w, http.StatusInternalServerError, "failed to review the access of the request")
+				return
+			}
+			if !authz.allowed {
+				writeAuthError(w, http.StatusForbidden, fmt.Sprintf("user %v cannot %v resource %v in API group %v",
+					authn.user.Username, attrs.verb, attrs.resource, longhorn.SchemeGroupVersion.Group))
+				return
+			}
+
+			next.ServeHTTP(w, r)
+		})
+	}
+}
+
+func writeAuthError(w http.ResponseWriter, statusCode int, message string) {
+	if statusCode == http.StatusUnauthorized {
+		w.Header().Set("WWW-Authenticate", `Bearer realm="longhorn"`)
+	}
+	http.Error(w, message, statusCode)
+}
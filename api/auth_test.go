@@ -0,0 +1,287 @@
+package api
+
+import (
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/client-go/kubernetes/fake"
+
+	authenticationv1 "k8s.io/api/authentication/v1"
+	authorizationv1 "k8s.io/api/authorization/v1"
+	k8stesting "k8s.io/client-go/testing"
+
+	"github.com/longhorn/longhorn-manager/types"
+)
+
+func TestGetAPIRequestAttributes(t *testing.T) {
+	tests := []struct {
+		name     string
+		method   string
+		target   string
+		expected *apiRequestAttributes
+	}{
+		{
+			name:     "api discovery",
+			method:   http.MethodGet,
+			target:   "/v1/schemas",
+			expected: nil,
+		},
+		{
+			name:     "non api path",
+			method:   http.MethodGet,
+			target:   "/metrics",
+			expected: nil,
+		},
+		{
+			name:     "list volumes",
+			method:   http.MethodGet,
+			target:   "/v1/volumes",
+			expected: &apiRequestAttributes{resource: "volumes", verb: "list", readOnly: true},
+		},
+		{
+			name:     "get volume",
+			method:   http.MethodGet,
+			target:   "/v1/volumes/vol-1",
+			expected: &apiRequestAttributes{resource: "volumes", name: "vol-1", verb: "get", readOnly: true},
+		},
+		{
+			name:     "create volume",
+			method:   http.MethodPost,
+			target:   "/v1/volumes",
+			expected: &apiRequestAttributes{resource: "volumes", verb: "create"},
+		},
+		{
+			name:     "detach volume",
+			method:   http.MethodPost,
+			target:   "/v1/volumes/vol-1?action=detach",
+			expected: &apiRequestAttributes{resource: "volumes", name: "vol-1", verb: "detach"},
+		},
+		{
+			name:     "list snapshots of volume",
+			method:   http.MethodPost,
+			target:   "/v1/volumes/vol-1?action=snapshotList",
+			expected: &apiRequestAttributes{resource: "volumes", name: "vol-1", verb: "get", readOnly: true},
+		},
+		{
+			name:     "update setting",
+			method:   http.MethodPut,
+			target:   "/v1/settings/api-access-mode",
+			expected: &apiRequestAttributes{resource: "settings", name: "api-access-mode", verb: "update"},
+		},
+		{
+			name:     "delete backup volume",
+			method:   http.MethodDelete,
+			target:   "/v1/backupvolumes/bv-1",
+			expected: &apiRequestAttributes{resource: "backupvolumes", name: "bv-1", verb: "delete"},
+		},
+		{
+			name:     "watch volumes",
+			method:   http.MethodGet,
+			target:   "/v1/ws/1s/volumes",
+			expected: &apiRequestAttributes{resource: "volumes", verb: "watch", readOnly: true},
+		},
+		{
+			name:     "watch volume",
+			method:   http.MethodGet,
+			target:   "/v1/ws/volumes/vol-1",
+			expected: &apiRequestAttributes{resource: "volumes", name: "vol-1", verb: "watch", readOnly: true},
+		},
+		{
+			name:     "watch volume with period",
+			method:   http.MethodGet,
+			target:   "/v1/ws/1s/volumes/vol-1",
+			expected: &apiRequestAttributes{resource: "volumes", name: "vol-1", verb: "watch", readOnly: true},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			req := httptest.NewRequest(tt.method, tt.target, nil)
+			assert.Equal(t, tt.expected, getAPIRequestAttributes(req))
+		})
+	}
+}
+
+func TestGetBearerToken(t *testing.T) {
+	req := httptest.NewRequest(http.MethodGet, "/v1/volumes", nil)
+	assert.Equal(t, "", getBearerToken(req))
+
+	req.Header.Set("Authorization", "Bearer token-1")
+	assert.Equal(t, "token-1", getBearerToken(req))
+
+	req = httptest.NewRequest(http.MethodGet, "/v1/volumes", nil)
+	req.SetBasicAuth("", "token-2")
+	assert.Equal(t, "token-2", getBearerToken(req))
+}
+
+func newTestAccessReviewer() *AccessReviewer {
+	kubeClient := fake.NewSimpleClientset()
+	kubeClient.PrependReactor("create", "tokenreviews", func(action k8stesting.Action) (bool, runtime.Object, error) {
+		review := action.(k8stesting.CreateAction).GetObject().(*authenticationv1.TokenReview)
+		switch review.Spec.Token {
+		case "admin-token":
+			review.Status.Authenticated = true
+			review.Status.User = authenticationv1.UserInfo{Username: "admin", UID: "1"}
+		case "viewer-token":
+			review.Status.Authenticated = true
+			review.Status.User = authenticationv1.UserInfo{Username: "viewer", UID: "2"}
+		default:
+			review.Status.Error = "token is not valid"
+		}
+		return true, review, nil
+	})
+	kubeClient.PrependReactor("create", "subjectaccessreviews", func(action k8stesting.Action) (bool, runtime.Object, error) {
+		review := action.(k8stesting.CreateAction).GetObject().(*authorizationv1.SubjectAccessReview)
+		switch review.Spec.User {
+		case "admin":
+			review.Status.Allowed = true
+		case "viewer":
+			verb := review.Spec.ResourceAttributes.Verb
+			review.Status.Allowed = verb == "get" || verb == "list" || verb == "watch"
+		}
+		return true, review, nil
+	})
+	return NewAccessReviewer(kubeClient, "longhorn-system")
+}
+
+func TestAuthMiddleware(t *testing.T) {
+	tests := []struct {
+		name           string
+		mode           types.APIAccessMode
+		modeErr        error
+		method         string
+		target         string
+		token          string
+		expectedStatus int
+	}{
+		{
+			name:           "unrestricted mode allows anonymous writes",
+			mode:           types.APIAccessModeUnrestricted,
+			method:         http.MethodPost,
+			target:         "/v1/volumes/vol-1?action=detach",
+			expectedStatus: http.StatusOK,
+		},
+		{
+			name:           "failing to get the access mode rejects the request",
+			modeErr:        fmt.Errorf("setting is not found"),
+			method:         http.MethodGet,
+			target:         "/v1/volumes",
+			expectedStatus: http.StatusServiceUnavailable,
+		},
+		{
+			name:           "read-only mode allows anonymous reads",
+			mode:           types.APIAccessModeReadOnly,
+			method:         http.MethodGet,
+			target:         "/v1/volumes",
+			expectedStatus: http.StatusOK,
+		},
+		{
+			name:           "read-only mode allows anonymous read-only actions",
+			mode:           types.APIAccessModeReadOnly,
+			method:         http.MethodPost,
+			target:         "/v1/volumes/vol-1?action=snapshotList",
+			expectedStatus: http.StatusOK,
+		},
+		{
+			name:           "read-only mode rejects anonymous writes",
+			mode:           types.APIAccessModeReadOnly,
+			method:         http.MethodPost,
+			target:         "/v1/volumes/vol-1?action=detach",
+			expectedStatus: http.StatusUnauthorized,
+		},
+		{
+			name:           "read-only mode rejects writes of a user without access",
+			mode:           types.APIAccessModeReadOnly,
+			method:         http.MethodPost,
+			target:         "/v1/volumes/vol-1?action=detach",
+			token:          "viewer-token",
+			expectedStatus: http.StatusForbidden,
+		},
+		{
+			name:           "read-only mode allows writes of a user with access",
+			mode:           types.APIAccessModeReadOnly,
+			method:         http.MethodPost,
+			target:         "/v1/volumes/vol-1?action=detach",
+			token:          "admin-token",
+			expectedStatus: http.StatusOK,
+		},
+		{
+			name:           "authenticated mode rejects anonymous reads",
+			mode:           types.APIAccessModeAuthenticated,
+			method:         http.MethodGet,
+			target:         "/v1/volumes",
+			expectedStatus: http.StatusUnauthorized,
+		},
+		{
+			name:           "authenticated mode rejects an invalid token",
+			mode:           types.APIAccessModeAuthenticated,
+			method:         http.MethodGet,
+			target:         "/v1/volumes",
+			token:          "invalid-token",
+			expectedStatus: http.StatusUnauthorized,
+		},
+		{
+			name:           "authenticated mode allows reads of a user with access",
+			mode:           types.APIAccessModeAuthenticated,
+			method:         http.MethodGet,
+			target:         "/v1/volumes",
+			token:          "viewer-token",
+			expectedStatus: http.StatusOK,
+		},
+		{
+			name:           "authenticated mode rejects deletes of a user without access",
+			mode:           types.APIAccessModeAuthenticated,
+			method:         http.MethodDelete,
+			target:         "/v1/volumes/vol-1",
+			token:          "viewer-token",
+			expectedStatus: http.StatusForbidden,
+		},
+		{
+			name:           "authenticated mode allows anonymous api discovery",
+			mode:           types.APIAccessModeAuthenticated,
+			method:         http.MethodGet,
+			target:         "/v1/schemas",
+			expectedStatus: http.StatusOK,
+		},
+		{
+			name:           "authenticated mode rejects unmapped requests",
+			mode:           types.APIAccessModeAuthenticated,
+			method:         http.MethodGet,
+			target:         "/v2/volumes",
+			token:          "admin-token",
+			expectedStatus: http.StatusForbidden,
+		},
+		{
+			name:           "read-only mode rejects unmapped requests",
+			mode:           types.APIAccessModeReadOnly,
+			method:         http.MethodGet,
+			target:         "/debug",
+			expectedStatus: http.StatusForbidden,
+		},
+	}
+
+	accessReviewer := newTestAccessReviewer()
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			getAccessMode := func() (types.APIAccessMode, error) {
+				return tt.mode, tt.modeErr
+			}
+			handler := newAuthMiddleware(getAccessMode, accessReviewer)(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				w.WriteHeader(http.StatusOK)
+			}))
+
+			req := httptest.NewRequest(tt.method, tt.target, nil)
+			if tt.token != "" {
+				req.Header.Set("Authorization", "Bearer "+tt.token)
+			}
+			rec := httptest.NewRecorder()
+			handler.ServeHTTP(rec, req)
+			assert.Equal(t, tt.expectedStatus, rec.Code)
+		})
+	}
+}
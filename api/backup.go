@@ -79,10 +79,21 @@ func newBackupTarget(input BackupTarget) (*longhorn.BackupTargetSpec, error) {
 		return nil, errors.Wrapf(err, "invalid backup target polling interval '%s'", input.PollInterval)
 	}
 
+	var retentionLockPeriod int64
+	if input.RetentionLockPeriod != "" {
+		retentionLockPeriod, err = strconv.ParseInt(input.RetentionLockPeriod, 10, 64)
+		if err != nil {
+			return nil, errors.Wrapf(err, "invalid backup target retention lock period '%s'", input.RetentionLockPeriod)
+		}
+	}
+
 	return &longhorn.BackupTargetSpec{
-		BackupTargetURL:  input.BackupTargetURL,
-		CredentialSecret: input.CredentialSecret,
-		PollInterval:     metav1.Duration{Duration: time.Duration(pollInterval) * time.Second}}, nil
+		BackupTargetURL:       input.BackupTargetURL,
+		CredentialSecret:      input.CredentialSecret,
+		PollInterval:          metav1.Duration{Duration: time.Duration(pollInterval) * time.Second},
+		RetentionLockPeriod:   metav1.Duration{Duration: time.Duration(retentionLockPeriod) * time.Second},
+		ReplicationTargetName: input.ReplicationTargetName,
+		BackupWindow:          input.BackupWindow}, nil
 }
 
 func (s *Server) BackupTargetUpdate(rw http.ResponseWriter, req *http.Request) error {
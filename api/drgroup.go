@@ -0,0 +1,110 @@
+package api
+
+import (
+	"net/http"
+
+	"github.com/cockroachdb/errors"
+	"github.com/gorilla/mux"
+
+	"github.com/rancher/go-rancher/api"
+	"github.com/rancher/go-rancher/client"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	longhorn "github.com/longhorn/longhorn-manager/k8s/pkg/apis/longhorn/v1beta2"
+)
+
+func (s *Server) DRGroupCreate(w http.ResponseWriter, req *http.Request) error {
+	var input DRGroupInput
+
+	apiContext := api.GetApiContext(req)
+	if err := apiContext.Read(&input); err != nil {
+		return err
+	}
+
+	obj := &longhorn.DRGroup{
+		ObjectMeta: metav1.ObjectMeta{
+			Name: input.Name,
+		},
+		Spec: longhorn.DRGroupSpec{
+			Role:             input.Role,
+			VolumeSelector:   input.VolumeSelector,
+			BackupTargetName: input.BackupTargetName,
+			NumberOfReplicas: input.NumberOfReplicas,
+		},
+	}
+	drGroup, err := s.m.CreateDRGroup(obj)
+	if err != nil {
+		return errors.Wrapf(err, "failed to create DRGroup %v", input.Name)
+	}
+
+	apiContext.Write(toDRGroupResource(drGroup, apiContext))
+	return nil
+}
+
+func (s *Server) DRGroupDelete(w http.ResponseWriter, req *http.Request) error {
+	name := mux.Vars(req)["name"]
+
+	if err := s.m.DeleteDRGroup(name); err != nil {
+		return errors.Wrapf(err, "failed to delete DRGroup %v", name)
+	}
+	return nil
+}
+
+func (s *Server) DRGroupGet(w http.ResponseWriter, req *http.Request) error {
+	apiContext := api.GetApiContext(req)
+
+	name := mux.Vars(req)["name"]
+
+	drGroup, err := s.m.GetDRGroup(name)
+	if err != nil {
+		return errors.Wrapf(err, "failed to get DRGroup %v", name)
+	}
+	apiContext.Write(toDRGroupResource(drGroup, apiContext))
+	return nil
+}
+
+func (s *Server) DRGroupList(w http.ResponseWriter, req *http.Request) error {
+	apiContext := api.GetApiContext(req)
+
+	drGroups, err := s.drGroupList(apiContext)
+	if err != nil {
+		return err
+	}
+	apiContext.Write(drGroups)
+	return nil
+}
+
+func (s *Server) drGroupList(apiContext *api.ApiContext) (*client.GenericCollection, error) {
+	drGroups, err := s.m.ListDRGroupsSorted()
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to list DRGroups")
+	}
+	return toDRGroupCollection(drGroups, apiContext), nil
+}
+
+func (s *Server) DRGroupFailover(w http.ResponseWriter, req *http.Request) error {
+	apiContext := api.GetApiContext(req)
+
+	name := mux.Vars(req)["name"]
+
+	drGroup, err := s.m.FailoverDRGroup(name)
+	if err != nil {
+		return errors.Wrapf(err, "failed to fail over DRGroup %v", name)
+	}
+	apiContext.Write(toDRGroupResource(drGroup, apiContext))
+	return nil
+}
+
+func (s *Server) DRGroupFailback(w http.ResponseWriter, req *http.Request) error {
+	apiContext := api.GetApiContext(req)
+
+	name := mux.Vars(req)["name"]
+
+	drGroup, err := s.m.FailbackDRGroup(name)
+	if err != nil {
+		return errors.Wrapf(err, "failed to fail back DRGroup %v", name)
+	}
+	apiContext.Write(toDRGroupResource(drGroup, apiContext))
+	return nil
+}
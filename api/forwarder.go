@@ -44,6 +44,21 @@ func OwnerIDFromVolume(m *manager.VolumeManager) func(req *http.Request) (string
 	}
 }
 
+// CurrentNodeIDFromVolume returns the node that the volume is attached to
+func CurrentNodeIDFromVolume(m *manager.VolumeManager) func(req *http.Request) (string, error) {
+	return func(req *http.Request) (string, error) {
+		name := mux.Vars(req)["name"]
+		volume, err := m.Get(name)
+		if err != nil {
+			return "", errors.Wrapf(err, "failed to get volume '%s'", name)
+		}
+		if volume == nil {
+			return "", nil
+		}
+		return volume.Status.CurrentNodeID, nil
+	}
+}
+
 func OwnerIDFromBackupTarget(m *manager.VolumeManager) func(req *http.Request) (string, error) {
 	return func(req *http.Request) (string, error) {
 		backupTargetName := mux.Vars(req)["backupTargetName"]
@@ -125,6 +140,7 @@ func (f *Fwd) Handler(proxyHandler ProxyRequestHandler, parametersGetFunc Parame
 			}
 		}
 		if requireProxy {
+			setAuditForwarded(req)
 			f.proxy.ServeHTTP(w, req)
 			return nil
 		}
@@ -2,6 +2,7 @@ package api
 
 import (
 	"fmt"
+	"sort"
 	"strconv"
 	"time"
 
@@ -12,10 +13,12 @@ import (
 
 	corev1 "k8s.io/api/core/v1"
 
+	"github.com/longhorn/longhorn-manager/audit"
 	"github.com/longhorn/longhorn-manager/controller"
 	"github.com/longhorn/longhorn-manager/datastore"
 	"github.com/longhorn/longhorn-manager/engineapi"
 	"github.com/longhorn/longhorn-manager/manager"
+	"github.com/longhorn/longhorn-manager/scheduler"
 	"github.com/longhorn/longhorn-manager/types"
 	"github.com/longhorn/longhorn-manager/util"
 
@@ -29,48 +32,57 @@ type Empty struct {
 type Volume struct {
 	client.Resource
 
-	Name                            string                                 `json:"name"`
-	Size                            string                                 `json:"size"`
-	Frontend                        longhorn.VolumeFrontend                `json:"frontend"`
-	DisableFrontend                 bool                                   `json:"disableFrontend"`
-	FromBackup                      string                                 `json:"fromBackup"`
-	RestoreVolumeRecurringJob       longhorn.RestoreVolumeRecurringJobType `json:"restoreVolumeRecurringJob"`
-	DataSource                      longhorn.VolumeDataSource              `json:"dataSource"`
-	CloneMode                       longhorn.CloneMode                     `json:"cloneMode"`
-	DataLocality                    longhorn.DataLocality                  `json:"dataLocality"`
-	StaleReplicaTimeout             int                                    `json:"staleReplicaTimeout"`
-	State                           longhorn.VolumeState                   `json:"state"`
-	Robustness                      longhorn.VolumeRobustness              `json:"robustness"`
-	Image                           string                                 `json:"image"`
-	CurrentImage                    string                                 `json:"currentImage"`
-	BackingImage                    string                                 `json:"backingImage"`
-	Created                         string                                 `json:"created"`
-	LastBackup                      string                                 `json:"lastBackup"`
-	LastBackupAt                    string                                 `json:"lastBackupAt"`
-	LastAttachedBy                  string                                 `json:"lastAttachedBy"`
-	Standby                         bool                                   `json:"standby"`
-	RestoreRequired                 bool                                   `json:"restoreRequired"`
-	RestoreInitiated                bool                                   `json:"restoreInitiated"`
-	RevisionCounterDisabled         bool                                   `json:"revisionCounterDisabled"`
-	SnapshotDataIntegrity           longhorn.SnapshotDataIntegrity         `json:"snapshotDataIntegrity"`
-	UnmapMarkSnapChainRemoved       longhorn.UnmapMarkSnapChainRemoved     `json:"unmapMarkSnapChainRemoved"`
-	BackupCompressionMethod         longhorn.BackupCompressionMethod       `json:"backupCompressionMethod"`
-	BackupBlockSize                 string                                 `json:"backupBlockSize"`
-	ReplicaSoftAntiAffinity         longhorn.ReplicaSoftAntiAffinity       `json:"replicaSoftAntiAffinity"`
-	ReplicaZoneSoftAntiAffinity     longhorn.ReplicaZoneSoftAntiAffinity   `json:"replicaZoneSoftAntiAffinity"`
-	ReplicaDiskSoftAntiAffinity     longhorn.ReplicaDiskSoftAntiAffinity   `json:"replicaDiskSoftAntiAffinity"`
-	DataEngine                      longhorn.DataEngineType                `json:"dataEngine"`
-	SnapshotMaxCount                int                                    `json:"snapshotMaxCount"`
-	SnapshotMaxSize                 string                                 `json:"snapshotMaxSize"`
-	ReplicaRebuildingBandwidthLimit int64                                  `json:"replicaRebuildingBandwidthLimit"`
-	UblkQueueDepth                  int                                    `json:"ublkQueueDepth"`
-	UblkNumberOfQueue               int                                    `json:"ublkNumberOfQueue"`
-	FreezeFilesystemForSnapshot     longhorn.FreezeFilesystemForSnapshot   `json:"freezeFilesystemForSnapshot"`
-	BackupTargetName                string                                 `json:"backupTargetName"`
-
-	DiskSelector         []string                      `json:"diskSelector"`
-	NodeSelector         []string                      `json:"nodeSelector"`
-	RecurringJobSelector []longhorn.VolumeRecurringJob `json:"recurringJobSelector"`
+	Name                                string                                 `json:"name"`
+	Size                                string                                 `json:"size"`
+	Frontend                            longhorn.VolumeFrontend                `json:"frontend"`
+	DisableFrontend                     bool                                   `json:"disableFrontend"`
+	FromBackup                          string                                 `json:"fromBackup"`
+	RestoreVolumeRecurringJob           longhorn.RestoreVolumeRecurringJobType `json:"restoreVolumeRecurringJob"`
+	DataSource                          longhorn.VolumeDataSource              `json:"dataSource"`
+	CloneMode                           longhorn.CloneMode                     `json:"cloneMode"`
+	DataLocality                        longhorn.DataLocality                  `json:"dataLocality"`
+	StaleReplicaTimeout                 int                                    `json:"staleReplicaTimeout"`
+	State                               longhorn.VolumeState                   `json:"state"`
+	Robustness                          longhorn.VolumeRobustness              `json:"robustness"`
+	Image                               string                                 `json:"image"`
+	CurrentImage                        string                                 `json:"currentImage"`
+	BackingImage                        string                                 `json:"backingImage"`
+	Created                             string                                 `json:"created"`
+	LastBackup                          string                                 `json:"lastBackup"`
+	LastBackupAt                        string                                 `json:"lastBackupAt"`
+	SnapshotChecksumCheckRequestedAt    string                                 `json:"snapshotChecksumCheckRequestedAt"`
+	LastSnapshotChecksumCheckCompleteAt string                                 `json:"lastSnapshotChecksumCheckCompleteAt"`
+	LastRestoredBackup                  string                                 `json:"lastRestoredBackup"`
+	LastRestoredBackupAt                string                                 `json:"lastRestoredBackupAt"`
+	LastRestoreCompletedAt              string                                 `json:"lastRestoreCompletedAt"`
+	PendingRestoreBackupCount           int                                    `json:"pendingRestoreBackupCount"`
+	LastAttachedBy                      string                                 `json:"lastAttachedBy"`
+	Standby                             bool                                   `json:"standby"`
+	RestoreRequired                     bool                                   `json:"restoreRequired"`
+	RestoreInitiated                    bool                                   `json:"restoreInitiated"`
+	RevisionCounterDisabled             bool                                   `json:"revisionCounterDisabled"`
+	SnapshotDataIntegrity               longhorn.SnapshotDataIntegrity         `json:"snapshotDataIntegrity"`
+	UnmapMarkSnapChainRemoved           longhorn.UnmapMarkSnapChainRemoved     `json:"unmapMarkSnapChainRemoved"`
+	BackupCompressionMethod             longhorn.BackupCompressionMethod       `json:"backupCompressionMethod"`
+	BackupBlockSize                     string                                 `json:"backupBlockSize"`
+	ReplicaSoftAntiAffinity             longhorn.ReplicaSoftAntiAffinity       `json:"replicaSoftAntiAffinity"`
+	ReplicaZoneSoftAntiAffinity         longhorn.ReplicaZoneSoftAntiAffinity   `json:"replicaZoneSoftAntiAffinity"`
+	ReplicaDiskSoftAntiAffinity         longhorn.ReplicaDiskSoftAntiAffinity   `json:"replicaDiskSoftAntiAffinity"`
+	ReplicaSchedulingPolicy             longhorn.ReplicaSchedulingPolicy       `json:"replicaSchedulingPolicy"`
+	DataEngine                          longhorn.DataEngineType                `json:"dataEngine"`
+	SnapshotMaxCount                    int                                    `json:"snapshotMaxCount"`
+	SnapshotMaxSize                     string                                 `json:"snapshotMaxSize"`
+	ReplicaRebuildingBandwidthLimit     int64                                  `json:"replicaRebuildingBandwidthLimit"`
+	UblkQueueDepth                      int                                    `json:"ublkQueueDepth"`
+	UblkNumberOfQueue                   int                                    `json:"ublkNumberOfQueue"`
+	FreezeFilesystemForSnapshot         longhorn.FreezeFilesystemForSnapshot   `json:"freezeFilesystemForSnapshot"`
+	BackupTargetName                    string                                 `json:"backupTargetName"`
+
+	DiskSelector          []string                                   `json:"diskSelector"`
+	DiskTiers             []string                                   `json:"diskTiers"`
+	NodeSelector          []string                                   `json:"nodeSelector"`
+	RecurringJobSelector  []longhorn.VolumeRecurringJob              `json:"recurringJobSelector"`
+	ReplicaTopologySpread []longhorn.ReplicaTopologySpreadConstraint `json:"replicaTopologySpread"`
 
 	NumberOfReplicas           int                         `json:"numberOfReplicas"`
 	ReplicaAutoBalance         longhorn.ReplicaAutoBalance `json:"replicaAutoBalance"`
@@ -82,6 +94,7 @@ type Volume struct {
 	Ready            bool                          `json:"ready"`
 
 	AccessMode        longhorn.AccessMode              `json:"accessMode"`
+	NFSMountOptions   longhorn.NFSMountOptions         `json:"nfsMountOptions"`
 	ShareEndpoint     string                           `json:"shareEndpoint"`
 	ShareState        longhorn.ShareManagerState       `json:"shareState"`
 	OfflineRebuilding longhorn.VolumeOfflineRebuilding `json:"offlineRebuilding"`
@@ -183,6 +196,10 @@ type Backup struct {
 	ReUploadedDataSize     string               `json:"reUploadedDataSize"`
 	BackupTargetName       string               `json:"backupTargetName"`
 	BlockSize              string               `json:"blockSize"`
+	RetentionLockedUntil   string               `json:"retentionLockedUntil"`
+	LastVerifiedAt         string               `json:"lastVerifiedAt"`
+	VerificationResult     string               `json:"verificationResult"`
+	VerificationMessage    string               `json:"verificationMessage"`
 }
 
 type BackupBackingImage struct {
@@ -237,12 +254,15 @@ type Controller struct {
 type Replica struct {
 	Instance
 
-	DiskID     string `json:"diskID"`
-	DiskPath   string `json:"diskPath"`
-	DataPath   string `json:"dataPath"`
-	Mode       string `json:"mode"`
-	FailedAt   string `json:"failedAt"`
-	DataEngine string `json:"dataEngine"`
+	DiskID                        string `json:"diskID"`
+	DiskPath                      string `json:"diskPath"`
+	DataPath                      string `json:"dataPath"`
+	Mode                          string `json:"mode"`
+	FailedAt                      string `json:"failedAt"`
+	DataEngine                    string `json:"dataEngine"`
+	LastSnapshotChecksumCheckedAt string `json:"lastSnapshotChecksumCheckedAt"`
+	MismatchedSnapshotChecksums   int64  `json:"mismatchedSnapshotChecksums"`
+	MismatchedBlocks              int64  `json:"mismatchedBlocks"`
 }
 
 type Attachment struct {
@@ -414,6 +434,10 @@ type UpdateReplicaDiskSoftAntiAffinityInput struct {
 	ReplicaDiskSoftAntiAffinity string `json:"replicaDiskSoftAntiAffinity"`
 }
 
+type UpdateReplicaSchedulingPolicyInput struct {
+	ReplicaSchedulingPolicy string `json:"replicaSchedulingPolicy"`
+}
+
 type UpdateSnapshotMaxCount struct {
 	SnapshotMaxCount int `json:"snapshotMaxCount"`
 }
@@ -471,17 +495,19 @@ type ExpandInput struct {
 
 type Node struct {
 	client.Resource
-	Name                      string                        `json:"name"`
-	Address                   string                        `json:"address"`
-	AllowScheduling           bool                          `json:"allowScheduling"`
-	EvictionRequested         bool                          `json:"evictionRequested"`
-	Disks                     map[string]DiskInfo           `json:"disks"`
-	Conditions                map[string]longhorn.Condition `json:"conditions"`
-	Tags                      []string                      `json:"tags"`
-	Region                    string                        `json:"region"`
-	Zone                      string                        `json:"zone"`
-	InstanceManagerCPURequest int                           `json:"instanceManagerCPURequest"`
-	AutoEvicting              bool                          `json:"autoEvicting"`
+	Name                      string                         `json:"name"`
+	Address                   string                         `json:"address"`
+	AllowScheduling           bool                           `json:"allowScheduling"`
+	EvictionRequested         bool                           `json:"evictionRequested"`
+	Disks                     map[string]DiskInfo            `json:"disks"`
+	Conditions                map[string]longhorn.Condition  `json:"conditions"`
+	Tags                      []string                       `json:"tags"`
+	Region                    string                         `json:"region"`
+	Zone                      string                         `json:"zone"`
+	InstanceManagerCPURequest int                            `json:"instanceManagerCPURequest"`
+	AutoEvicting              bool                           `json:"autoEvicting"`
+	Maintenance               bool                           `json:"maintenance"`
+	MaintenanceStatus         longhorn.NodeMaintenanceStatus `json:"maintenanceStatus"`
 }
 
 type DiskStatus struct {
@@ -492,10 +518,17 @@ type DiskStatus struct {
 	ScheduledReplica      map[string]int64              `json:"scheduledReplica"`
 	ScheduledBackingImage map[string]int64              `json:"scheduledBackingImage"`
 	DiskUUID              string                        `json:"diskUUID"`
+	Tier                  string                        `json:"tier"`
 
 	// Disk health monitoring data
 	HealthData                map[string]longhorn.HealthData `json:"healthData,omitempty"`
 	HealthDataLastCollectedAt string                         `json:"healthDataLastCollectedAt,omitempty"`
+
+	// Disk capacity forecast
+	StorageUsageGrowthRate      int64  `json:"storageUsageGrowthRate"`
+	ReplicaActualSize           int64  `json:"replicaActualSize"`
+	ReplicaActualSizeGrowthRate int64  `json:"replicaActualSizeGrowthRate"`
+	ProjectedFullAt             string `json:"projectedFullAt"`
 }
 
 type DiskInfo struct {
@@ -547,6 +580,32 @@ type SystemBackupInput struct {
 	VolumeBackupPolicy longhorn.SystemBackupCreateVolumeBackupPolicy `json:"volumeBackupPolicy"`
 }
 
+type DRGroup struct {
+	client.Resource
+
+	Name             string               `json:"name"`
+	Role             longhorn.DRGroupRole `json:"role"`
+	VolumeSelector   map[string]string    `json:"volumeSelector"`
+	BackupTargetName string               `json:"backupTargetName"`
+	NumberOfReplicas int                  `json:"numberOfReplicas"`
+
+	CurrentRole    longhorn.DRGroupRole                    `json:"currentRole"`
+	State          longhorn.DRGroupState                   `json:"state"`
+	Volumes        map[string]longhorn.DRGroupVolumeStatus `json:"volumes"`
+	RecoveryPoint  string                                  `json:"recoveryPoint"`
+	LastFailoverAt string                                  `json:"lastFailoverAt"`
+	LastFailbackAt string                                  `json:"lastFailbackAt"`
+	Error          string                                  `json:"error,omitempty"`
+}
+
+type DRGroupInput struct {
+	Name             string               `json:"name"`
+	Role             longhorn.DRGroupRole `json:"role"`
+	VolumeSelector   map[string]string    `json:"volumeSelector"`
+	BackupTargetName string               `json:"backupTargetName"`
+	NumberOfReplicas int                  `json:"numberOfReplicas"`
+}
+
 type SystemRestore struct {
 	client.Resource
 	Name         string                      `json:"name"`
@@ -666,6 +725,42 @@ type SnapshotListOutput struct {
 	Type string     `json:"type"`
 }
 
+type SchedulingExplanation struct {
+	client.Resource
+	VolumeName     string                      `json:"volumeName"`
+	Schedulable    bool                        `json:"schedulable"`
+	DiskCandidates []string                    `json:"diskCandidates"`
+	SelectedNode   string                      `json:"selectedNode"`
+	SelectedDisk   string                      `json:"selectedDisk"`
+	Errors         []string                    `json:"errors"`
+	Nodes          []NodeSchedulingExplanation `json:"nodes"`
+}
+
+type NodeSchedulingExplanation struct {
+	Name     string                      `json:"name"`
+	Zone     string                      `json:"zone"`
+	Tags     []string                    `json:"tags"`
+	Rejected bool                        `json:"rejected"`
+	Rule     string                      `json:"rule"`
+	Message  string                      `json:"message"`
+	Disks    []DiskSchedulingExplanation `json:"disks"`
+}
+
+type DiskSchedulingExplanation struct {
+	Name             string   `json:"name"`
+	DiskUUID         string   `json:"diskUUID"`
+	Path             string   `json:"path"`
+	Tags             []string `json:"tags"`
+	Tier             string   `json:"tier"`
+	StorageAvailable int64    `json:"storageAvailable"`
+	StorageMaximum   int64    `json:"storageMaximum"`
+	StorageReserved  int64    `json:"storageReserved"`
+	StorageScheduled int64    `json:"storageScheduled"`
+	Rejected         bool     `json:"rejected"`
+	Rule             string   `json:"rule"`
+	Message          string   `json:"message"`
+}
+
 type SnapshotCRListOutput struct {
 	Data []SnapshotCR `json:"data"`
 	Type string       `json:"type"`
@@ -713,11 +808,15 @@ func NewSchema() *client.Schemas {
 	schemas.AddType("UpdateReplicaSoftAntiAffinityInput", UpdateReplicaSoftAntiAffinityInput{})
 	schemas.AddType("UpdateReplicaZoneSoftAntiAffinityInput", UpdateReplicaZoneSoftAntiAffinityInput{})
 	schemas.AddType("UpdateReplicaDiskSoftAntiAffinityInput", UpdateReplicaDiskSoftAntiAffinityInput{})
+	schemas.AddType("UpdateReplicaSchedulingPolicyInput", UpdateReplicaSchedulingPolicyInput{})
 	schemas.AddType("UpdateFreezeFilesystemForSnapshotInput", UpdateFreezeFilesystemForSnapshotInput{})
 	schemas.AddType("UpdateBackupTargetInput", UpdateBackupTargetInput{})
 	schemas.AddType("UpdateOfflineRebuildingInput", UpdateOfflineRebuildingInput{})
 	schemas.AddType("workloadStatus", longhorn.WorkloadStatus{})
 	schemas.AddType("cloneStatus", longhorn.VolumeCloneStatus{})
+	schemas.AddType("nfsMountOptions", longhorn.NFSMountOptions{})
+	schemas.AddType("replicaTopologySpreadConstraint", longhorn.ReplicaTopologySpreadConstraint{})
+	schemas.AddType("nodeMaintenanceStatus", longhorn.NodeMaintenanceStatus{})
 	schemas.AddType("empty", Empty{})
 
 	schemas.AddType("volumeRecurringJob", VolumeRecurringJob{})
@@ -752,10 +851,12 @@ func NewSchema() *client.Schemas {
 	volumeSchema(schemas.AddType("volume", Volume{}))
 	snapshotSchema(schemas.AddType("snapshot", Snapshot{}))
 	snapshotCRSchema(schemas.AddType("snapshotCR", SnapshotCR{}))
+	schemas.AddType("backupTargetReplicationStatus", longhorn.BackupTargetReplicationStatus{})
 	backupTargetSchema(schemas.AddType("backupTarget", BackupTarget{}))
 	backupVolumeSchema(schemas.AddType("backupVolume", BackupVolume{}))
 	backupBackingImageSchema(schemas.AddType("backupBackingImage", BackupBackingImage{}))
 	settingSchema(schemas.AddType("setting", Setting{}))
+	schemas.AddType("recurringJobRetentionPolicy", longhorn.RecurringJobRetentionPolicy{})
 	recurringJobSchema(schemas.AddType("recurringJob", RecurringJob{}))
 	engineImageSchema(schemas.AddType("engineImage", EngineImage{}))
 	backingImageSchema(schemas.AddType("backingImage", BackingImage{}))
@@ -769,7 +870,12 @@ func NewSchema() *client.Schemas {
 	snapshotListOutputSchema(schemas.AddType("snapshotListOutput", SnapshotListOutput{}))
 	systemBackupSchema(schemas.AddType("systemBackup", SystemBackup{}))
 	systemRestoreSchema(schemas.AddType("systemRestore", SystemRestore{}))
+	schemas.AddType("drGroupVolumeStatus", longhorn.DRGroupVolumeStatus{})
+	drGroupSchema(schemas.AddType("drGroup", DRGroup{}))
 	snapshotCRListOutputSchema(schemas.AddType("snapshotCRListOutput", SnapshotCRListOutput{}))
+	schedulingExplanationSchema(schemas.AddType("schedulingExplanation", SchedulingExplanation{}))
+	nodeSchedulingExplanationSchema(schemas.AddType("nodeSchedulingExplanation", NodeSchedulingExplanation{}))
+	schemas.AddType("diskSchedulingExplanation", DiskSchedulingExplanation{})
 
 	return schemas
 }
@@ -783,6 +889,12 @@ func nodeSchema(node *client.Schema) {
 			Input:  "diskUpdateInput",
 			Output: "node",
 		},
+		"maintenance": {
+			Output: "node",
+		},
+		"exitMaintenance": {
+			Output: "node",
+		},
 	}
 
 	allowScheduling := node.ResourceFields["allowScheduling"]
@@ -913,6 +1025,13 @@ func recurringJobSchema(job *client.Schema) {
 	retain.Create = true
 	job.ResourceFields["retain"] = retain
 
+	job.ResourceFields["retentionPolicy"] = client.Field{
+		Type:     "recurringJobRetentionPolicy",
+		Nullable: true,
+		Create:   true,
+		Update:   true,
+	}
+
 	concurrency := job.ResourceFields["concurrency"]
 	concurrency.Required = true
 	concurrency.Unique = false
@@ -961,6 +1080,25 @@ func backupTargetSchema(backupTarget *client.Schema) {
 	backupTargetPollInterval.Default = "300"
 	backupTarget.ResourceFields["pollInterval"] = backupTargetPollInterval
 
+	backupTargetRetentionLockPeriod := backupTarget.ResourceFields["retentionLockPeriod"]
+	backupTargetRetentionLockPeriod.Create = true
+	backupTargetRetentionLockPeriod.Default = "0"
+	backupTarget.ResourceFields["retentionLockPeriod"] = backupTargetRetentionLockPeriod
+
+	backupTargetReplicationTargetName := backupTarget.ResourceFields["replicationTargetName"]
+	backupTargetReplicationTargetName.Create = true
+	backupTargetReplicationTargetName.Default = ""
+	backupTarget.ResourceFields["replicationTargetName"] = backupTargetReplicationTargetName
+
+	backupTargetBackupWindow := backupTarget.ResourceFields["backupWindow"]
+	backupTargetBackupWindow.Create = true
+	backupTargetBackupWindow.Default = ""
+	backupTarget.ResourceFields["backupWindow"] = backupTargetBackupWindow
+
+	backupTargetReplicationStatus := backupTarget.ResourceFields["replicationStatus"]
+	backupTargetReplicationStatus.Type = "map[backupTargetReplicationStatus]"
+	backupTarget.ResourceFields["replicationStatus"] = backupTargetReplicationStatus
+
 	backupTarget.ResourceActions = map[string]client.Action{
 		"backupTargetSync": {
 			Input:  "syncBackupResource",
@@ -1022,6 +1160,12 @@ func settingSchema(setting *client.Schema) {
 func volumeSchema(volume *client.Schema) {
 	volume.CollectionMethods = []string{"GET", "POST"}
 	volume.ResourceMethods = []string{"GET", "DELETE"}
+	volume.CollectionActions = map[string]client.Action{
+		"explainScheduling": {
+			Input:  "volume",
+			Output: "schedulingExplanation",
+		},
+	}
 	volume.ResourceActions = map[string]client.Action{
 		"attach": {
 			Input:  "attachInput",
@@ -1053,6 +1197,15 @@ func volumeSchema(volume *client.Schema) {
 		"trimFilesystem": {
 			Output: "volume",
 		},
+		"snapshotChecksumCheck": {
+			Output: "volume",
+		},
+		"checkFilesystem": {
+			Output: "volume",
+		},
+		"checkBackupBlocks": {
+			Output: "volume",
+		},
 		"snapshotPurge": {
 			Output: "volume",
 		},
@@ -1067,6 +1220,9 @@ func volumeSchema(volume *client.Schema) {
 		"snapshotList": {
 			Output: "snapshotListOutput",
 		},
+		"explainScheduling": {
+			Output: "schedulingExplanation",
+		},
 		"snapshotDelete": {
 			Input:  "snapshotInput",
 			Output: "volume",
@@ -1175,6 +1331,10 @@ func volumeSchema(volume *client.Schema) {
 			Input: "UpdateReplicaDiskSoftAntiAffinityInput",
 		},
 
+		"updateReplicaSchedulingPolicy": {
+			Input: "UpdateReplicaSchedulingPolicyInput",
+		},
+
 		"updateFreezeFilesystemForSnapshot": {
 			Input: "UpdateFreezeFilesystemForSnapshotInput",
 		},
@@ -1316,6 +1476,12 @@ func volumeSchema(volume *client.Schema) {
 	replicaDiskSoftAntiAffinity.Default = longhorn.ReplicaDiskSoftAntiAffinityDefault
 	volume.ResourceFields["replicaDiskSoftAntiAffinity"] = replicaDiskSoftAntiAffinity
 
+	replicaSchedulingPolicy := volume.ResourceFields["replicaSchedulingPolicy"]
+	replicaSchedulingPolicy.Required = true
+	replicaSchedulingPolicy.Create = true
+	replicaSchedulingPolicy.Default = longhorn.ReplicaSchedulingPolicyDefault
+	volume.ResourceFields["replicaSchedulingPolicy"] = replicaSchedulingPolicy
+
 	rebuildConcurrentSyncLimit := volume.ResourceFields["rebuildConcurrentSyncLimit"]
 	rebuildConcurrentSyncLimit.Create = true
 	rebuildConcurrentSyncLimit.Default = 0
@@ -1335,10 +1501,19 @@ func volumeSchema(volume *client.Schema) {
 	diskSelector.Create = true
 	volume.ResourceFields["diskSelector"] = diskSelector
 
+	diskTiers := volume.ResourceFields["diskTiers"]
+	diskTiers.Create = true
+	volume.ResourceFields["diskTiers"] = diskTiers
+
 	nodeSelector := volume.ResourceFields["nodeSelector"]
 	nodeSelector.Create = true
 	volume.ResourceFields["nodeSelector"] = nodeSelector
 
+	replicaTopologySpread := volume.ResourceFields["replicaTopologySpread"]
+	replicaTopologySpread.Type = "array[replicaTopologySpreadConstraint]"
+	replicaTopologySpread.Create = true
+	volume.ResourceFields["replicaTopologySpread"] = replicaTopologySpread
+
 	kubernetesStatus := volume.ResourceFields["kubernetesStatus"]
 	kubernetesStatus.Type = "kubernetesStatus"
 	volume.ResourceFields["kubernetesStatus"] = kubernetesStatus
@@ -1347,6 +1522,11 @@ func volumeSchema(volume *client.Schema) {
 	cloneStatus.Type = "cloneStatus"
 	volume.ResourceFields["cloneStatus"] = cloneStatus
 
+	nfsMountOptions := volume.ResourceFields["nfsMountOptions"]
+	nfsMountOptions.Type = "nfsMountOptions"
+	nfsMountOptions.Create = true
+	volume.ResourceFields["nfsMountOptions"] = nfsMountOptions
+
 	backupStatus := volume.ResourceFields["backupStatus"]
 	backupStatus.Type = "array[backupStatus]"
 	volume.ResourceFields["backupStatus"] = backupStatus
@@ -1404,6 +1584,18 @@ func snapshotListOutputSchema(snapshotList *client.Schema) {
 	snapshotList.ResourceFields["data"] = data
 }
 
+func schedulingExplanationSchema(explanation *client.Schema) {
+	nodes := explanation.ResourceFields["nodes"]
+	nodes.Type = "array[nodeSchedulingExplanation]"
+	explanation.ResourceFields["nodes"] = nodes
+}
+
+func nodeSchedulingExplanationSchema(explanation *client.Schema) {
+	disks := explanation.ResourceFields["disks"]
+	disks.Type = "array[diskSchedulingExplanation]"
+	explanation.ResourceFields["disks"] = disks
+}
+
 func systemBackupSchema(systemBackup *client.Schema) {
 	systemBackup.CollectionMethods = []string{"GET", "POST"}
 	systemBackup.ResourceMethods = []string{"GET", "DELETE"}
@@ -1431,6 +1623,40 @@ func systemRestoreSchema(systemRestore *client.Schema) {
 	systemRestore.ResourceFields["systemBackup"] = systemBackup
 }
 
+func drGroupSchema(drGroup *client.Schema) {
+	drGroup.CollectionMethods = []string{"GET", "POST"}
+	drGroup.ResourceMethods = []string{"GET", "DELETE"}
+
+	drGroup.ResourceActions = map[string]client.Action{
+		"failover": {
+			Output: "drGroup",
+		},
+		"failback": {
+			Output: "drGroup",
+		},
+	}
+
+	name := drGroup.ResourceFields["name"]
+	name.Required = true
+	name.Unique = true
+	name.Create = true
+	drGroup.ResourceFields["name"] = name
+
+	role := drGroup.ResourceFields["role"]
+	role.Required = true
+	role.Create = true
+	drGroup.ResourceFields["role"] = role
+
+	volumeSelector := drGroup.ResourceFields["volumeSelector"]
+	volumeSelector.Required = true
+	volumeSelector.Create = true
+	drGroup.ResourceFields["volumeSelector"] = volumeSelector
+
+	volumes := drGroup.ResourceFields["volumes"]
+	volumes.Type = "map[drGroupVolumeStatus]"
+	drGroup.ResourceFields["volumes"] = volumes
+}
+
 func snapshotCRListOutputSchema(snapshotList *client.Schema) {
 	data := snapshotList.ResourceFields["data"]
 	data.Type = "array[snapshotCR]"
@@ -1596,12 +1822,15 @@ func toVolumeResource(v *longhorn.Volume, ves []*longhorn.Engine, vrs []*longhor
 				CurrentImage:        r.Status.CurrentImage,
 				InstanceManagerName: r.Status.InstanceManagerName,
 			},
-			DiskID:     r.Spec.DiskID,
-			DiskPath:   r.Spec.DiskPath,
-			DataPath:   types.GetReplicaDataPath(r.Spec.DiskPath, r.Spec.DataDirectoryName),
-			Mode:       mode,
-			FailedAt:   r.Spec.FailedAt,
-			DataEngine: string(r.Spec.DataEngine),
+			DiskID:                        r.Spec.DiskID,
+			DiskPath:                      r.Spec.DiskPath,
+			DataPath:                      types.GetReplicaDataPath(r.Spec.DiskPath, r.Spec.DataDirectoryName),
+			Mode:                          mode,
+			FailedAt:                      r.Spec.FailedAt,
+			DataEngine:                    string(r.Spec.DataEngine),
+			LastSnapshotChecksumCheckedAt: r.Status.LastSnapshotChecksumCheckedAt,
+			MismatchedSnapshotChecksums:   r.Status.MismatchedSnapshotChecksums,
+			MismatchedBlocks:              r.Status.MismatchedBlocks,
 		})
 	}
 
@@ -1654,6 +1883,11 @@ func toVolumeResource(v *longhorn.Volume, ves []*longhorn.Engine, vrs []*longhor
 	//   5. It's failed to clone
 	ready, _ := types.IsVolumeReady(v, vrs, types.VolumeOperationGeneric)
 
+	diskTiers := []string{}
+	for _, tier := range v.Spec.DiskTiers {
+		diskTiers = append(diskTiers, string(tier))
+	}
+
 	r := &Volume{
 		Resource: client.Resource{
 			Id:      v.Name,
@@ -1687,27 +1921,37 @@ func toVolumeResource(v *longhorn.Volume, ves []*longhorn.Engine, vrs []*longhor
 		BackingImage:                    v.Spec.BackingImage,
 		Standby:                         v.Spec.Standby,
 		DiskSelector:                    v.Spec.DiskSelector,
+		DiskTiers:                       diskTiers,
 		NodeSelector:                    v.Spec.NodeSelector,
+		ReplicaTopologySpread:           v.Spec.ReplicaTopologySpread,
 		RestoreVolumeRecurringJob:       v.Spec.RestoreVolumeRecurringJob,
 		FreezeFilesystemForSnapshot:     v.Spec.FreezeFilesystemForSnapshot,
 		BackupTargetName:                v.Spec.BackupTargetName,
 
-		State:                       v.Status.State,
-		Robustness:                  v.Status.Robustness,
-		CurrentImage:                v.Status.CurrentImage,
-		LastBackup:                  v.Status.LastBackup,
-		LastBackupAt:                v.Status.LastBackupAt,
-		RestoreRequired:             v.Status.RestoreRequired,
-		RestoreInitiated:            v.Status.RestoreInitiated,
-		RevisionCounterDisabled:     v.Spec.RevisionCounterDisabled,
-		UnmapMarkSnapChainRemoved:   v.Spec.UnmapMarkSnapChainRemoved,
-		ReplicaSoftAntiAffinity:     v.Spec.ReplicaSoftAntiAffinity,
-		ReplicaZoneSoftAntiAffinity: v.Spec.ReplicaZoneSoftAntiAffinity,
-		ReplicaDiskSoftAntiAffinity: v.Spec.ReplicaDiskSoftAntiAffinity,
-		DataEngine:                  v.Spec.DataEngine,
-		Ready:                       ready,
+		State:                               v.Status.State,
+		Robustness:                          v.Status.Robustness,
+		CurrentImage:                        v.Status.CurrentImage,
+		LastBackup:                          v.Status.LastBackup,
+		LastBackupAt:                        v.Status.LastBackupAt,
+		SnapshotChecksumCheckRequestedAt:    v.Spec.SnapshotChecksumCheckRequestedAt,
+		LastSnapshotChecksumCheckCompleteAt: v.Status.LastSnapshotChecksumCheckCompleteAt,
+		LastRestoredBackup:                  v.Status.LastRestoredBackup,
+		LastRestoredBackupAt:                v.Status.LastRestoredBackupAt,
+		LastRestoreCompletedAt:              v.Status.LastRestoreCompletedAt,
+		PendingRestoreBackupCount:           v.Status.PendingRestoreBackupCount,
+		RestoreRequired:                     v.Status.RestoreRequired,
+		RestoreInitiated:                    v.Status.RestoreInitiated,
+		RevisionCounterDisabled:             v.Spec.RevisionCounterDisabled,
+		UnmapMarkSnapChainRemoved:           v.Spec.UnmapMarkSnapChainRemoved,
+		ReplicaSoftAntiAffinity:             v.Spec.ReplicaSoftAntiAffinity,
+		ReplicaZoneSoftAntiAffinity:         v.Spec.ReplicaZoneSoftAntiAffinity,
+		ReplicaDiskSoftAntiAffinity:         v.Spec.ReplicaDiskSoftAntiAffinity,
+		ReplicaSchedulingPolicy:             v.Spec.ReplicaSchedulingPolicy,
+		DataEngine:                          v.Spec.DataEngine,
+		Ready:                               ready,
 
 		AccessMode:        v.Spec.AccessMode,
+		NFSMountOptions:   v.Spec.NFSMountOptions,
 		ShareEndpoint:     v.Status.ShareEndpoint,
 		ShareState:        v.Status.ShareState,
 		OfflineRebuilding: v.Spec.OfflineRebuilding,
@@ -1732,8 +1976,9 @@ func toVolumeResource(v *longhorn.Volume, ves []*longhorn.Engine, vrs []*longhor
 	// api attach & detach calls are always allowed
 	// the volume manager is responsible for handling them appropriately
 	actions := map[string]struct{}{
-		"attach": {},
-		"detach": {},
+		"attach":            {},
+		"detach":            {},
+		"explainScheduling": {},
 	}
 
 	if v.Status.Robustness == longhorn.VolumeRobustnessFaulted {
@@ -1771,6 +2016,7 @@ func toVolumeResource(v *longhorn.Volume, ves []*longhorn.Engine, vrs []*longhor
 			actions["updateReplicaSoftAntiAffinity"] = struct{}{}
 			actions["updateReplicaZoneSoftAntiAffinity"] = struct{}{}
 			actions["updateReplicaDiskSoftAntiAffinity"] = struct{}{}
+			actions["updateReplicaSchedulingPolicy"] = struct{}{}
 			actions["updateFreezeFilesystemForSnapshot"] = struct{}{}
 			actions["updateBackupTargetName"] = struct{}{}
 			actions["recurringJobAdd"] = struct{}{}
@@ -1807,6 +2053,7 @@ func toVolumeResource(v *longhorn.Volume, ves []*longhorn.Engine, vrs []*longhor
 			actions["updateReplicaSoftAntiAffinity"] = struct{}{}
 			actions["updateReplicaZoneSoftAntiAffinity"] = struct{}{}
 			actions["updateReplicaDiskSoftAntiAffinity"] = struct{}{}
+			actions["updateReplicaSchedulingPolicy"] = struct{}{}
 			actions["updateFreezeFilesystemForSnapshot"] = struct{}{}
 			actions["updateBackupTargetName"] = struct{}{}
 			actions["pvCreate"] = struct{}{}
@@ -1814,6 +2061,9 @@ func toVolumeResource(v *longhorn.Volume, ves []*longhorn.Engine, vrs []*longhor
 			actions["cancelExpansion"] = struct{}{}
 			actions["offlineReplicaRebuilding"] = struct{}{}
 			actions["trimFilesystem"] = struct{}{}
+			actions["snapshotChecksumCheck"] = struct{}{}
+			actions["checkFilesystem"] = struct{}{}
+			actions["checkBackupBlocks"] = struct{}{}
 			actions["recurringJobAdd"] = struct{}{}
 			actions["recurringJobDelete"] = struct{}{}
 			actions["recurringJobList"] = struct{}{}
@@ -1901,6 +2151,63 @@ func toSnapshotCollection(ssList map[string]*longhorn.SnapshotInfo, ssListRO map
 	return &client.GenericCollection{Data: data, Collection: client.Collection{ResourceType: "snapshot"}}
 }
 
+func toSchedulingExplanationResource(e *scheduler.SchedulingExplanation) *SchedulingExplanation {
+	errs := []string{}
+	for reason, messages := range e.Errors {
+		for _, message := range messages {
+			errs = append(errs, reason+": "+message)
+		}
+	}
+	sort.Strings(errs)
+
+	nodes := []NodeSchedulingExplanation{}
+	for _, node := range e.Nodes {
+		disks := []DiskSchedulingExplanation{}
+		for _, disk := range node.Disks {
+			disks = append(disks, DiskSchedulingExplanation{
+				Name:             disk.DiskName,
+				DiskUUID:         disk.DiskUUID,
+				Path:             disk.Path,
+				Tags:             disk.Tags,
+				Tier:             string(disk.Tier),
+				StorageAvailable: disk.StorageAvailable,
+				StorageMaximum:   disk.StorageMaximum,
+				StorageReserved:  disk.StorageReserved,
+				StorageScheduled: disk.StorageScheduled,
+				Rejected:         disk.Rejected,
+				Rule:             string(disk.Rule),
+				Message:          disk.Message,
+			})
+		}
+		sort.Slice(disks, func(i, j int) bool { return disks[i].Name < disks[j].Name })
+
+		nodes = append(nodes, NodeSchedulingExplanation{
+			Name:     node.NodeName,
+			Zone:     node.Zone,
+			Tags:     node.Tags,
+			Rejected: node.Rejected,
+			Rule:     string(node.Rule),
+			Message:  node.Message,
+			Disks:    disks,
+		})
+	}
+	sort.Slice(nodes, func(i, j int) bool { return nodes[i].Name < nodes[j].Name })
+
+	return &SchedulingExplanation{
+		Resource: client.Resource{
+			Id:   e.VolumeName,
+			Type: "schedulingExplanation",
+		},
+		VolumeName:     e.VolumeName,
+		Schedulable:    e.Schedulable,
+		DiskCandidates: e.DiskCandidates,
+		SelectedNode:   e.SelectedNode,
+		SelectedDisk:   e.SelectedDisk,
+		Errors:         errs,
+		Nodes:          nodes,
+	}
+}
+
 func toVolumeRecurringJobResource(obj *longhorn.VolumeRecurringJob) *VolumeRecurringJob {
 	if obj == nil {
 		return nil
@@ -1934,13 +2241,24 @@ func toBackupTargetResource(bt *longhorn.BackupTarget, apiContext *api.ApiContex
 			Links: map[string]string{},
 		},
 		BackupTarget: engineapi.BackupTarget{
-			Name:             bt.Name,
-			BackupTargetURL:  bt.Spec.BackupTargetURL,
-			CredentialSecret: bt.Spec.CredentialSecret,
-			PollInterval:     bt.Spec.PollInterval.Duration.String(),
-			Available:        bt.Status.Available,
-			Message:          types.GetCondition(bt.Status.Conditions, longhorn.BackupTargetConditionTypeUnavailable).Message,
-		},
+			Name:                  bt.Name,
+			BackupTargetURL:       bt.Spec.BackupTargetURL,
+			CredentialSecret:      bt.Spec.CredentialSecret,
+			PollInterval:          bt.Spec.PollInterval.Duration.String(),
+			RetentionLockPeriod:   bt.Spec.RetentionLockPeriod.Duration.String(),
+			ReplicationTargetName: bt.Spec.ReplicationTargetName,
+			BackupWindow:          bt.Spec.BackupWindow,
+			Available:             bt.Status.Available,
+			Message:               types.GetCondition(bt.Status.Conditions, longhorn.BackupTargetConditionTypeUnavailable).Message,
+		},
+	}
+	if len(bt.Status.ReplicationStatus) > 0 {
+		res.ReplicationStatus = map[string]longhorn.BackupTargetReplicationStatus{}
+		for name, status := range bt.Status.ReplicationStatus {
+			if status != nil {
+				res.ReplicationStatus[name] = *status
+			}
+		}
 	}
 	res.Actions = map[string]string{
 		"backupTargetSync":   apiContext.UrlBuilder.ActionLink(res.Resource, "backupTargetSync"),
@@ -2095,6 +2413,14 @@ func toBackupResource(b *longhorn.Backup) *Backup {
 		ReUploadedDataSize:     b.Status.ReUploadedDataSize,
 		BackupTargetName:       backupTargetName,
 		BlockSize:              strconv.FormatInt(b.Spec.BackupBlockSize, 10),
+		VerificationResult:     string(b.Status.VerificationResult),
+		VerificationMessage:    b.Status.VerificationMessage,
+	}
+	if !b.Status.RetentionLockedUntil.IsZero() {
+		ret.RetentionLockedUntil = util.FormatTimeZ(b.Status.RetentionLockedUntil.Time)
+	}
+	if !b.Status.LastVerifiedAt.IsZero() {
+		ret.LastVerifiedAt = util.FormatTimeZ(b.Status.LastVerifiedAt.Time)
 	}
 	// Set the volume name from backup CR's label if it's empty.
 	// This field is empty probably because the backup state is not Ready
@@ -2207,13 +2533,19 @@ type Server struct {
 	m   *manager.VolumeManager
 	wsc *controller.WebsocketController
 	fwd *Fwd
+
+	accessReviewer *AccessReviewer
+	auditLogger    *audit.Logger
 }
 
-func NewServer(m *manager.VolumeManager, wsc *controller.WebsocketController) *Server {
+func NewServer(m *manager.VolumeManager, wsc *controller.WebsocketController, accessReviewer *AccessReviewer, auditLogger *audit.Logger) *Server {
 	s := &Server{
 		m:   m,
 		wsc: wsc,
 		fwd: NewFwd(m),
+
+		accessReviewer: accessReviewer,
+		auditLogger:    auditLogger,
 	}
 	return s
 }
@@ -2236,6 +2568,8 @@ func toNodeResource(node *longhorn.Node, address string, apiContext *api.ApiCont
 		Zone:                      node.Status.Zone,
 		InstanceManagerCPURequest: node.Spec.InstanceManagerCPURequest,
 		AutoEvicting:              node.Status.AutoEvicting,
+		Maintenance:               node.Spec.Maintenance,
+		MaintenanceStatus:         node.Status.Maintenance,
 	}
 
 	disks := map[string]DiskInfo{}
@@ -2245,15 +2579,20 @@ func toNodeResource(node *longhorn.Node, address string, apiContext *api.ApiCont
 		}
 		if node.Status.DiskStatus != nil && node.Status.DiskStatus[name] != nil {
 			di.DiskStatus = DiskStatus{
-				Conditions:                sliceToMap(node.Status.DiskStatus[name].Conditions),
-				StorageAvailable:          node.Status.DiskStatus[name].StorageAvailable,
-				StorageScheduled:          node.Status.DiskStatus[name].StorageScheduled,
-				StorageMaximum:            node.Status.DiskStatus[name].StorageMaximum,
-				ScheduledReplica:          node.Status.DiskStatus[name].ScheduledReplica,
-				ScheduledBackingImage:     node.Status.DiskStatus[name].ScheduledBackingImage,
-				DiskUUID:                  node.Status.DiskStatus[name].DiskUUID,
-				HealthData:                node.Status.DiskStatus[name].HealthData,
-				HealthDataLastCollectedAt: node.Status.DiskStatus[name].HealthDataLastCollectedAt.String(),
+				Conditions:                  sliceToMap(node.Status.DiskStatus[name].Conditions),
+				StorageAvailable:            node.Status.DiskStatus[name].StorageAvailable,
+				StorageScheduled:            node.Status.DiskStatus[name].StorageScheduled,
+				StorageMaximum:              node.Status.DiskStatus[name].StorageMaximum,
+				ScheduledReplica:            node.Status.DiskStatus[name].ScheduledReplica,
+				ScheduledBackingImage:       node.Status.DiskStatus[name].ScheduledBackingImage,
+				DiskUUID:                    node.Status.DiskStatus[name].DiskUUID,
+				Tier:                        string(node.Status.DiskStatus[name].Tier),
+				HealthData:                  node.Status.DiskStatus[name].HealthData,
+				HealthDataLastCollectedAt:   node.Status.DiskStatus[name].HealthDataLastCollectedAt.String(),
+				StorageUsageGrowthRate:      node.Status.DiskStatus[name].StorageUsageGrowthRate,
+				ReplicaActualSize:           node.Status.DiskStatus[name].ReplicaActualSize,
+				ReplicaActualSizeGrowthRate: node.Status.DiskStatus[name].ReplicaActualSizeGrowthRate,
+				ProjectedFullAt:             node.Status.DiskStatus[name].ProjectedFullAt,
 			}
 		}
 		disks[name] = di
@@ -2261,7 +2600,9 @@ func toNodeResource(node *longhorn.Node, address string, apiContext *api.ApiCont
 	n.Disks = disks
 
 	n.Actions = map[string]string{
-		"diskUpdate": apiContext.UrlBuilder.ActionLink(n.Resource, "diskUpdate"),
+		"diskUpdate":      apiContext.UrlBuilder.ActionLink(n.Resource, "diskUpdate"),
+		"maintenance":     apiContext.UrlBuilder.ActionLink(n.Resource, "maintenance"),
+		"exitMaintenance": apiContext.UrlBuilder.ActionLink(n.Resource, "exitMaintenance"),
 	}
 
 	return n
@@ -2358,6 +2699,51 @@ func toSystemBackupResource(systemBackup *longhorn.SystemBackup) *SystemBackup {
 	}
 }
 
+func toDRGroupCollection(drGroups []*longhorn.DRGroup, apiContext *api.ApiContext) *client.GenericCollection {
+	data := []interface{}{}
+	for _, drGroup := range drGroups {
+		data = append(data, toDRGroupResource(drGroup, apiContext))
+	}
+	return &client.GenericCollection{Data: data, Collection: client.Collection{ResourceType: "drGroup"}}
+}
+
+func toDRGroupResource(drGroup *longhorn.DRGroup, apiContext *api.ApiContext) *DRGroup {
+	volumes := map[string]longhorn.DRGroupVolumeStatus{}
+	for name, status := range drGroup.Status.Volumes {
+		if status == nil {
+			continue
+		}
+		volumes[name] = *status
+	}
+
+	res := &DRGroup{
+		Resource: client.Resource{
+			Id:    drGroup.Name,
+			Type:  "drGroup",
+			Links: map[string]string{},
+		},
+		Name:             drGroup.Name,
+		Role:             drGroup.Spec.Role,
+		VolumeSelector:   drGroup.Spec.VolumeSelector,
+		BackupTargetName: drGroup.Spec.BackupTargetName,
+		NumberOfReplicas: drGroup.Spec.NumberOfReplicas,
+
+		CurrentRole:    drGroup.Status.Role,
+		State:          drGroup.Status.State,
+		Volumes:        volumes,
+		RecoveryPoint:  drGroup.Status.RecoveryPoint,
+		LastFailoverAt: drGroup.Status.LastFailoverAt,
+		LastFailbackAt: drGroup.Status.LastFailbackAt,
+		Error:          drGroup.Status.Error,
+	}
+	res.Actions = map[string]string{
+		"failover": apiContext.UrlBuilder.ActionLink(res.Resource, "failover"),
+		"failback": apiContext.UrlBuilder.ActionLink(res.Resource, "failback"),
+	}
+
+	return res
+}
+
 func toSystemRestoreCollection(systemRestores []*longhorn.SystemRestore) *client.GenericCollection {
 	data := []interface{}{}
 	for _, systemRestore := range systemRestores {
@@ -2441,14 +2827,15 @@ func toRecurringJobResource(recurringJob *longhorn.RecurringJob, apiContext *api
 			Type: "recurringJob",
 		},
 		RecurringJobSpec: longhorn.RecurringJobSpec{
-			Name:        recurringJob.Name,
-			Groups:      recurringJob.Spec.Groups,
-			Task:        recurringJob.Spec.Task,
-			Cron:        recurringJob.Spec.Cron,
-			Retain:      recurringJob.Spec.Retain,
-			Concurrency: recurringJob.Spec.Concurrency,
-			Labels:      recurringJob.Spec.Labels,
-			Parameters:  recurringJob.Spec.Parameters,
+			Name:            recurringJob.Name,
+			Groups:          recurringJob.Spec.Groups,
+			Task:            recurringJob.Spec.Task,
+			Cron:            recurringJob.Spec.Cron,
+			Retain:          recurringJob.Spec.Retain,
+			RetentionPolicy: recurringJob.Spec.RetentionPolicy,
+			Concurrency:     recurringJob.Spec.Concurrency,
+			Labels:          recurringJob.Spec.Labels,
+			Parameters:      recurringJob.Spec.Parameters,
 		},
 		RecurringJobStatus: longhorn.RecurringJobStatus{
 			ExecutionCount: recurringJob.Status.ExecutionCount,
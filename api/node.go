@@ -120,6 +120,37 @@ func (s *Server) DiskUpdate(rw http.ResponseWriter, req *http.Request) error {
 	return nil
 }
 
+func (s *Server) NodeMaintenance(rw http.ResponseWriter, req *http.Request) error {
+	return s.nodeSetMaintenance(rw, req, true)
+}
+
+func (s *Server) NodeExitMaintenance(rw http.ResponseWriter, req *http.Request) error {
+	return s.nodeSetMaintenance(rw, req, false)
+}
+
+func (s *Server) nodeSetMaintenance(rw http.ResponseWriter, req *http.Request, maintenance bool) error {
+	apiContext := api.GetApiContext(req)
+	id := mux.Vars(req)["name"]
+
+	nodeIPMap, err := s.m.GetManagerNodeIPMap()
+	if err != nil {
+		return errors.Wrap(err, "failed to get node ip")
+	}
+
+	obj, err := util.RetryOnConflictCause(func() (interface{}, error) {
+		return s.m.SetNodeMaintenance(id, maintenance)
+	})
+	if err != nil {
+		return err
+	}
+	unode, ok := obj.(*longhorn.Node)
+	if !ok {
+		return fmt.Errorf("failed to convert to node %v object", id)
+	}
+	apiContext.Write(toNodeResource(unode, nodeIPMap[id], apiContext))
+	return nil
+}
+
 func (s *Server) NodeDelete(rw http.ResponseWriter, req *http.Request) error {
 	id := mux.Vars(req)["name"]
 	if err := s.m.DeleteNode(id); err != nil {
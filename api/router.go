@@ -57,6 +57,10 @@ func NewRouter(s *Server) *mux.Router {
 
 	// Apply manager-url middleware to all API routes (including previously registered routes)
 	r.Use(ManagerURLMiddleware(s))
+	// Record the mutating requests to the audit log, including the ones rejected by the authorization
+	r.Use(AuditMiddleware(s))
+	// Apply authentication and authorization according to the api-access-mode setting
+	r.Use(AuthMiddleware(s))
 
 	r.Methods("GET").Path("/v1").Handler(versionHandler)
 	r.Methods("GET").Path("/v1/apiversions").Handler(versionsHandler)
@@ -71,6 +75,7 @@ func NewRouter(s *Server) *mux.Router {
 	r.Methods("GET").Path("/v1/volumes").Handler(f(schemas, s.VolumeList))
 	r.Methods("GET").Path("/v1/volumes/{name}").Handler(f(schemas, s.VolumeGet))
 	r.Methods("DELETE").Path("/v1/volumes/{name}").Handler(f(schemas, s.VolumeDelete))
+	r.Methods("POST").Path("/v1/volumes").Queries("action", "explainScheduling").Handler(f(schemas, s.VolumeExplainSchedulingForSpec))
 	r.Methods("POST").Path("/v1/volumes").Handler(f(schemas, s.fwd.Handler(s.fwd.HandleProxyRequestByNodeID, s.fwd.GetHTTPAddressByNodeID(NodeHasDefaultEngineImage(s.m)), s.VolumeCreate)))
 	volumeActions := map[string]func(http.ResponseWriter, *http.Request) error{
 		"attach":                                s.VolumeAttach,
@@ -87,10 +92,12 @@ func NewRouter(s *Server) *mux.Router {
 		"updateReplicaSoftAntiAffinity":         s.VolumeUpdateReplicaSoftAntiAffinity,
 		"updateReplicaZoneSoftAntiAffinity":     s.VolumeUpdateReplicaZoneSoftAntiAffinity,
 		"updateReplicaDiskSoftAntiAffinity":     s.VolumeUpdateReplicaDiskSoftAntiAffinity,
+		"updateReplicaSchedulingPolicy":         s.VolumeUpdateReplicaSchedulingPolicy,
 		"activate":                              s.VolumeActivate,
 		"expand":                                s.VolumeExpand,
 		"cancelExpansion":                       s.VolumeCancelExpansion,
 		"offlineReplicaRebuilding":              s.VolumeOfflineRebuilding,
+		"explainScheduling":                     s.VolumeExplainScheduling,
 
 		"updateReplicaCount":                s.VolumeUpdateReplicaCount,
 		"updateReplicaAutoBalance":          s.VolumeUpdateReplicaAutoBalance,
@@ -103,7 +110,10 @@ func NewRouter(s *Server) *mux.Router {
 
 		"engineUpgrade": s.EngineUpgrade,
 
-		"trimFilesystem": s.fwd.Handler(s.fwd.HandleProxyRequestByNodeID, s.fwd.GetHTTPAddressByNodeID(OwnerIDFromVolume(s.m)), s.VolumeFilesystemTrim),
+		"trimFilesystem":        s.fwd.Handler(s.fwd.HandleProxyRequestByNodeID, s.fwd.GetHTTPAddressByNodeID(OwnerIDFromVolume(s.m)), s.VolumeFilesystemTrim),
+		"snapshotChecksumCheck": s.VolumeSnapshotChecksumCheck,
+		"checkFilesystem":       s.fwd.Handler(s.fwd.HandleProxyRequestByNodeID, s.fwd.GetHTTPAddressByNodeID(CurrentNodeIDFromVolume(s.m)), s.VolumeFilesystemCheck),
+		"checkBackupBlocks":     s.fwd.Handler(s.fwd.HandleProxyRequestByNodeID, s.fwd.GetHTTPAddressByNodeID(CurrentNodeIDFromVolume(s.m)), s.VolumeBackupBlocksCheck),
 
 		"snapshotPurge":  s.fwd.Handler(s.fwd.HandleProxyRequestByNodeID, s.fwd.GetHTTPAddressByNodeID(OwnerIDFromVolume(s.m)), s.SnapshotPurge),
 		"snapshotCreate": s.fwd.Handler(s.fwd.HandleProxyRequestByNodeID, s.fwd.GetHTTPAddressByNodeID(OwnerIDFromVolume(s.m)), s.SnapshotCreate),
@@ -162,7 +172,9 @@ func NewRouter(s *Server) *mux.Router {
 	r.Methods("PUT").Path("/v1/nodes/{name}").Handler(f(schemas, s.NodeUpdate))
 	r.Methods("DELETE").Path("/v1/nodes/{name}").Handler(f(schemas, s.NodeDelete))
 	nodeActions := map[string]func(http.ResponseWriter, *http.Request) error{
-		"diskUpdate": s.DiskUpdate,
+		"diskUpdate":      s.DiskUpdate,
+		"maintenance":     s.NodeMaintenance,
+		"exitMaintenance": s.NodeExitMaintenance,
 	}
 	for name, action := range nodeActions {
 		r.Methods("POST").Path("/v1/nodes/{name}").Queries("action", name).Handler(f(schemas, action))
@@ -230,6 +242,18 @@ func NewRouter(s *Server) *mux.Router {
 	r.Methods("GET").Path("/v1/systembackups/{name}").Handler(f(schemas, s.SystemBackupGet))
 	r.Methods("DELETE").Path("/v1/systembackups/{name}").Handler(f(schemas, s.SystemBackupDelete))
 
+	r.Methods("POST").Path("/v1/drgroups").Handler(f(schemas, s.DRGroupCreate))
+	r.Methods("GET").Path("/v1/drgroups").Handler(f(schemas, s.DRGroupList))
+	r.Methods("GET").Path("/v1/drgroups/{name}").Handler(f(schemas, s.DRGroupGet))
+	r.Methods("DELETE").Path("/v1/drgroups/{name}").Handler(f(schemas, s.DRGroupDelete))
+	drGroupActions := map[string]func(http.ResponseWriter, *http.Request) error{
+		"failover": s.DRGroupFailover,
+		"failback": s.DRGroupFailback,
+	}
+	for name, action := range drGroupActions {
+		r.Methods("POST").Path("/v1/drgroups/{name}").Queries("action", name).Handler(f(schemas, action))
+	}
+
 	r.Methods("POST").Path("/v1/systemrestores").Handler(f(schemas, s.SystemRestoreCreate))
 	r.Methods("GET").Path("/v1/systemrestores").Handler(f(schemas, s.SystemRestoreList))
 	r.Methods("GET").Path("/v1/systemrestores/{name}").Handler(f(schemas, s.SystemRestoreGet))
@@ -239,9 +263,13 @@ func NewRouter(s *Server) *mux.Router {
 	r.Path("/v1/ws/settings").Handler(f(schemas, settingListStream))
 	r.Path("/v1/ws/{period}/settings").Handler(f(schemas, settingListStream))
 
+	// The streams accept the query parameters name, volume and labelSelector to filter the resources, and
+	// delta=true to send the added, modified and deleted resources instead of the full list on every change.
 	volumeListStream := NewStreamHandlerFunc("volumes", s.wsc.NewWatcher("volume", "engine", "replica", "backup"), s.volumeList)
 	r.Path("/v1/ws/volumes").Handler(f(schemas, volumeListStream))
 	r.Path("/v1/ws/{period}/volumes").Handler(f(schemas, volumeListStream))
+	r.Path("/v1/ws/volumes/{name}").Handler(f(schemas, volumeListStream))
+	r.Path("/v1/ws/{period}/volumes/{name}").Handler(f(schemas, volumeListStream))
 
 	recurringJobListStream := NewStreamHandlerFunc("recurringjobs", s.wsc.NewWatcher("recurringJob"), s.recurringJobList)
 	r.Path("/v1/ws/recurringjobs").Handler(f(schemas, recurringJobListStream))
@@ -254,6 +282,8 @@ func NewRouter(s *Server) *mux.Router {
 	nodeListStream := NewStreamHandlerFunc("nodes", s.wsc.NewWatcher("node"), s.nodeList)
 	r.Path("/v1/ws/nodes").Handler(f(schemas, nodeListStream))
 	r.Path("/v1/ws/{period}/nodes").Handler(f(schemas, nodeListStream))
+	r.Path("/v1/ws/nodes/{name}").Handler(f(schemas, nodeListStream))
+	r.Path("/v1/ws/{period}/nodes/{name}").Handler(f(schemas, nodeListStream))
 
 	engineImageStream := NewStreamHandlerFunc("engineimages", s.wsc.NewWatcher("engineImage"), s.engineImageList)
 	r.Path("/v1/ws/engineimages").Handler(f(schemas, engineImageStream))
@@ -270,19 +300,14 @@ func NewRouter(s *Server) *mux.Router {
 	backupVolumeStream := NewStreamHandlerFunc("backupvolumes", s.wsc.NewWatcher("backupVolume"), s.backupVolumeList)
 	r.Path("/v1/ws/backupvolumes").Handler(f(schemas, backupVolumeStream))
 	r.Path("/v1/ws/{period}/backupvolumes").Handler(f(schemas, backupVolumeStream))
+	r.Path("/v1/ws/backupvolumes/{name}").Handler(f(schemas, backupVolumeStream))
+	r.Path("/v1/ws/{period}/backupvolumes/{name}").Handler(f(schemas, backupVolumeStream))
 
 	backupTargetStream := NewStreamHandlerFunc("backuptargets", s.wsc.NewWatcher("backupTarget"), s.backupTargetList)
 	r.Path("/v1/ws/backuptargets").Handler(f(schemas, backupTargetStream))
 	r.Path("/v1/ws/{period}/backuptargets").Handler(f(schemas, backupTargetStream))
 
-	// TODO:
-	// We haven't found a way to allow passing the volume name as a parameter to filter
-	// per-backup volume's backups change thru. WebSocket endpoint. Either by:
-	// - `/v1/ws/backups/{volName}`
-	// - `/v1/ws/backups?volName=<volName>`
-	// - `/v1/ws/backupvolumes/{backupName}`
-	// Once we enhance this part, the WebSocket endpoint could only send the updates of specific
-	// backup volume changes and decrease the traffic data it sends out.
+	// The backups of a volume are filtered by `/v1/ws/backups?volume=<volName>`
 	backupStream := NewStreamHandlerFunc("backups", s.wsc.NewWatcher("backup"), s.backupListAll)
 	r.Path("/v1/ws/backups").Handler(f(schemas, backupStream))
 	r.Path("/v1/ws/{period}/backups").Handler(f(schemas, backupStream))
@@ -1,12 +1,15 @@
 package api
 
 import (
+	"fmt"
 	"math/rand"
 	"net/http"
 	"reflect"
+	"sort"
 	"strconv"
 	"time"
 
+	"github.com/cockroachdb/errors"
 	"github.com/gorilla/mux"
 	"github.com/gorilla/websocket"
 	"github.com/sirupsen/logrus"
@@ -14,6 +17,8 @@ import (
 	"github.com/rancher/go-rancher/api"
 	"github.com/rancher/go-rancher/client"
 
+	"k8s.io/apimachinery/pkg/labels"
+
 	"github.com/longhorn/longhorn-manager/controller"
 )
 
@@ -36,6 +41,13 @@ func init() {
 
 func NewStreamHandlerFunc(streamType string, watcher *controller.Watcher, listFunc func(ctx *api.ApiContext) (*client.GenericCollection, error)) func(w http.ResponseWriter, r *http.Request) error {
 	return func(w http.ResponseWriter, r *http.Request) error {
+		// The request can only be rejected with a status code before it is upgraded to a WebSocket connection
+		filter, err := newStreamFilter(r)
+		if err != nil {
+			writeErr(w, r, err, http.StatusBadRequest)
+			return nil
+		}
+
 		conn, err := upgrader.Upgrade(w, r, nil)
 		if err != nil {
 			return err
@@ -60,8 +72,15 @@ func NewStreamHandlerFunc(streamType string, watcher *controller.Watcher, listFu
 
 		apiContext := api.GetApiContext(r)
 
-		resp, err := writeList(conn, nil, listFunc, apiContext)
-		if err != nil {
+		sw := &streamWriter{
+			conn:       conn,
+			listFunc:   listFunc,
+			apiContext: apiContext,
+			filter:     filter,
+			delta:      isDeltaStream(r),
+		}
+
+		if err := sw.write(); err != nil {
 			return err
 		}
 
@@ -79,13 +98,13 @@ func NewStreamHandlerFunc(streamType string, watcher *controller.Watcher, listFu
 			case <-done:
 				return nil
 			case <-watcher.Events():
-				resp, err = writeList(conn, resp, listFunc, apiContext)
+				err = sw.write()
 			case <-keepAliveTicker.C:
 				err = conn.WriteControl(websocket.PingMessage, []byte{}, time.Now().Add(writeWait))
 				// WebsocketController doesn't include eventInformer so it only
 				// gets triggered here.
 				if streamType == "events" {
-					resp, err = writeList(conn, resp, listFunc, apiContext)
+					err = sw.write()
 				}
 			}
 			if err != nil {
@@ -95,30 +114,191 @@ func NewStreamHandlerFunc(streamType string, watcher *controller.Watcher, listFu
 	}
 }
 
-func writeList(conn *websocket.Conn, oldResp *client.GenericCollection, listFunc func(ctx *api.ApiContext) (*client.GenericCollection, error), apiContext *api.ApiContext) (*client.GenericCollection, error) {
-	newResp, err := listFunc(apiContext)
+// StreamDelta is sent by the streams in delta mode instead of the full collection. The first delta of a stream
+// contains all resources as added. The sequence is a counter of the deltas sent by the stream, unrelated to the
+// Kubernetes resource versions, so that the client can apply the deltas in order.
+type StreamDelta struct {
+	Type         string                   `json:"type"`
+	ResourceType string                   `json:"resourceType"`
+	Sequence     int64                    `json:"sequence"`
+	Added        []map[string]interface{} `json:"added"`
+	Modified     []map[string]interface{} `json:"modified"`
+	Deleted      []map[string]interface{} `json:"deleted"`
+}
+
+// streamWriter writes the list of the resources to the WebSocket connection when the list is changed
+type streamWriter struct {
+	conn       *websocket.Conn
+	listFunc   func(ctx *api.ApiContext) (*client.GenericCollection, error)
+	apiContext *api.ApiContext
+	filter     *streamFilter
+	delta      bool
+
+	lastResp      *client.GenericCollection
+	lastResources map[string]map[string]interface{}
+	sequence      int64
+}
+
+func (sw *streamWriter) write() error {
+	newResp, err := sw.listFunc(sw.apiContext)
 	if err != nil {
-		return oldResp, err
+		return err
 	}
 
-	if oldResp != nil && reflect.DeepEqual(oldResp, newResp) {
-		return oldResp, nil
+	if sw.lastResp != nil && reflect.DeepEqual(sw.lastResp, newResp) {
+		return nil
 	}
-	data, err := apiContext.PopulateCollection(newResp)
+	data, err := sw.apiContext.PopulateCollection(newResp)
 	if err != nil {
-		return oldResp, err
+		return err
 	}
 
-	err = conn.SetWriteDeadline(time.Now().Add(writeWait))
-	if err != nil {
-		return oldResp, err
+	resources, _ := data["data"].([]map[string]interface{})
+	if !sw.filter.isEmpty() {
+		filtered := []map[string]interface{}{}
+		for _, resource := range resources {
+			if sw.filter.match(resource) {
+				filtered = append(filtered, resource)
+			}
+		}
+		resources = filtered
+		data["data"] = resources
 	}
-	err = conn.WriteJSON(data)
-	if err != nil {
-		return oldResp, err
+
+	current := getResourcesByID(resources)
+
+	var msg interface{} = data
+	if !sw.delta && !sw.filter.isEmpty() && sw.lastResources != nil && reflect.DeepEqual(sw.lastResources, current) {
+		// The changes are out of the filtered resources
+		sw.lastResp = newResp
+		return nil
+	}
+	if sw.delta {
+		delta := sw.getDelta(resources, current)
+		if delta == nil {
+			sw.lastResp = newResp
+			return nil
+		}
+		delta.ResourceType, _ = data["resourceType"].(string)
+		msg = delta
+	}
+
+	if err := sw.conn.SetWriteDeadline(time.Now().Add(writeWait)); err != nil {
+		return err
+	}
+	if err := sw.conn.WriteJSON(msg); err != nil {
+		return err
+	}
+
+	sw.lastResp = newResp
+	sw.lastResources = current
+	return nil
+}
+
+// getDelta returns the delta between the last sent resources and the current ones, or nil if there is no change
+// after the first delta.
+func (sw *streamWriter) getDelta(resources []map[string]interface{}, current map[string]map[string]interface{}) *StreamDelta {
+	delta := &StreamDelta{
+		Type:     "delta",
+		Added:    []map[string]interface{}{},
+		Modified: []map[string]interface{}{},
+		Deleted:  []map[string]interface{}{},
+	}
+
+	for _, resource := range resources {
+		id, _ := resource["id"].(string)
+		lastResource, ok := sw.lastResources[id]
+		if !ok {
+			delta.Added = append(delta.Added, resource)
+		} else if !reflect.DeepEqual(lastResource, resource) {
+			delta.Modified = append(delta.Modified, resource)
+		}
 	}
+	for id, lastResource := range sw.lastResources {
+		if _, ok := current[id]; !ok {
+			delta.Deleted = append(delta.Deleted, lastResource)
+		}
+	}
+	sort.Slice(delta.Deleted, func(i, j int) bool {
+		return fmt.Sprint(delta.Deleted[i]["id"]) < fmt.Sprint(delta.Deleted[j]["id"])
+	})
+
+	if sw.lastResources != nil && len(delta.Added) == 0 && len(delta.Modified) == 0 && len(delta.Deleted) == 0 {
+		return nil
+	}
+
+	sw.sequence++
+	delta.Sequence = sw.sequence
+	return delta
+}
+
+func getResourcesByID(resources []map[string]interface{}) map[string]map[string]interface{} {
+	resourcesByID := make(map[string]map[string]interface{}, len(resources))
+	for _, resource := range resources {
+		id, _ := resource["id"].(string)
+		resourcesByID[id] = resource
+	}
+	return resourcesByID
+}
+
+// streamFilter selects the resources sent by a stream by:
+//   - the resource name in the path /v1/ws/<resources>/{name} or the query parameter name
+//   - the volume name of the resource in the query parameter volume, for example /v1/ws/backups?volume=vol-1
+//   - the label selector of the resource labels in the query parameter labelSelector
+type streamFilter struct {
+	name          string
+	volumeName    string
+	labelSelector labels.Selector
+}
+
+func newStreamFilter(r *http.Request) (*streamFilter, error) {
+	query := r.URL.Query()
+
+	filter := &streamFilter{
+		name:       mux.Vars(r)["name"],
+		volumeName: query.Get("volume"),
+	}
+	if filter.name == "" {
+		filter.name = query.Get("name")
+	}
+	if selector := query.Get("labelSelector"); selector != "" {
+		labelSelector, err := labels.Parse(selector)
+		if err != nil {
+			return nil, errors.Wrapf(err, "invalid label selector %v", selector)
+		}
+		filter.labelSelector = labelSelector
+	}
+	return filter, nil
+}
+
+func (f *streamFilter) isEmpty() bool {
+	return f.name == "" && f.volumeName == "" && f.labelSelector == nil
+}
+
+func (f *streamFilter) match(resource map[string]interface{}) bool {
+	if f.name != "" && resource["id"] != f.name {
+		return false
+	}
+	if f.volumeName != "" && resource["volumeName"] != f.volumeName {
+		return false
+	}
+	if f.labelSelector != nil {
+		resourceLabels := labels.Set{}
+		if m, ok := resource["labels"].(map[string]interface{}); ok {
+			for k, v := range m {
+				resourceLabels[k] = fmt.Sprint(v)
+			}
+		}
+		if !f.labelSelector.Matches(resourceLabels) {
+			return false
+		}
+	}
+	return true
+}
 
-	return newResp, nil
+func isDeltaStream(r *http.Request) bool {
+	delta, _ := strconv.ParseBool(r.URL.Query().Get("delta"))
+	return delta
 }
 
 func maybeNewTicker(d time.Duration) *time.Ticker {
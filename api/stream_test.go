@@ -0,0 +1,96 @@
+package api
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestStreamFilter(t *testing.T) {
+	resources := []map[string]interface{}{
+		{"id": "backup-1", "volumeName": "vol-1", "labels": map[string]interface{}{"app": "db"}},
+		{"id": "backup-2", "volumeName": "vol-1", "labels": map[string]interface{}{"app": "web"}},
+		{"id": "backup-3", "volumeName": "vol-2"},
+	}
+
+	tests := []struct {
+		name     string
+		target   string
+		expected []string
+	}{
+		{
+			name:     "no filter",
+			target:   "/v1/ws/backups",
+			expected: []string{"backup-1", "backup-2", "backup-3"},
+		},
+		{
+			name:     "filter by name",
+			target:   "/v1/ws/backups?name=backup-2",
+			expected: []string{"backup-2"},
+		},
+		{
+			name:     "filter by volume",
+			target:   "/v1/ws/backups?volume=vol-1",
+			expected: []string{"backup-1", "backup-2"},
+		},
+		{
+			name:     "filter by label selector",
+			target:   "/v1/ws/backups?labelSelector=app%3Ddb",
+			expected: []string{"backup-1"},
+		},
+		{
+			name:     "filter by volume and label selector",
+			target:   "/v1/ws/backups?volume=vol-2&labelSelector=app",
+			expected: []string{},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			filter, err := newStreamFilter(httptest.NewRequest(http.MethodGet, tt.target, nil))
+			assert.NoError(t, err)
+
+			matched := []string{}
+			for _, resource := range resources {
+				if filter.match(resource) {
+					matched = append(matched, resource["id"].(string))
+				}
+			}
+			assert.Equal(t, tt.expected, matched)
+		})
+	}
+
+	_, err := newStreamFilter(httptest.NewRequest(http.MethodGet, "/v1/ws/backups?labelSelector=app%3D%3D%3D", nil))
+	assert.Error(t, err)
+}
+
+func TestStreamWriterGetDelta(t *testing.T) {
+	sw := &streamWriter{delta: true}
+
+	resources := []map[string]interface{}{
+		{"id": "vol-1", "state": "attached"},
+		{"id": "vol-2", "state": "detached"},
+	}
+	delta := sw.getDelta(resources, getResourcesByID(resources))
+	assert.NotNil(t, delta)
+	assert.Equal(t, int64(1), delta.Sequence)
+	assert.Len(t, delta.Added, 2)
+	assert.Empty(t, delta.Modified)
+	assert.Empty(t, delta.Deleted)
+	sw.lastResources = getResourcesByID(resources)
+
+	assert.Nil(t, sw.getDelta(resources, getResourcesByID(resources)))
+
+	resources = []map[string]interface{}{
+		{"id": "vol-1", "state": "detached"},
+		{"id": "vol-3", "state": "detached"},
+	}
+	delta = sw.getDelta(resources, getResourcesByID(resources))
+	assert.NotNil(t, delta)
+	assert.Equal(t, int64(2), delta.Sequence)
+	assert.Equal(t, []map[string]interface{}{{"id": "vol-3", "state": "detached"}}, delta.Added)
+	assert.Equal(t, []map[string]interface{}{{"id": "vol-1", "state": "detached"}}, delta.Modified)
+	assert.Equal(t, []map[string]interface{}{{"id": "vol-2", "state": "detached"}}, delta.Deleted)
+}
@@ -14,6 +14,7 @@ import (
 	apierrors "k8s.io/apimachinery/pkg/api/errors"
 
 	"github.com/longhorn/longhorn-manager/datastore"
+	"github.com/longhorn/longhorn-manager/types"
 	"github.com/longhorn/longhorn-manager/util"
 
 	longhorn "github.com/longhorn/longhorn-manager/k8s/pkg/apis/longhorn/v1beta2"
@@ -138,48 +139,72 @@ func (s *Server) VolumeCreate(rw http.ResponseWriter, req *http.Request) error {
 		}
 	}
 
+	spec, err := s.toVolumeSpec(&volume)
+	if err != nil {
+		return err
+	}
+
+	v, err := s.m.Create(volume.Name, spec, volume.RecurringJobSelector)
+	if err != nil {
+		return errors.Wrap(err, "failed to create volume")
+	}
+	return s.responseWithVolume(rw, req, "", v)
+}
+
+// toVolumeSpec validates the volume creation input and converts it to a volume spec.
+func (s *Server) toVolumeSpec(volume *Volume) (*longhorn.VolumeSpec, error) {
 	size, err := util.ConvertSize(volume.Size)
 	if err != nil {
-		return fmt.Errorf("failed to parse size %v", err)
+		return nil, fmt.Errorf("failed to parse size %v", err)
 	}
 
 	// Check DiskSelector.
 	diskTags, err := s.m.GetDiskTags()
 	if err != nil {
-		return errors.Wrap(err, "failed to get all disk tags")
+		return nil, errors.Wrap(err, "failed to get all disk tags")
 	}
 	sort.Strings(diskTags)
 	for _, selector := range volume.DiskSelector {
 		if index := sort.SearchStrings(diskTags, selector); index >= len(diskTags) || diskTags[index] != selector {
-			return fmt.Errorf("specified disk tag %v does not exist", selector)
+			return nil, fmt.Errorf("specified disk tag %v does not exist", selector)
 		}
 	}
 
+	// Check DiskTiers.
+	diskTiers := []longhorn.DiskTier{}
+	for _, tier := range volume.DiskTiers {
+		diskTiers = append(diskTiers, longhorn.DiskTier(tier))
+	}
+	if err := types.ValidateDiskTiers(diskTiers); err != nil {
+		return nil, err
+	}
+
 	// Check NodeSelector.
 	nodeTags, err := s.m.GetNodeTags()
 	if err != nil {
-		return errors.Wrap(err, "failed to get all node tags")
+		return nil, errors.Wrap(err, "failed to get all node tags")
 	}
 	sort.Strings(nodeTags)
 	for _, selector := range volume.NodeSelector {
 		if index := sort.SearchStrings(nodeTags, selector); index >= len(nodeTags) || nodeTags[index] != selector {
-			return fmt.Errorf("specified node tag %v does not exist", selector)
+			return nil, fmt.Errorf("specified node tag %v does not exist", selector)
 		}
 	}
 
 	snapshotMaxSize, err := util.ConvertSize(volume.SnapshotMaxSize)
 	if err != nil {
-		return errors.Wrap(err, "failed to parse snapshot max size")
+		return nil, errors.Wrap(err, "failed to parse snapshot max size")
 	}
 
 	backupBlockSize, err := util.ConvertSize(volume.BackupBlockSize)
 	if err != nil {
-		return errors.Wrapf(err, "failed to parse backup block size %v", volume.BackupBlockSize)
+		return nil, errors.Wrapf(err, "failed to parse backup block size %v", volume.BackupBlockSize)
 	}
 
-	v, err := s.m.Create(volume.Name, &longhorn.VolumeSpec{
+	return &longhorn.VolumeSpec{
 		Size:                            size,
 		AccessMode:                      volume.AccessMode,
+		NFSMountOptions:                 volume.NFSMountOptions,
 		Migratable:                      volume.Migratable,
 		Encrypted:                       volume.Encrypted,
 		Frontend:                        volume.Frontend,
@@ -196,7 +221,9 @@ func (s *Server) VolumeCreate(rw http.ResponseWriter, req *http.Request) error {
 		Standby:                         volume.Standby,
 		RevisionCounterDisabled:         volume.RevisionCounterDisabled,
 		DiskSelector:                    volume.DiskSelector,
+		DiskTiers:                       diskTiers,
 		NodeSelector:                    volume.NodeSelector,
+		ReplicaTopologySpread:           volume.ReplicaTopologySpread,
 		SnapshotDataIntegrity:           volume.SnapshotDataIntegrity,
 		SnapshotMaxCount:                volume.SnapshotMaxCount,
 		SnapshotMaxSize:                 snapshotMaxSize,
@@ -209,15 +236,46 @@ func (s *Server) VolumeCreate(rw http.ResponseWriter, req *http.Request) error {
 		ReplicaSoftAntiAffinity:         volume.ReplicaSoftAntiAffinity,
 		ReplicaZoneSoftAntiAffinity:     volume.ReplicaZoneSoftAntiAffinity,
 		ReplicaDiskSoftAntiAffinity:     volume.ReplicaDiskSoftAntiAffinity,
+		ReplicaSchedulingPolicy:         volume.ReplicaSchedulingPolicy,
 		DataEngine:                      volume.DataEngine,
 		FreezeFilesystemForSnapshot:     volume.FreezeFilesystemForSnapshot,
 		BackupTargetName:                volume.BackupTargetName,
 		OfflineRebuilding:               volume.OfflineRebuilding,
-	}, volume.RecurringJobSelector)
+	}, nil
+}
+
+func (s *Server) VolumeExplainScheduling(rw http.ResponseWriter, req *http.Request) error {
+	id := mux.Vars(req)["name"]
+
+	explanation, err := s.m.ExplainScheduling(id)
 	if err != nil {
-		return errors.Wrap(err, "failed to create volume")
+		return err
 	}
-	return s.responseWithVolume(rw, req, "", v)
+	api.GetApiContext(req).Write(toSchedulingExplanationResource(explanation))
+	return nil
+}
+
+// VolumeExplainSchedulingForSpec explains the replica scheduling of a volume that is not created yet. It accepts the
+// same input as VolumeCreate.
+func (s *Server) VolumeExplainSchedulingForSpec(rw http.ResponseWriter, req *http.Request) error {
+	var volume Volume
+	apiContext := api.GetApiContext(req)
+
+	if err := apiContext.Read(&volume); err != nil {
+		return err
+	}
+
+	spec, err := s.toVolumeSpec(&volume)
+	if err != nil {
+		return err
+	}
+
+	explanation, err := s.m.ExplainSchedulingForSpec(volume.Name, spec)
+	if err != nil {
+		return err
+	}
+	apiContext.Write(toSchedulingExplanationResource(explanation))
+	return nil
 }
 
 func (s *Server) VolumeDelete(rw http.ResponseWriter, req *http.Request) error {
@@ -610,6 +668,28 @@ func (s *Server) VolumeUpdateReplicaDiskSoftAntiAffinity(rw http.ResponseWriter,
 	return s.responseWithVolume(rw, req, "", v)
 }
 
+func (s *Server) VolumeUpdateReplicaSchedulingPolicy(rw http.ResponseWriter, req *http.Request) error {
+	var input UpdateReplicaSchedulingPolicyInput
+	id := mux.Vars(req)["name"]
+
+	apiContext := api.GetApiContext(req)
+	if err := apiContext.Read(&input); err != nil {
+		return errors.Wrap(err, "failed to read ReplicaSchedulingPolicy input")
+	}
+
+	obj, err := util.RetryOnConflictCause(func() (interface{}, error) {
+		return s.m.UpdateReplicaSchedulingPolicy(id, longhorn.ReplicaSchedulingPolicy(input.ReplicaSchedulingPolicy))
+	})
+	if err != nil {
+		return err
+	}
+	v, ok := obj.(*longhorn.Volume)
+	if !ok {
+		return fmt.Errorf("failed to convert to volume %v object", id)
+	}
+	return s.responseWithVolume(rw, req, "", v)
+}
+
 func (s *Server) VolumeActivate(rw http.ResponseWriter, req *http.Request) error {
 	var input ActivateInput
 
@@ -729,6 +809,45 @@ func (s *Server) VolumeFilesystemTrim(rw http.ResponseWriter, req *http.Request)
 	return s.responseWithVolume(rw, req, "", v)
 }
 
+func (s *Server) VolumeFilesystemCheck(rw http.ResponseWriter, req *http.Request) error {
+	id := mux.Vars(req)["name"]
+
+	v, err := s.m.CheckFilesystem(id)
+	if err != nil {
+		return err
+	}
+
+	return s.responseWithVolume(rw, req, "", v)
+}
+
+func (s *Server) VolumeBackupBlocksCheck(rw http.ResponseWriter, req *http.Request) error {
+	id := mux.Vars(req)["name"]
+
+	v, err := s.m.CheckBackupBlocks(id)
+	if err != nil {
+		return err
+	}
+
+	return s.responseWithVolume(rw, req, "", v)
+}
+
+func (s *Server) VolumeSnapshotChecksumCheck(rw http.ResponseWriter, req *http.Request) error {
+	id := mux.Vars(req)["name"]
+
+	obj, err := util.RetryOnConflictCause(func() (interface{}, error) {
+		return s.m.SnapshotChecksumCheck(id)
+	})
+	if err != nil {
+		return err
+	}
+	v, ok := obj.(*longhorn.Volume)
+	if !ok {
+		return fmt.Errorf("failed to convert to volume %v object", id)
+	}
+
+	return s.responseWithVolume(rw, req, "", v)
+}
+
 func (s *Server) PVCreate(rw http.ResponseWriter, req *http.Request) error {
 	var input PVCreateInput
 	id := mux.Vars(req)["name"]
@@ -29,6 +29,7 @@ import (
 	lhtypes "github.com/longhorn/go-common-libs/types"
 
 	"github.com/longhorn/longhorn-manager/api"
+	"github.com/longhorn/longhorn-manager/audit"
 	"github.com/longhorn/longhorn-manager/controller"
 	"github.com/longhorn/longhorn-manager/datastore"
 	"github.com/longhorn/longhorn-manager/manager"
@@ -355,7 +356,7 @@ func startManager(c *cli.Context) error {
 		return err
 	}
 
-	server := api.NewServer(m, wsc)
+	server := api.NewServer(m, wsc, api.NewAccessReviewer(clients.K8s, clients.Namespace), audit.NewLogger(clients.Datastore, currentNodeID))
 	router := http.Handler(api.NewRouter(server))
 	router = util.FilteredLoggingHandler(os.Stdout, router)
 	router = handlers.ProxyHeaders(router)
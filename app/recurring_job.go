@@ -76,6 +76,8 @@ func recurringJob(c *cli.Context) (err error) {
 	switch recurringJob.Spec.Task {
 	case longhorn.RecurringJobTypeSystemBackup:
 		return recurringjob.StartSystemBackupJob(job, recurringJob)
+	case longhorn.RecurringJobTypeVolumeGroupSnapshot, longhorn.RecurringJobTypeVolumeGroupBackup:
+		return recurringjob.StartVolumeGroupJob(job, recurringJob)
 	default:
 		return recurringjob.StartVolumeJobs(job, recurringJob)
 	}
@@ -0,0 +1,264 @@
+package recurringjob
+
+import (
+	"context"
+	"fmt"
+	"math/rand"
+	"sort"
+	"strconv"
+	"time"
+
+	"github.com/cockroachdb/errors"
+
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/client-go/util/retry"
+
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/longhorn/longhorn-manager/types"
+	"github.com/longhorn/longhorn-manager/util"
+
+	longhornclient "github.com/longhorn/longhorn-manager/client"
+	longhorn "github.com/longhorn/longhorn-manager/k8s/pkg/apis/longhorn/v1beta2"
+)
+
+// doRecurringBackupVerify restores a backup of the volume picked by the verification policy into a throwaway volume,
+// optionally checks the filesystem and compares the restored blocks with the block checksums of the backup. The
+// result is recorded on the Backup CR, and the throwaway volume is deleted afterwards.
+func (job *VolumeJob) doRecurringBackupVerify(volume *longhornclient.Volume) (err error) {
+	defer func() {
+		err = errors.Wrapf(err, "failed to verify backup for %v", volume.Name)
+		if err == nil {
+			job.logger.Info("Finished recurring backup verification")
+		}
+	}()
+
+	backups, err := job.listBackupsForVerification(volume.BackupTargetName)
+	if err != nil {
+		return err
+	}
+
+	policy := job.parameters[types.RecurringJobParameterBackupVerifyPolicy]
+	backup := selectBackupForVerification(backups, policy, time.Now())
+	if backup == nil {
+		job.logger.Infof("Skipping backup verification of volume %v since there is no completed backup", volume.Name)
+		return nil
+	}
+
+	job.logger.Infof("Verifying backup %v of volume %v with policy %v", backup.Name, volume.Name, policy)
+	message, verifyErr := job.verifyBackup(volume, backup)
+	if err := job.recordBackupVerification(backup.Name, message, verifyErr); err != nil {
+		return errors.Wrapf(err, "failed to record the verification result of backup %v", backup.Name)
+	}
+	return verifyErr
+}
+
+func (job *VolumeJob) listBackupsForVerification(backupTargetName string) ([]longhorn.Backup, error) {
+	selector := labels.Set(types.GetBackupVolumeWithBackupTargetLabels(backupTargetName, job.volumeName)).String()
+	list, err := job.lhClient.LonghornV1beta2().Backups(job.namespace).List(context.TODO(), metav1.ListOptions{
+		LabelSelector: selector,
+	})
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to list backups by label %v", selector)
+	}
+	return list.Items, nil
+}
+
+// selectBackupForVerification returns the completed backup picked by the policy, or nil if there is no completed backup.
+// The latest backup is picked if the policy is empty.
+func selectBackupForVerification(backups []longhorn.Backup, policy string, now time.Time) *longhorn.Backup {
+	candidates := []NameWithTimestamp{}
+	backupMap := map[string]*longhorn.Backup{}
+	for i := range backups {
+		backup := &backups[i]
+		if backup.Status.State != longhorn.BackupStateCompleted || backup.Status.URL == "" {
+			continue
+		}
+		createdAt := backup.CreationTimestamp.Time
+		if t, err := time.Parse(time.RFC3339, backup.Status.BackupCreatedAt); err == nil {
+			createdAt = t
+		}
+		candidates = append(candidates, NameWithTimestamp{Name: backup.Name, Timestamp: createdAt})
+		backupMap[backup.Name] = backup
+	}
+	if len(candidates) == 0 {
+		return nil
+	}
+
+	sort.Slice(candidates, func(i, j int) bool {
+		return candidates[i].Timestamp.Before(candidates[j].Timestamp)
+	})
+
+	switch policy {
+	case types.BackupVerifyPolicyOldest:
+		return backupMap[candidates[0].Name]
+	case types.BackupVerifyPolicyRandom:
+		r := rand.New(rand.NewSource(now.UnixNano()))
+		return backupMap[candidates[r.Intn(len(candidates))].Name]
+	default:
+		return backupMap[candidates[len(candidates)-1].Name]
+	}
+}
+
+// verifyBackup restores the backup into a throwaway volume and checks the restored data. It returns the message
+// describing the checks that have been done.
+func (job *VolumeJob) verifyBackup(volume *longhornclient.Volume, backup *longhorn.Backup) (string, error) {
+	filesystemCheck := false
+	if value, ok := job.parameters[types.RecurringJobParameterBackupVerifyFilesystemCheck]; ok {
+		var err error
+		if filesystemCheck, err = strconv.ParseBool(value); err != nil {
+			return "", errors.Wrapf(err, "invalid parameter %v", types.RecurringJobParameterBackupVerifyFilesystemCheck)
+		}
+	}
+
+	size, err := strconv.ParseInt(backup.Status.VolumeSize, 10, 64)
+	if err != nil {
+		return "", errors.Wrapf(err, "invalid volume size %v of backup %v", backup.Status.VolumeSize, backup.Name)
+	}
+
+	verifyVolume := &longhorn.Volume{
+		ObjectMeta: metav1.ObjectMeta{
+			Name: "verify-" + sliceStringSafely(job.volumeName, 0, 24) + "-" + util.RandomID(),
+			Labels: map[string]string{
+				types.GetLonghornLabelKey(types.LonghornLabelBackupVerification): backup.Name,
+			},
+		},
+		Spec: longhorn.VolumeSpec{
+			Size:             size,
+			FromBackup:       backup.Status.URL,
+			BackupTargetName: backup.Status.BackupTargetName,
+			BackingImage:     backup.Status.VolumeBackingImageName,
+			NumberOfReplicas: 1,
+			DataEngine:       longhorn.DataEngineType(volume.DataEngine),
+			Frontend:         longhorn.VolumeFrontendBlockDev,
+			AccessMode:       longhorn.AccessModeReadWriteOnce,
+			Encrypted:        volume.Encrypted,
+		},
+	}
+	verifyVolume, err = job.lhClient.LonghornV1beta2().Volumes(job.namespace).Create(context.TODO(), verifyVolume, metav1.CreateOptions{})
+	if err != nil {
+		return "", errors.Wrapf(err, "failed to create volume to restore backup %v", backup.Name)
+	}
+	defer job.deleteBackupVerificationVolume(verifyVolume.Name)
+
+	job.logger.Infof("Restoring backup %v into volume %v", backup.Name, verifyVolume.Name)
+	verifyVolume, err = job.waitForBackupVerificationRestore(verifyVolume.Name, backup.Name)
+	if err != nil {
+		return "", err
+	}
+
+	nodeID := job.parameters[types.RecurringJobParameterBackupVerifyNode]
+	if nodeID == "" {
+		nodeID = verifyVolume.Status.OwnerID
+	}
+	if err := job.attachBackupVerificationVolume(verifyVolume.Name, nodeID); err != nil {
+		return "", err
+	}
+
+	apiVolume, err := job.api.Volume.ById(verifyVolume.Name)
+	if err != nil {
+		return "", errors.Wrapf(err, "failed to get volume %v", verifyVolume.Name)
+	}
+
+	message := fmt.Sprintf("Restored backup on node %v", nodeID)
+	if filesystemCheck {
+		// The manager does not open encrypted volumes, so only their blocks are checked
+		if verifyVolume.Spec.Encrypted {
+			message += ", filesystem check skipped since the volume is encrypted"
+		} else {
+			if _, err := job.api.Volume.ActionCheckFilesystem(apiVolume); err != nil {
+				return "", errors.Wrap(err, "failed to check filesystem of the restored volume")
+			}
+			message += ", filesystem check passed"
+		}
+	}
+
+	if _, err := job.api.Volume.ActionCheckBackupBlocks(apiVolume); err != nil {
+		return "", errors.Wrap(err, "failed to compare the restored volume with the backup block checksums")
+	}
+	return message + ", backup block checksums matched", nil
+}
+
+func (job *VolumeJob) waitForBackupVerificationRestore(volumeName, backupName string) (*longhorn.Volume, error) {
+	startTime := time.Now()
+	for {
+		v, err := job.GetVolume(volumeName)
+		if err != nil {
+			return nil, errors.Wrapf(err, "failed to get volume %v", volumeName)
+		}
+
+		restoreCondition := types.GetCondition(v.Status.Conditions, longhorn.VolumeConditionTypeRestore)
+		if restoreCondition.Reason == longhorn.VolumeConditionReasonRestoreFailure {
+			return nil, fmt.Errorf("failed to restore backup %v: %v", backupName, restoreCondition.Message)
+		}
+		if v.Status.Robustness == longhorn.VolumeRobustnessFaulted {
+			return nil, fmt.Errorf("volume %v is faulted during restoring backup %v", volumeName, backupName)
+		}
+		if v.Status.RestoreInitiated && !v.Status.RestoreRequired && v.Status.LastBackup == backupName &&
+			v.Status.State == longhorn.VolumeStateDetached {
+			return v, nil
+		}
+
+		if time.Since(startTime) > BackupVerifyRestoreTimeout {
+			return nil, fmt.Errorf("timed out waiting for backup %v to be restored into volume %v", backupName, volumeName)
+		}
+		time.Sleep(WaitInterval)
+	}
+}
+
+func (job *VolumeJob) attachBackupVerificationVolume(volumeName, nodeID string) error {
+	apiVolume, err := job.api.Volume.ById(volumeName)
+	if err != nil {
+		return errors.Wrapf(err, "failed to get volume %v", volumeName)
+	}
+	if _, err := job.api.Volume.ActionAttach(apiVolume, &longhornclient.AttachInput{
+		HostId:       nodeID,
+		AttachedBy:   job.name,
+		AttacherType: string(longhorn.AttacherTypeLonghornAPI),
+		AttachmentID: string(longhorn.RecurringJobTypeBackupVerify) + "-" + job.name,
+	}); err != nil {
+		return errors.Wrapf(err, "failed to attach volume %v to node %v", volumeName, nodeID)
+	}
+
+	for i := 0; i < VolumeAttachTimeout; i++ {
+		v, err := job.GetVolume(volumeName)
+		if err != nil {
+			return errors.Wrapf(err, "failed to get volume %v", volumeName)
+		}
+		if v.Status.State == longhorn.VolumeStateAttached && v.Status.CurrentNodeID == nodeID {
+			return nil
+		}
+		time.Sleep(time.Second)
+	}
+	return fmt.Errorf("timed out waiting for volume %v to be attached to node %v", volumeName, nodeID)
+}
+
+func (job *VolumeJob) recordBackupVerification(backupName, message string, verifyErr error) error {
+	result := longhorn.BackupVerificationResultPassed
+	if verifyErr != nil {
+		result = longhorn.BackupVerificationResultFailed
+		message = verifyErr.Error()
+	}
+
+	return retry.RetryOnConflict(retry.DefaultRetry, func() error {
+		backup, err := job.lhClient.LonghornV1beta2().Backups(job.namespace).Get(context.TODO(), backupName, metav1.GetOptions{})
+		if err != nil {
+			return err
+		}
+		backup.Status.LastVerifiedAt = metav1.Time{Time: time.Now().UTC()}
+		backup.Status.VerificationResult = result
+		backup.Status.VerificationMessage = message
+		_, err = job.lhClient.LonghornV1beta2().Backups(job.namespace).UpdateStatus(context.TODO(), backup, metav1.UpdateOptions{})
+		return err
+	})
+}
+
+func (job *VolumeJob) deleteBackupVerificationVolume(volumeName string) {
+	err := job.lhClient.LonghornV1beta2().Volumes(job.namespace).Delete(context.TODO(), volumeName, metav1.DeleteOptions{})
+	if err != nil && !apierrors.IsNotFound(err) {
+		job.logger.WithError(err).Warnf("Failed to delete volume %v of the backup verification", volumeName)
+		return
+	}
+	job.logger.Infof("Deleted volume %v of the backup verification", volumeName)
+}
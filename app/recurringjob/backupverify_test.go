@@ -0,0 +1,83 @@
+package recurringjob
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/longhorn/longhorn-manager/types"
+
+	longhorn "github.com/longhorn/longhorn-manager/k8s/pkg/apis/longhorn/v1beta2"
+)
+
+func TestSelectBackupForVerification(t *testing.T) {
+	base := time.Date(2024, time.March, 31, 23, 0, 0, 0, time.UTC)
+
+	newBackup := func(name string, state longhorn.BackupState, createdAt time.Time) longhorn.Backup {
+		return longhorn.Backup{
+			ObjectMeta: metav1.ObjectMeta{Name: name},
+			Status: longhorn.BackupStatus{
+				State:           state,
+				URL:             "s3://backupbucket@us-east-1/?backup=" + name,
+				BackupCreatedAt: createdAt.Format(time.RFC3339),
+			},
+		}
+	}
+	backups := []longhorn.Backup{
+		newBackup("backup-2", longhorn.BackupStateCompleted, base.Add(-2*time.Hour)),
+		newBackup("backup-1", longhorn.BackupStateCompleted, base.Add(-3*time.Hour)),
+		newBackup("backup-4", longhorn.BackupStateInProgress, base),
+		newBackup("backup-3", longhorn.BackupStateCompleted, base.Add(-1*time.Hour)),
+		newBackup("backup-0", longhorn.BackupStateError, base.Add(-4*time.Hour)),
+	}
+
+	type testCase struct {
+		backups []longhorn.Backup
+		policy  string
+
+		expectedBackups []string
+	}
+
+	for name, tc := range map[string]testCase{
+		"default policy": {
+			backups:         backups,
+			expectedBackups: []string{"backup-3"},
+		},
+		"latest": {
+			backups:         backups,
+			policy:          types.BackupVerifyPolicyLatest,
+			expectedBackups: []string{"backup-3"},
+		},
+		"oldest": {
+			backups:         backups,
+			policy:          types.BackupVerifyPolicyOldest,
+			expectedBackups: []string{"backup-1"},
+		},
+		"random": {
+			backups:         backups,
+			policy:          types.BackupVerifyPolicyRandom,
+			expectedBackups: []string{"backup-1", "backup-2", "backup-3"},
+		},
+		"no completed backup": {
+			backups: []longhorn.Backup{
+				newBackup("backup-4", longhorn.BackupStateInProgress, base),
+			},
+			policy: types.BackupVerifyPolicyLatest,
+		},
+	} {
+		t.Run(name, func(t *testing.T) {
+			assert := assert.New(t)
+
+			backup := selectBackupForVerification(tc.backups, tc.policy, base)
+			if len(tc.expectedBackups) == 0 {
+				assert.Nil(backup)
+				return
+			}
+			assert.NotNil(backup)
+			assert.Contains(tc.expectedBackups, backup.Name)
+		})
+	}
+}
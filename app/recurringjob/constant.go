@@ -8,6 +8,10 @@ const (
 	SnapshotPurgeStatusInterval = 5 * time.Second
 	// SnapshotPurgeStatusTimeout is set to 24 hours because we don't know the appropriate value.
 	SnapshotPurgeStatusTimeout = 24 * time.Hour
+	// BackupVerifyRestoreTimeout is set to 24 hours because restoring a large backup can take a long time.
+	BackupVerifyRestoreTimeout = 24 * time.Hour
+	// VolumeGroupSnapshotTimeout covers the pre/post snapshot hooks of all member volumes besides the snapshots.
+	VolumeGroupSnapshotTimeout = 30 * time.Minute
 
 	WaitInterval              = 5 * time.Second
 	DetachingWaitInterval     = 10 * time.Second
@@ -16,6 +16,7 @@ import (
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 
 	"github.com/longhorn/longhorn-manager/types"
+	"github.com/longhorn/longhorn-manager/util"
 
 	apputil "github.com/longhorn/longhorn-manager/app/util"
 	longhornclient "github.com/longhorn/longhorn-manager/client"
@@ -30,8 +31,9 @@ func NewJob(name string, logger *logrus.Logger, managerURL string, recurringJob
 	}
 
 	clientOpts := &longhornclient.ClientOpts{
-		Url:     managerURL,
-		Timeout: HTTPClientTimout,
+		Url:       managerURL,
+		Timeout:   HTTPClientTimout,
+		SecretKey: util.GetServiceAccountToken(),
 	}
 	apiClient, err := longhornclient.NewRancherClient(clientOpts)
 	if err != nil {
@@ -64,12 +66,13 @@ func NewJob(name string, logger *logrus.Logger, managerURL string, recurringJob
 		eventRecorder: eventBroadcaster.NewRecorder(scheme, corev1.EventSource{Component: "longhorn-recurring-job"}),
 		logger:        logger,
 
-		name:           name,
-		namespace:      namespace,
-		retain:         recurringJob.Spec.Retain,
-		task:           recurringJob.Spec.Task,
-		parameters:     parameters,
-		executionCount: recurringJob.Status.ExecutionCount,
+		name:            name,
+		namespace:       namespace,
+		retain:          recurringJob.Spec.Retain,
+		retentionPolicy: recurringJob.Spec.RetentionPolicy,
+		task:            recurringJob.Spec.Task,
+		parameters:      parameters,
+		executionCount:  recurringJob.Status.ExecutionCount,
 	}, nil
 }
 
@@ -120,3 +123,25 @@ func (job *Job) ListSystemBackup() (*longhorn.SystemBackupList, error) {
 		LabelSelector: label,
 	})
 }
+
+func (job *Job) CreateVolumeGroupSnapshot(groupSnapshot *longhorn.VolumeGroupSnapshot) (*longhorn.VolumeGroupSnapshot, error) {
+	return job.lhClient.LonghornV1beta2().VolumeGroupSnapshots(job.namespace).Create(context.TODO(), groupSnapshot, metav1.CreateOptions{})
+}
+
+func (job *Job) DeleteVolumeGroupSnapshot(name string) error {
+	return job.lhClient.LonghornV1beta2().VolumeGroupSnapshots(job.namespace).Delete(context.TODO(), name, metav1.DeleteOptions{})
+}
+
+func (job *Job) GetVolumeGroupSnapshot(name string) (*longhorn.VolumeGroupSnapshot, error) {
+	return job.lhClient.LonghornV1beta2().VolumeGroupSnapshots(job.namespace).Get(context.TODO(), name, metav1.GetOptions{})
+}
+
+func (job *Job) ListVolumeGroupSnapshot() (*longhorn.VolumeGroupSnapshotList, error) {
+	labelKey := types.GetRecurringJobLabelKey(types.LonghornLabelRecurringJob, string(job.task))
+	label := fmt.Sprintf("%s=%s", labelKey, job.name)
+
+	job.logger.Infof("Getting VolumeGroupSnapshots by label %v", label)
+	return job.lhClient.LonghornV1beta2().VolumeGroupSnapshots(job.namespace).List(context.TODO(), metav1.ListOptions{
+		LabelSelector: label,
+	})
+}
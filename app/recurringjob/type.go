@@ -20,12 +20,13 @@ type Job struct {
 	eventRecorder record.EventRecorder // Used to record events related to the job.
 	logger        *logrus.Logger       // Log messages related to the job.
 
-	name           string                    // Name for the RecurringJob.
-	namespace      string                    // Kubernetes namespace in which the RecurringJob is running.
-	retain         int                       // Number of task CRs to retain.
-	task           longhorn.RecurringJobType // Type of task to be executed.
-	parameters     map[string]string         // Additional parameters for the task.
-	executionCount int                       // Number of times the job has been executed.
+	name            string                                // Name for the RecurringJob.
+	namespace       string                                // Kubernetes namespace in which the RecurringJob is running.
+	retain          int                                   // Number of task CRs to retain.
+	retentionPolicy *longhorn.RecurringJobRetentionPolicy // GFS retention policy of the task CRs.
+	task            longhorn.RecurringJobType             // Type of task to be executed.
+	parameters      map[string]string                     // Additional parameters for the task.
+	executionCount  int                                   // Number of times the job has been executed.
 }
 
 // VolumeJob is a job for volume tasks.
@@ -53,6 +54,19 @@ type SystemBackupJob struct {
 	volumeBackupPolicy longhorn.SystemBackupCreateVolumeBackupPolicy // backup policy used for the SystemBackup.Spec.
 }
 
+// VolumeGroupJob is a job for volume group tasks.
+// It embeds the Job struct and includes additional fields specific to volume group snapshot operations.
+type VolumeGroupJob struct {
+	*Job // Embedding the base Job struct.
+
+	logger *logrus.Entry // Log messages related to the volume group job.
+
+	volumeNames       []string          // Names of the member volumes of the group.
+	groupSnapshotName string            // Name of the VolumeGroupSnapshot.
+	specLabels        map[string]string // A map of labels from the RecurringJob.Spec.
+	concurrent        int               // Number of concurrent backups allowed for the job.
+}
+
 // NameWithTimestamp for resource cleanup.
 type NameWithTimestamp struct {
 	Name      string
@@ -63,6 +63,74 @@ func filterExpiredItems(nts []NameWithTimestamp, retainCount int) []string {
 	return ret
 }
 
+// filterExpiredItemsWithRetentionPolicy returns a list of names from the input nts excluding the latest retainCount names
+// and the names kept by the GFS (grandfather-father-son) retention policy. For each hourly, daily, weekly, monthly and
+// yearly bucket of the policy, the latest item of each of the latest N periods (in UTC) is kept.
+func filterExpiredItemsWithRetentionPolicy(nts []NameWithTimestamp, retainCount int, policy *longhorn.RecurringJobRetentionPolicy) []string {
+	if policy == nil || *policy == (longhorn.RecurringJobRetentionPolicy{}) {
+		return filterExpiredItems(nts, retainCount)
+	}
+
+	// Sort from the latest to the oldest, so the first item seen in a period is the latest one of the period.
+	sort.Slice(nts, func(i, j int) bool {
+		return nts[i].Timestamp.After(nts[j].Timestamp)
+	})
+
+	retained := map[string]struct{}{}
+	for i := 0; i < len(nts) && i < retainCount; i++ {
+		retained[nts[i].Name] = struct{}{}
+	}
+
+	buckets := []struct {
+		count     int
+		periodKey func(t time.Time) string
+	}{
+		{policy.Hourly, func(t time.Time) string { return t.Format("2006-01-02T15") }},
+		{policy.Daily, func(t time.Time) string { return t.Format("2006-01-02") }},
+		{policy.Weekly, func(t time.Time) string {
+			year, week := t.ISOWeek()
+			return fmt.Sprintf("%d-W%02d", year, week)
+		}},
+		{policy.Monthly, func(t time.Time) string { return t.Format("2006-01") }},
+		{policy.Yearly, func(t time.Time) string { return t.Format("2006") }},
+	}
+	for _, bucket := range buckets {
+		periods := map[string]struct{}{}
+		for _, nt := range nts {
+			if len(periods) >= bucket.count {
+				break
+			}
+			key := bucket.periodKey(nt.Timestamp.UTC())
+			if _, ok := periods[key]; ok {
+				continue
+			}
+			periods[key] = struct{}{}
+			retained[nt.Name] = struct{}{}
+		}
+	}
+
+	ret := []string{}
+	for i := len(nts) - 1; i >= 0; i-- {
+		if _, ok := retained[nts[i].Name]; !ok {
+			ret = append(ret, nts[i].Name)
+		}
+	}
+	return ret
+}
+
+// isBackupRetentionLocked returns true if the backup cannot be deleted from the backup target yet
+func isBackupRetentionLocked(backup longhornclient.Backup) bool {
+	if backup.RetentionLockedUntil == "" {
+		return false
+	}
+	lockedUntil, err := time.Parse(time.RFC3339, backup.RetentionLockedUntil)
+	if err != nil {
+		logrus.WithError(err).Warnf("Failed to parse the retention lock time %v of backup %v", backup.RetentionLockedUntil, backup.Name)
+		return false
+	}
+	return time.Now().Before(lockedUntil)
+}
+
 func snapshotCRsToNameWithTimestamps(snapshotCRs []longhornclient.SnapshotCR) []NameWithTimestamp {
 	result := []NameWithTimestamp{}
 	for _, snapshotCR := range snapshotCRs {
@@ -101,6 +169,11 @@ func filterVolumesForJob(allowDetached bool, volumes []longhorn.Volume, filterNa
 			continue
 		}
 
+		if _, ok := volume.Labels[types.GetLonghornLabelKey(types.LonghornLabelBackupVerification)]; ok {
+			logger.Infof("Bypassed to create job for %v volume of the backup verification", volume.Name)
+			continue
+		}
+
 		if volume.Status.Robustness != longhorn.VolumeRobustnessFaulted &&
 			(volume.Status.State == longhorn.VolumeStateAttached || allowDetached) {
 			*filterNames = append(*filterNames, volume.Name)
@@ -166,3 +239,14 @@ func systemBackupsToNameWithTimestamps(systemBackupList *longhorn.SystemBackupLi
 	}
 	return result
 }
+
+func volumeGroupSnapshotsToNameWithTimestamps(groupSnapshotList *longhorn.VolumeGroupSnapshotList) []NameWithTimestamp {
+	result := []NameWithTimestamp{}
+	for _, groupSnapshot := range groupSnapshotList.Items {
+		result = append(result, NameWithTimestamp{
+			Name:      groupSnapshot.Name,
+			Timestamp: groupSnapshot.CreationTimestamp.Time,
+		})
+	}
+	return result
+}
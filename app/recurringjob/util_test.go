@@ -0,0 +1,89 @@
+package recurringjob
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	longhorn "github.com/longhorn/longhorn-manager/k8s/pkg/apis/longhorn/v1beta2"
+)
+
+func TestFilterExpiredItemsWithRetentionPolicy(t *testing.T) {
+	base := time.Date(2024, time.March, 31, 23, 0, 0, 0, time.UTC)
+
+	// newHourlyItems returns items created every hour in the count hours before base, in random order
+	newHourlyItems := func(count int) []NameWithTimestamp {
+		nts := []NameWithTimestamp{}
+		for i := count - 1; i >= 0; i-- {
+			ts := base.Add(-time.Duration(i) * time.Hour)
+			nts = append(nts, NameWithTimestamp{Name: ts.Format(time.RFC3339), Timestamp: ts})
+		}
+		nts[0], nts[len(nts)-1] = nts[len(nts)-1], nts[0]
+		return nts
+	}
+
+	type testCase struct {
+		nts         []NameWithTimestamp
+		retainCount int
+		policy      *longhorn.RecurringJobRetentionPolicy
+
+		expectedExpiredCount int
+		expectedRetained     []string
+	}
+
+	for name, tc := range map[string]testCase{
+		"no retention policy": {
+			nts:                  newHourlyItems(10),
+			retainCount:          3,
+			expectedExpiredCount: 7,
+			expectedRetained:     []string{"2024-03-31T23:00:00Z", "2024-03-31T22:00:00Z", "2024-03-31T21:00:00Z"},
+		},
+		"empty retention policy": {
+			nts:                  newHourlyItems(10),
+			retainCount:          3,
+			policy:               &longhorn.RecurringJobRetentionPolicy{},
+			expectedExpiredCount: 7,
+		},
+		"hourly and daily": {
+			nts:         newHourlyItems(72),
+			retainCount: 1,
+			policy:      &longhorn.RecurringJobRetentionPolicy{Hourly: 4, Daily: 3},
+			// The latest 4 hours plus the latest item of 2024-03-30 and 2024-03-29.
+			expectedExpiredCount: 72 - 6,
+			expectedRetained: []string{
+				"2024-03-31T23:00:00Z", "2024-03-31T22:00:00Z", "2024-03-31T21:00:00Z", "2024-03-31T20:00:00Z",
+				"2024-03-30T23:00:00Z", "2024-03-29T23:00:00Z",
+			},
+		},
+		"weekly, monthly and yearly": {
+			nts:         newHourlyItems(24 * 40),
+			retainCount: 0,
+			policy:      &longhorn.RecurringJobRetentionPolicy{Weekly: 2, Monthly: 2, Yearly: 5},
+			// The latest item of the ISO weeks 2024-W13 and 2024-W12, of March and February, and of 2024.
+			expectedExpiredCount: 24*40 - 3,
+			expectedRetained:     []string{"2024-03-31T23:00:00Z", "2024-03-24T23:00:00Z", "2024-02-29T23:00:00Z"},
+		},
+		"retain count and policy overlap": {
+			nts:                  newHourlyItems(5),
+			retainCount:          10,
+			policy:               &longhorn.RecurringJobRetentionPolicy{Daily: 7},
+			expectedExpiredCount: 0,
+		},
+	} {
+		t.Run(name, func(t *testing.T) {
+			assert := assert.New(t)
+
+			expired := filterExpiredItemsWithRetentionPolicy(tc.nts, tc.retainCount, tc.policy)
+			assert.Len(expired, tc.expectedExpiredCount)
+			for _, retained := range tc.expectedRetained {
+				assert.NotContains(expired, retained)
+			}
+
+			// The expired items are returned from the oldest to the latest
+			for i := 1; i < len(expired); i++ {
+				assert.Less(expired[i-1], expired[i])
+			}
+		})
+	}
+}
@@ -24,31 +24,12 @@ import (
 )
 
 func StartVolumeJobs(job *Job, recurringJob *longhorn.RecurringJob) error {
-	allowDetachedSetting := types.SettingNameAllowRecurringJobWhileVolumeDetached
-	allowDetached, err := getSettingAsBoolean(allowDetachedSetting, job.namespace, job.lhClient)
-	if err != nil {
-		return errors.Wrapf(err, "failed to get %v setting", allowDetachedSetting)
-	}
-	job.logger.Infof("Setting %v is %v", allowDetachedSetting, allowDetached)
-
-	volumes, err := getVolumesBySelector(types.LonghornLabelRecurringJob, job.name, job.namespace, job.lhClient)
+	filteredVolumes, err := getVolumesForJob(job, recurringJob)
 	if err != nil {
 		return err
 	}
 
-	filteredVolumes := []string{}
-	filterVolumesForJob(allowDetached, volumes, &filteredVolumes)
-
 	jobGroups := recurringJob.Spec.Groups
-	for _, jobGroup := range jobGroups {
-		volumes, err := getVolumesBySelector(types.LonghornLabelRecurringJobGroup, jobGroup, job.namespace, job.lhClient)
-		if err != nil {
-			return err
-		}
-		filterVolumesForJob(allowDetached, volumes, &filteredVolumes)
-	}
-
-	job.logger.Infof("Found %v volumes with recurring job %v", len(filteredVolumes), job.name)
 
 	concurrentLimiter := make(chan struct{}, recurringJob.Spec.Concurrency)
 	ewg := &errgroup.Group{}
@@ -67,6 +48,35 @@ func StartVolumeJobs(job *Job, recurringJob *longhorn.RecurringJob) error {
 	return err
 }
 
+// getVolumesForJob returns the volumes applied with the recurring job directly or through the job groups
+func getVolumesForJob(job *Job, recurringJob *longhorn.RecurringJob) ([]string, error) {
+	allowDetachedSetting := types.SettingNameAllowRecurringJobWhileVolumeDetached
+	allowDetached, err := getSettingAsBoolean(allowDetachedSetting, job.namespace, job.lhClient)
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to get %v setting", allowDetachedSetting)
+	}
+	job.logger.Infof("Setting %v is %v", allowDetachedSetting, allowDetached)
+
+	volumes, err := getVolumesBySelector(types.LonghornLabelRecurringJob, job.name, job.namespace, job.lhClient)
+	if err != nil {
+		return nil, err
+	}
+
+	filteredVolumes := []string{}
+	filterVolumesForJob(allowDetached, volumes, &filteredVolumes)
+
+	for _, jobGroup := range recurringJob.Spec.Groups {
+		volumes, err := getVolumesBySelector(types.LonghornLabelRecurringJobGroup, jobGroup, job.namespace, job.lhClient)
+		if err != nil {
+			return nil, err
+		}
+		filterVolumesForJob(allowDetached, volumes, &filteredVolumes)
+	}
+
+	job.logger.Infof("Found %v volumes with recurring job %v", len(filteredVolumes), job.name)
+	return filteredVolumes, nil
+}
+
 func startVolumeJob(job *Job, recurringJob *longhorn.RecurringJob,
 	volumeName string, concurrentLimiter chan struct{}, jobGroups []string) error {
 
@@ -172,6 +182,14 @@ func (job *VolumeJob) run() (err error) {
 		job.logger.Infof("Running recurring filesystem trim for volume %v", volumeName)
 		return job.doRecurringFilesystemTrim(volume)
 
+	case longhorn.RecurringJobTypeSnapshotChecksumCheck:
+		job.logger.Infof("Running recurring snapshot checksum check for volume %v", volumeName)
+		return job.doRecurringSnapshotChecksumCheck(volume)
+
+	case longhorn.RecurringJobTypeBackupVerify:
+		job.logger.Infof("Running recurring backup verification for volume %v", volumeName)
+		return job.doRecurringBackupVerify(volume)
+
 	case longhorn.RecurringJobTypeBackup, longhorn.RecurringJobTypeBackupForceCreate:
 		job.logger.Infof("Running recurring backup for volume %v", volumeName)
 		return job.doRecurringBackup()
@@ -424,9 +442,10 @@ func (job *VolumeJob) filterExpiredSnapshotsOfCurrentRecurringJob(snapshotCRs []
 		return []string{}
 	}
 
-	// For recurring snapshot job and AutoCleanupRecurringJobBackupSnapshot is disabled, keeps the number of the snapshots as job.retain.
+	// For recurring snapshot job and AutoCleanupRecurringJobBackupSnapshot is disabled, keeps the number of the snapshots as job.retain
+	// in addition to the snapshots kept by job.retentionPolicy.
 	if job.task == longhorn.RecurringJobTypeSnapshot || job.task == longhorn.RecurringJobTypeSnapshotForceCreate || !allowBackupSnapshotDeleted {
-		return filterExpiredItems(snapshotCRsToNameWithTimestamps(snapshotCRs), job.retain)
+		return filterExpiredItemsWithRetentionPolicy(snapshotCRsToNameWithTimestamps(snapshotCRs), job.retain, job.retentionPolicy)
 	}
 
 	// For the recurring backup job, only keep the snapshot of the last backup and the current snapshot when AutoCleanupRecurringJobBackupSnapshot is enabled.
@@ -468,6 +487,12 @@ func (job *VolumeJob) doRecurringBackup() (err error) {
 		return err
 	}
 
+	return job.doBackup(volume, true)
+}
+
+// doBackup backs up the snapshot of the job and waits for the backup to complete, then cleans up the expired backups
+// of the job. The expired snapshots of the job are cleaned up as well if cleanupSnapshots is true.
+func (job *VolumeJob) doBackup(volume *longhornclient.Volume, cleanupSnapshots bool) (err error) {
 	backupMode := longhorn.BackupModeIncremental
 	if intervalStr, exists := job.parameters[types.RecurringJobParameterFullBackupInterval]; exists {
 		interval, err := strconv.Atoi(intervalStr)
@@ -562,6 +587,9 @@ func (job *VolumeJob) doRecurringBackup() (err error) {
 		job.logger.Infof("Cleaned up backup %v for %v", backup, job.volumeName)
 	}
 
+	if !cleanupSnapshots {
+		return nil
+	}
 	if err := job.doSnapshotCleanup(true); err != nil {
 		return err
 	}
@@ -600,6 +628,28 @@ func (job *VolumeJob) doRecurringFilesystemTrim(volume *longhornclient.Volume) (
 	return job.purgeSnapshots(volume, volumeAPI)
 }
 
+func (job *VolumeJob) doRecurringSnapshotChecksumCheck(volume *longhornclient.Volume) (err error) {
+	defer func() {
+		err = errors.Wrapf(err, "failed to request snapshot checksum check for %v", volume.Name)
+		if err == nil {
+			job.logger.Info("Finished recurring snapshot checksum check")
+		}
+	}()
+
+	// The check compares the replicas through the running engine
+	if volume.State != string(longhorn.VolumeStateAttached) {
+		job.logger.Infof("Skipping snapshot checksum check of volume %v since it is not attached", volume.Name)
+		return nil
+	}
+	if volume.SnapshotChecksumCheckRequestedAt != "" && volume.SnapshotChecksumCheckRequestedAt != volume.LastSnapshotChecksumCheckCompleteAt {
+		job.logger.Infof("Skipping snapshot checksum check of volume %v since the check requested at %v is still in progress", volume.Name, volume.SnapshotChecksumCheckRequestedAt)
+		return nil
+	}
+
+	_, err = job.api.Volume.ActionSnapshotChecksumCheck(volume)
+	return err
+}
+
 // waitForBackupProcessStart timeout in second
 // Return nil if the backup progress has started; error if error or timeout
 func (job *VolumeJob) waitForBackupProcessStart(timeout int) error {
@@ -661,6 +711,7 @@ func (job *VolumeJob) getLastBackup() (*longhornclient.Backup, error) {
 
 func (job *VolumeJob) listBackupsForCleanup(backups []longhornclient.Backup) []string {
 	sts := []NameWithTimestamp{}
+	lockedBackups := map[string]string{}
 
 	// only remove backups that where created by our current job
 	jobLabel, found := job.specLabels[types.RecurringJobLabel]
@@ -680,7 +731,20 @@ func (job *VolumeJob) listBackupsForCleanup(backups []longhornclient.Backup) []s
 				Name:      backup.Name,
 				Timestamp: t,
 			})
+			if isBackupRetentionLocked(backup) {
+				lockedBackups[backup.Name] = backup.RetentionLockedUntil
+			}
+		}
+	}
+
+	// The backups are still counted for the retention, but they are kept until their retention lock expires
+	expiredBackups := []string{}
+	for _, name := range filterExpiredItemsWithRetentionPolicy(sts, job.retain, job.retentionPolicy) {
+		if lockedUntil, locked := lockedBackups[name]; locked {
+			job.logger.Infof("Skipped deleting backup %v since it is retention locked until %v", name, lockedUntil)
+			continue
 		}
+		expiredBackups = append(expiredBackups, name)
 	}
-	return filterExpiredItems(sts, job.retain)
+	return expiredBackups
 }
@@ -0,0 +1,235 @@
+package recurringjob
+
+import (
+	"encoding/json"
+	"fmt"
+	"sort"
+	"time"
+
+	"github.com/cockroachdb/errors"
+	"github.com/sirupsen/logrus"
+	"golang.org/x/sync/errgroup"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/longhorn/longhorn-manager/types"
+	"github.com/longhorn/longhorn-manager/util"
+
+	longhorn "github.com/longhorn/longhorn-manager/k8s/pkg/apis/longhorn/v1beta2"
+)
+
+// StartVolumeGroupJob snapshots all volumes of the recurring job in one coordinated window by a VolumeGroupSnapshot,
+// then backs up the member snapshots for the volume-group-backup task.
+func StartVolumeGroupJob(job *Job, recurringJob *longhorn.RecurringJob) error {
+	volumeNames, err := getVolumesForJob(job, recurringJob)
+	if err != nil {
+		return err
+	}
+	if len(volumeNames) == 0 {
+		job.logger.Info("No volume found for volume group job, skipping")
+		return nil
+	}
+
+	volumeGroupJob, err := newVolumeGroupJob(job, recurringJob, volumeNames)
+	if err != nil {
+		job.logger.WithError(err).Errorf("Failed to initialize volume group job")
+		return err
+	}
+
+	defer volumeGroupJob.cleanup()
+
+	err = volumeGroupJob.run()
+	if err != nil {
+		volumeGroupJob.logger.WithError(err).Error("Failed to run volume group job")
+		return err
+	}
+
+	volumeGroupJob.logger.Info("Created volume group job")
+
+	return nil
+}
+
+func newVolumeGroupJob(job *Job, recurringJob *longhorn.RecurringJob, volumeNames []string) (*VolumeGroupJob, error) {
+	specLabels := map[string]string{}
+	for k, v := range recurringJob.Spec.Labels {
+		specLabels[k] = v
+	}
+	specLabels[types.RecurringJobLabel] = recurringJob.Name
+
+	specLabelsJSON, err := json.Marshal(specLabels)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to get JSON encoding for labels")
+	}
+
+	sort.Strings(volumeNames)
+	groupSnapshotName := sliceStringSafely(types.GetCronJobNameForRecurringJob(job.name), 0, 8) + "-" + util.UUID()
+
+	logger := job.logger.WithFields(logrus.Fields{
+		// job-specific fields
+		"job":            job.name,
+		"task":           job.task,
+		"retain":         job.retain,
+		"parameters":     job.parameters,
+		"executionCount": job.executionCount,
+		// volume-group-specific fields
+		"concurrent":          recurringJob.Spec.Concurrency,
+		"volumes":             volumeNames,
+		"volumeGroupSnapshot": groupSnapshotName,
+		"specLabels":          string(specLabelsJSON),
+	})
+
+	newJob := &VolumeGroupJob{
+		Job:               job,
+		logger:            logger,
+		volumeNames:       volumeNames,
+		groupSnapshotName: groupSnapshotName,
+		specLabels:        specLabels,
+		concurrent:        recurringJob.Spec.Concurrency,
+	}
+	return newJob, nil
+}
+
+func (job *VolumeGroupJob) run() (err error) {
+	job.logger.Info("Starting volume group job")
+	defer func() {
+		if err != nil {
+			job.logger.WithError(err).Error("Failed to run volume group job")
+		} else {
+			job.logger.Info("Finished running volume group job")
+		}
+	}()
+
+	newGroupSnapshot := &longhorn.VolumeGroupSnapshot{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      job.groupSnapshotName,
+			Namespace: job.namespace,
+			Labels: map[string]string{
+				types.GetRecurringJobLabelKey(types.LonghornLabelRecurringJob, string(job.task)): job.name,
+			},
+		},
+		Spec: longhorn.VolumeGroupSnapshotSpec{
+			Volumes: job.volumeNames,
+			Labels:  job.specLabels,
+		},
+	}
+
+	if _, err = job.CreateVolumeGroupSnapshot(newGroupSnapshot); err != nil {
+		return err
+	}
+
+	groupSnapshot, err := job.waitForVolumeGroupSnapshotToBeCreated()
+	if err != nil {
+		return err
+	}
+
+	if job.task != longhorn.RecurringJobTypeVolumeGroupBackup {
+		return nil
+	}
+	return job.doBackups(groupSnapshot)
+}
+
+// doBackups backs up the member snapshots of the volume group snapshot
+func (job *VolumeGroupJob) doBackups(groupSnapshot *longhorn.VolumeGroupSnapshot) error {
+	concurrent := job.concurrent
+	if concurrent < 1 {
+		concurrent = 1
+	}
+	concurrentLimiter := make(chan struct{}, concurrent)
+
+	ewg := &errgroup.Group{}
+	for volumeName, snapshotName := range groupSnapshot.Status.Snapshots {
+		volumeName := volumeName
+		snapshotName := snapshotName
+		ewg.Go(func() error {
+			concurrentLimiter <- struct{}{}
+			defer func() {
+				<-concurrentLimiter
+			}()
+			return job.doBackup(volumeName, snapshotName)
+		})
+	}
+	return ewg.Wait()
+}
+
+func (job *VolumeGroupJob) doBackup(volumeName, snapshotName string) (err error) {
+	defer func() {
+		err = errors.Wrapf(err, "failed to back up snapshot %v of volume %v", snapshotName, volumeName)
+	}()
+
+	volumeJob := &VolumeJob{
+		Job: job.Job,
+		logger: job.logger.WithFields(logrus.Fields{
+			"volumeName":   volumeName,
+			"snapshotName": snapshotName,
+		}),
+		volumeName:   volumeName,
+		snapshotName: snapshotName,
+		specLabels:   job.specLabels,
+		concurrent:   job.concurrent,
+	}
+
+	volume, err := job.api.Volume.ById(volumeName)
+	if err != nil {
+		return errors.Wrapf(err, "could not get volume %v", volumeName)
+	}
+	if volume == nil {
+		volumeJob.logger.Infof("Volume %v not found during backup, skipping", volumeName)
+		return nil
+	}
+
+	// The member snapshots are cleaned up along with the expired volume group snapshots
+	return volumeJob.doBackup(volume, false)
+}
+
+func (job *VolumeGroupJob) cleanup() {
+	job.logger.Info("Cleaning up expired volume group snapshots")
+	defer job.logger.Info("Finished cleaning up expired volume group snapshots")
+
+	groupSnapshotList, err := job.ListVolumeGroupSnapshot()
+	if err != nil {
+		job.logger.WithError(err).Warn("Failed to list volume group snapshots")
+		return
+	}
+
+	expiredGroupSnapshots := filterExpiredItemsWithRetentionPolicy(volumeGroupSnapshotsToNameWithTimestamps(groupSnapshotList), job.retain, job.retentionPolicy)
+	for _, groupSnapshotName := range expiredGroupSnapshots {
+		job.logger.Infof("Deleting volume group snapshot %v", groupSnapshotName)
+		err = job.DeleteVolumeGroupSnapshot(groupSnapshotName)
+		if err != nil {
+			job.logger.WithError(err).Warnf("Failed to delete volume group snapshot %v", groupSnapshotName)
+		}
+	}
+}
+
+func (job *VolumeGroupJob) waitForVolumeGroupSnapshotToBeCreated() (groupSnapshot *longhorn.VolumeGroupSnapshot, err error) {
+	job.logger.Info("Waiting for volume group snapshot to be created")
+	defer func() {
+		if err != nil {
+			job.logger.WithError(err).Error("Failed to wait for volume group snapshot to be created")
+		} else {
+			job.logger.Info("Volume group snapshot is created")
+		}
+	}()
+
+	startTime := time.Now()
+	for {
+		groupSnapshot, err = job.GetVolumeGroupSnapshot(job.groupSnapshotName)
+		if err != nil {
+			return nil, err
+		}
+
+		switch groupSnapshot.Status.State {
+		case longhorn.VolumeGroupSnapshotStateReady:
+			return groupSnapshot, nil
+		case longhorn.VolumeGroupSnapshotStateError:
+			return nil, fmt.Errorf("failed to create volume group snapshot %v: %v", job.groupSnapshotName, groupSnapshot.Status.Error)
+		}
+
+		if time.Since(startTime) > VolumeGroupSnapshotTimeout {
+			return nil, fmt.Errorf("timed out waiting for volume group snapshot %v to be created, current state is %v", job.groupSnapshotName, groupSnapshot.Status.State)
+		}
+
+		job.logger.Infof("Waiting for volume group snapshot to be created, current state is %v", groupSnapshot.Status.State)
+		time.Sleep(WaitInterval)
+	}
+}
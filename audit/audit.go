@@ -0,0 +1,149 @@
+package audit
+
+import (
+	"encoding/json"
+	"path/filepath"
+	"strings"
+	"sync"
+
+	"github.com/sirupsen/logrus"
+
+	"github.com/longhorn/longhorn-manager/datastore"
+	"github.com/longhorn/longhorn-manager/types"
+	"github.com/longhorn/longhorn-manager/util"
+)
+
+const (
+	LogFileName = "longhorn-manager-audit.log"
+
+	SourceAPI       = "api"
+	SourceAdmission = "admission"
+
+	ResultSucceeded = "succeeded"
+	ResultFailed    = "failed"
+	ResultAllowed   = "allowed"
+	ResultDenied    = "denied"
+)
+
+// Event is an audit record of a mutating operation
+type Event struct {
+	Timestamp string `json:"timestamp"`
+	Source    string `json:"source"`
+	NodeID    string `json:"nodeID"`
+	User      string `json:"user"`
+	// ClaimedUser is the username supplied by an unauthenticated client, which is not verified
+	ClaimedUser string   `json:"claimedUser,omitempty"`
+	Groups      []string `json:"groups,omitempty"`
+	SourceIP    string   `json:"sourceIP,omitempty"`
+	Resource    string   `json:"resource"`
+	Namespace   string   `json:"namespace,omitempty"`
+	Name        string   `json:"name,omitempty"`
+	Action      string   `json:"action"`
+	OldValue    *string  `json:"oldValue,omitempty"`
+	NewValue    *string  `json:"newValue,omitempty"`
+	Result      string   `json:"result"`
+	StatusCode  int      `json:"statusCode,omitempty"`
+	Message     string   `json:"message,omitempty"`
+}
+
+// Logger records the audit events to the audit log file on the log path of the node, and to the optional webhook sink.
+type Logger struct {
+	ds        *datastore.DataStore
+	nodeID    string
+	namespace string
+	logger    logrus.FieldLogger
+
+	webhookLock sync.Mutex
+	webhook     *webhookSink
+}
+
+func NewLogger(ds *datastore.DataStore, nodeID string) *Logger {
+	return &Logger{
+		ds:        ds,
+		nodeID:    nodeID,
+		namespace: util.GetNamespace(types.EnvPodNamespace),
+		logger:    logrus.StandardLogger().WithField("component", "audit"),
+	}
+}
+
+// IsLonghornServiceAccount returns true if the user is a service account of the Longhorn components.
+// The changes made by the Longhorn components are either reconciliation or on behalf of the API requests,
+// which are recorded by the API server.
+func (l *Logger) IsLonghornServiceAccount(username string) bool {
+	return strings.HasPrefix(username, "system:serviceaccount:"+l.namespace+":")
+}
+
+// Record writes the event to the audit sinks. Failing to write the event does not fail the audited operation.
+func (l *Logger) Record(event *Event) {
+	if l == nil || event == nil {
+		return
+	}
+
+	enabled, err := l.ds.GetSettingAsBool(types.SettingNameAuditLogEnabled)
+	if err != nil {
+		l.logger.WithError(err).Warnf("Failed to get %v setting", types.SettingNameAuditLogEnabled)
+		return
+	}
+	if !enabled {
+		return
+	}
+
+	if event.Timestamp == "" {
+		event.Timestamp = util.Now()
+	}
+	if event.NodeID == "" {
+		event.NodeID = l.nodeID
+	}
+
+	data, err := json.Marshal(event)
+	if err != nil {
+		l.logger.WithError(err).Warnf("Failed to encode audit event of %v %v/%v", event.Action, event.Resource, event.Name)
+		return
+	}
+
+	if err := l.writeFile(data); err != nil {
+		l.logger.WithError(err).Warnf("Failed to write audit event of %v %v/%v", event.Action, event.Resource, event.Name)
+	}
+
+	webhookURL, err := l.ds.GetSettingValueExisted(types.SettingNameAuditLogWebhookURL)
+	if err != nil {
+		l.logger.WithError(err).Warnf("Failed to get %v setting", types.SettingNameAuditLogWebhookURL)
+		return
+	}
+	if sink := l.getWebhookSink(webhookURL); sink != nil {
+		sink.send(data)
+	}
+}
+
+func (l *Logger) writeFile(data []byte) error {
+	logPath, err := l.ds.GetSettingValueExisted(types.SettingNameLogPath)
+	if err != nil || logPath == "" {
+		logPath = types.DefaultLogDirectoryOnHost
+	}
+	maxSize, err := l.ds.GetSettingAsInt(types.SettingNameAuditLogMaxSize)
+	if err != nil {
+		return err
+	}
+	maxBackups, err := l.ds.GetSettingAsInt(types.SettingNameAuditLogMaxBackups)
+	if err != nil {
+		return err
+	}
+
+	file := getRotatingFile(filepath.Join(logPath, LogFileName))
+	return file.write(append(data, '\n'), maxSize*util.MiB, int(maxBackups))
+}
+
+// getWebhookSink returns the sink of the webhook URL, and replaces the sink if the URL is changed
+func (l *Logger) getWebhookSink(webhookURL string) *webhookSink {
+	l.webhookLock.Lock()
+	defer l.webhookLock.Unlock()
+
+	if l.webhook != nil && l.webhook.url != webhookURL {
+		l.webhook.stop()
+		l.webhook = nil
+	}
+	if l.webhook == nil && webhookURL != "" {
+		l.webhook = newWebhookSink(webhookURL, l.logger)
+	}
+	return l.webhook
+}
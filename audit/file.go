@@ -0,0 +1,109 @@
+package audit
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"sync"
+
+	"github.com/cockroachdb/errors"
+)
+
+var (
+	rotatingFilesLock sync.Mutex
+	// rotatingFiles are shared by the audit loggers of the API server and the admission webhook in the same process,
+	// so that the writes and the rotation of the same file are serialized.
+	rotatingFiles = map[string]*rotatingFile{}
+)
+
+// rotatingFile is a file that is rotated to <path>.1, <path>.2, ... when the size exceeds the limit
+type rotatingFile struct {
+	path string
+
+	lock sync.Mutex
+	file *os.File
+	size int64
+}
+
+func getRotatingFile(path string) *rotatingFile {
+	rotatingFilesLock.Lock()
+	defer rotatingFilesLock.Unlock()
+
+	file, ok := rotatingFiles[path]
+	if !ok {
+		file = &rotatingFile{path: path}
+		rotatingFiles[path] = file
+	}
+	return file
+}
+
+func (f *rotatingFile) write(data []byte, maxSize int64, maxBackups int) error {
+	f.lock.Lock()
+	defer f.lock.Unlock()
+
+	if f.file == nil {
+		if err := f.open(); err != nil {
+			return err
+		}
+	}
+
+	if f.size > 0 && f.size+int64(len(data)) > maxSize {
+		if err := f.rotate(maxBackups); err != nil {
+			return err
+		}
+	}
+
+	n, err := f.file.Write(data)
+	f.size += int64(n)
+	return err
+}
+
+func (f *rotatingFile) open() error {
+	if err := os.MkdirAll(filepath.Dir(f.path), 0755); err != nil {
+		return errors.Wrapf(err, "failed to create directory for audit log %v", f.path)
+	}
+	file, err := os.OpenFile(f.path, os.O_CREATE|os.O_WRONLY|os.O_APPEND, 0600)
+	if err != nil {
+		return errors.Wrapf(err, "failed to open audit log %v", f.path)
+	}
+	info, err := file.Stat()
+	if err != nil {
+		_ = file.Close()
+		return errors.Wrapf(err, "failed to get size of audit log %v", f.path)
+	}
+	f.file = file
+	f.size = info.Size()
+	return nil
+}
+
+func (f *rotatingFile) rotate(maxBackups int) error {
+	if err := f.file.Close(); err != nil {
+		return errors.Wrapf(err, "failed to close audit log %v", f.path)
+	}
+	f.file = nil
+
+	if maxBackups <= 0 {
+		if err := os.Remove(f.path); err != nil && !os.IsNotExist(err) {
+			return errors.Wrapf(err, "failed to remove audit log %v", f.path)
+		}
+		return f.open()
+	}
+
+	if err := os.Remove(backupFilePath(f.path, maxBackups)); err != nil && !os.IsNotExist(err) {
+		return errors.Wrapf(err, "failed to remove the oldest audit log backup of %v", f.path)
+	}
+	for i := maxBackups - 1; i >= 1; i-- {
+		if err := os.Rename(backupFilePath(f.path, i), backupFilePath(f.path, i+1)); err != nil && !os.IsNotExist(err) {
+			return errors.Wrapf(err, "failed to rotate audit log backup %v", backupFilePath(f.path, i))
+		}
+	}
+	if err := os.Rename(f.path, backupFilePath(f.path, 1)); err != nil && !os.IsNotExist(err) {
+		return errors.Wrapf(err, "failed to rotate audit log %v", f.path)
+	}
+
+	return f.open()
+}
+
+func backupFilePath(path string, index int) string {
+	return fmt.Sprintf("%s.%d", path, index)
+}
@@ -0,0 +1,66 @@
+package audit
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+func TestRotatingFileWrite(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "logs", LogFileName)
+	file := getRotatingFile(path)
+	if other := getRotatingFile(path); other != file {
+		t.Fatalf("expected the same rotating file for path %v", path)
+	}
+
+	line := []byte("0123456789\n")
+	for i := 0; i < 10; i++ {
+		if err := file.write(line, 3*int64(len(line)), 2); err != nil {
+			t.Fatalf("failed to write audit log: %v", err)
+		}
+	}
+
+	for _, test := range []struct {
+		path         string
+		expectedSize int64
+	}{
+		{path, 1 * int64(len(line))},
+		{backupFilePath(path, 1), 3 * int64(len(line))},
+		{backupFilePath(path, 2), 3 * int64(len(line))},
+	} {
+		info, err := os.Stat(test.path)
+		if err != nil {
+			t.Fatalf("failed to stat %v: %v", test.path, err)
+		}
+		if info.Size() != test.expectedSize {
+			t.Errorf("expected size of %v: %v, but got: %v", test.path, test.expectedSize, info.Size())
+		}
+	}
+
+	if _, err := os.Stat(backupFilePath(path, 3)); !os.IsNotExist(err) {
+		t.Errorf("expected backup %v to be removed, but got: %v", backupFilePath(path, 3), err)
+	}
+}
+
+func TestRotatingFileWriteWithoutBackups(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "logs", LogFileName)
+	file := getRotatingFile(path)
+
+	line := []byte("0123456789\n")
+	for i := 0; i < 4; i++ {
+		if err := file.write(line, 3*int64(len(line)), 0); err != nil {
+			t.Fatalf("failed to write audit log: %v", err)
+		}
+	}
+
+	info, err := os.Stat(path)
+	if err != nil {
+		t.Fatalf("failed to stat %v: %v", path, err)
+	}
+	if info.Size() != int64(len(line)) {
+		t.Errorf("expected size of %v: %v, but got: %v", path, len(line), info.Size())
+	}
+	if _, err := os.Stat(backupFilePath(path, 1)); !os.IsNotExist(err) {
+		t.Errorf("expected no backup %v, but got: %v", backupFilePath(path, 1), err)
+	}
+}
@@ -0,0 +1,76 @@
+package audit
+
+import (
+	"bytes"
+	"fmt"
+	"net/http"
+	"time"
+
+	"github.com/sirupsen/logrus"
+)
+
+const (
+	webhookQueueSize      = 1024
+	webhookRequestTimeout = 10 * time.Second
+)
+
+// webhookSink posts the audit events to the webhook URL in the background, so that a slow or unavailable
+// webhook receiver does not block the audited operations. The events are dropped when the queue is full.
+type webhookSink struct {
+	url    string
+	client *http.Client
+	logger logrus.FieldLogger
+
+	queue  chan []byte
+	stopCh chan struct{}
+}
+
+func newWebhookSink(url string, logger logrus.FieldLogger) *webhookSink {
+	sink := &webhookSink{
+		url:    url,
+		client: &http.Client{Timeout: webhookRequestTimeout},
+		logger: logger.WithField("webhookURL", url),
+		queue:  make(chan []byte, webhookQueueSize),
+		stopCh: make(chan struct{}),
+	}
+	go sink.run()
+	return sink
+}
+
+func (s *webhookSink) send(data []byte) {
+	select {
+	case s.queue <- data:
+	default:
+		s.logger.Warn("Audit webhook queue is full, dropping audit event")
+	}
+}
+
+func (s *webhookSink) stop() {
+	close(s.stopCh)
+}
+
+func (s *webhookSink) run() {
+	for {
+		select {
+		case <-s.stopCh:
+			return
+		case data := <-s.queue:
+			if err := s.post(data); err != nil {
+				s.logger.WithError(err).Warn("Failed to send audit event to webhook")
+			}
+		}
+	}
+}
+
+func (s *webhookSink) post(data []byte) error {
+	resp, err := s.client.Post(s.url, "application/json", bytes.NewReader(data))
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
+		return fmt.Errorf("unexpected status code %v", resp.StatusCode)
+	}
+	return nil
+}
@@ -21,6 +21,8 @@ type Backup struct {
 
 	Labels map[string]string `json:"labels,omitempty" yaml:"labels,omitempty"`
 
+	LastVerifiedAt string `json:"lastVerifiedAt,omitempty" yaml:"last_verified_at,omitempty"`
+
 	Messages map[string]string `json:"messages,omitempty" yaml:"messages,omitempty"`
 
 	Name string `json:"name,omitempty" yaml:"name,omitempty"`
@@ -31,6 +33,8 @@ type Backup struct {
 
 	ReUploadedDataSize string `json:"reUploadedDataSize,omitempty" yaml:"re_uploaded_data_size,omitempty"`
 
+	RetentionLockedUntil string `json:"retentionLockedUntil,omitempty" yaml:"retention_locked_until,omitempty"`
+
 	Size string `json:"size,omitempty" yaml:"size,omitempty"`
 
 	SnapshotCreated string `json:"snapshotCreated,omitempty" yaml:"snapshot_created,omitempty"`
@@ -41,6 +45,10 @@ type Backup struct {
 
 	Url string `json:"url,omitempty" yaml:"url,omitempty"`
 
+	VerificationMessage string `json:"verificationMessage,omitempty" yaml:"verification_message,omitempty"`
+
+	VerificationResult string `json:"verificationResult,omitempty" yaml:"verification_result,omitempty"`
+
 	VolumeBackingImageName string `json:"volumeBackingImageName,omitempty" yaml:"volume_backing_image_name,omitempty"`
 
 	VolumeCreated string `json:"volumeCreated,omitempty" yaml:"volume_created,omitempty"`
@@ -11,6 +11,8 @@ type BackupTarget struct {
 
 	BackupTargetURL string `json:"backupTargetURL,omitempty" yaml:"backup_target_url,omitempty"`
 
+	BackupWindow string `json:"backupWindow,omitempty" yaml:"backup_window,omitempty"`
+
 	CredentialSecret string `json:"credentialSecret,omitempty" yaml:"credential_secret,omitempty"`
 
 	Message string `json:"message,omitempty" yaml:"message,omitempty"`
@@ -18,6 +20,12 @@ type BackupTarget struct {
 	Name string `json:"name,omitempty" yaml:"name,omitempty"`
 
 	PollInterval string `json:"pollInterval,omitempty" yaml:"poll_interval,omitempty"`
+
+	ReplicationStatus map[string]interface{} `json:"replicationStatus,omitempty" yaml:"replication_status,omitempty"`
+
+	ReplicationTargetName string `json:"replicationTargetName,omitempty" yaml:"replication_target_name,omitempty"`
+
+	RetentionLockPeriod string `json:"retentionLockPeriod,omitempty" yaml:"retention_lock_period,omitempty"`
 }
 
 type BackupTargetCollection struct {
@@ -0,0 +1,97 @@
+package client
+
+const (
+	BACKUP_TARGET_REPLICATION_STATUS_TYPE = "backupTargetReplicationStatus"
+)
+
+type BackupTargetReplicationStatus struct {
+	Resource `yaml:"-"`
+
+	FailedBackups int64 `json:"failedBackups,omitempty" yaml:"failed_backups,omitempty"`
+
+	LastReplicatedAt string `json:"lastReplicatedAt,omitempty" yaml:"last_replicated_at,omitempty"`
+
+	Message string `json:"message,omitempty" yaml:"message,omitempty"`
+
+	PendingBackingImageBackups int64 `json:"pendingBackingImageBackups,omitempty" yaml:"pending_backing_image_backups,omitempty"`
+
+	PendingBackups int64 `json:"pendingBackups,omitempty" yaml:"pending_backups,omitempty"`
+
+	PendingSystemBackups int64 `json:"pendingSystemBackups,omitempty" yaml:"pending_system_backups,omitempty"`
+
+	ReplicatedBackingImageBackups int64 `json:"replicatedBackingImageBackups,omitempty" yaml:"replicated_backing_image_backups,omitempty"`
+
+	ReplicatedBackups int64 `json:"replicatedBackups,omitempty" yaml:"replicated_backups,omitempty"`
+
+	ReplicatedSystemBackups int64 `json:"replicatedSystemBackups,omitempty" yaml:"replicated_system_backups,omitempty"`
+
+	State string `json:"state,omitempty" yaml:"state,omitempty"`
+}
+
+type BackupTargetReplicationStatusCollection struct {
+	Collection
+	Data   []BackupTargetReplicationStatus `json:"data,omitempty"`
+	client *BackupTargetReplicationStatusClient
+}
+
+type BackupTargetReplicationStatusClient struct {
+	rancherClient *RancherClient
+}
+
+type BackupTargetReplicationStatusOperations interface {
+	List(opts *ListOpts) (*BackupTargetReplicationStatusCollection, error)
+	Create(opts *BackupTargetReplicationStatus) (*BackupTargetReplicationStatus, error)
+	Update(existing *BackupTargetReplicationStatus, updates interface{}) (*BackupTargetReplicationStatus, error)
+	ById(id string) (*BackupTargetReplicationStatus, error)
+	Delete(container *BackupTargetReplicationStatus) error
+}
+
+func newBackupTargetReplicationStatusClient(rancherClient *RancherClient) *BackupTargetReplicationStatusClient {
+	return &BackupTargetReplicationStatusClient{
+		rancherClient: rancherClient,
+	}
+}
+
+func (c *BackupTargetReplicationStatusClient) Create(container *BackupTargetReplicationStatus) (*BackupTargetReplicationStatus, error) {
+	resp := &BackupTargetReplicationStatus{}
+	err := c.rancherClient.doCreate(BACKUP_TARGET_REPLICATION_STATUS_TYPE, container, resp)
+	return resp, err
+}
+
+func (c *BackupTargetReplicationStatusClient) Update(existing *BackupTargetReplicationStatus, updates interface{}) (*BackupTargetReplicationStatus, error) {
+	resp := &BackupTargetReplicationStatus{}
+	err := c.rancherClient.doUpdate(BACKUP_TARGET_REPLICATION_STATUS_TYPE, &existing.Resource, updates, resp)
+	return resp, err
+}
+
+func (c *BackupTargetReplicationStatusClient) List(opts *ListOpts) (*BackupTargetReplicationStatusCollection, error) {
+	resp := &BackupTargetReplicationStatusCollection{}
+	err := c.rancherClient.doList(BACKUP_TARGET_REPLICATION_STATUS_TYPE, opts, resp)
+	resp.client = c
+	return resp, err
+}
+
+func (cc *BackupTargetReplicationStatusCollection) Next() (*BackupTargetReplicationStatusCollection, error) {
+	if cc != nil && cc.Pagination != nil && cc.Pagination.Next != "" {
+		resp := &BackupTargetReplicationStatusCollection{}
+		err := cc.client.rancherClient.doNext(cc.Pagination.Next, resp)
+		resp.client = cc.client
+		return resp, err
+	}
+	return nil, nil
+}
+
+func (c *BackupTargetReplicationStatusClient) ById(id string) (*BackupTargetReplicationStatus, error) {
+	resp := &BackupTargetReplicationStatus{}
+	err := c.rancherClient.doById(BACKUP_TARGET_REPLICATION_STATUS_TYPE, id, resp)
+	if apiError, ok := err.(*ApiError); ok {
+		if apiError.StatusCode == 404 {
+			return nil, nil
+		}
+	}
+	return resp, err
+}
+
+func (c *BackupTargetReplicationStatusClient) Delete(container *BackupTargetReplicationStatus) error {
+	return c.rancherClient.doResourceDelete(BACKUP_TARGET_REPLICATION_STATUS_TYPE, &container.Resource)
+}
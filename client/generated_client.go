@@ -49,6 +49,7 @@ type RancherClient struct {
 	Empty                                      EmptyOperations
 	VolumeRecurringJob                         VolumeRecurringJobOperations
 	VolumeRecurringJobInput                    VolumeRecurringJobInputOperations
+	RecurringJobRetentionPolicy                RecurringJobRetentionPolicyOperations
 	PVCreateInput                              PVCreateInputOperations
 	PVCCreateInput                             PVCCreateInputOperations
 	SettingDefinition                          SettingDefinitionOperations
@@ -70,6 +71,7 @@ type RancherClient struct {
 	Snapshot                                   SnapshotOperations
 	SnapshotCR                                 SnapshotCROperations
 	BackupTarget                               BackupTargetOperations
+	BackupTargetReplicationStatus              BackupTargetReplicationStatusOperations
 	BackupVolume                               BackupVolumeOperations
 	BackupBackingImage                         BackupBackingImageOperations
 	Setting                                    SettingOperations
@@ -80,12 +82,17 @@ type RancherClient struct {
 	DiskUpdateInput                            DiskUpdateInputOperations
 	DiskInfo                                   DiskInfoOperations
 	KubernetesStatus                           KubernetesStatusOperations
+	NfsMountOptions                            NfsMountOptionsOperations
+	ReplicaTopologySpreadConstraint            ReplicaTopologySpreadConstraintOperations
+	NodeMaintenanceStatus                      NodeMaintenanceStatusOperations
 	BackupTargetListOutput                     BackupTargetListOutputOperations
 	BackupVolumeListOutput                     BackupVolumeListOutputOperations
 	BackupListOutput                           BackupListOutputOperations
 	SnapshotListOutput                         SnapshotListOutputOperations
 	SystemBackup                               SystemBackupOperations
 	SystemRestore                              SystemRestoreOperations
+	DRGroupVolumeStatus                        DRGroupVolumeStatusOperations
+	DRGroup                                    DRGroupOperations
 	SnapshotCRListOutput                       SnapshotCRListOutputOperations
 }
 
@@ -140,6 +147,7 @@ func constructClient(rancherBaseClient *RancherBaseClientImpl) *RancherClient {
 	client.Empty = newEmptyClient(client)
 	client.VolumeRecurringJob = newVolumeRecurringJobClient(client)
 	client.VolumeRecurringJobInput = newVolumeRecurringJobInputClient(client)
+	client.RecurringJobRetentionPolicy = newRecurringJobRetentionPolicyClient(client)
 	client.PVCreateInput = newPVCreateInputClient(client)
 	client.PVCCreateInput = newPVCCreateInputClient(client)
 	client.SettingDefinition = newSettingDefinitionClient(client)
@@ -161,6 +169,7 @@ func constructClient(rancherBaseClient *RancherBaseClientImpl) *RancherClient {
 	client.Snapshot = newSnapshotClient(client)
 	client.SnapshotCR = newSnapshotCRClient(client)
 	client.BackupTarget = newBackupTargetClient(client)
+	client.BackupTargetReplicationStatus = newBackupTargetReplicationStatusClient(client)
 	client.BackupVolume = newBackupVolumeClient(client)
 	client.BackupBackingImage = newBackupBackingImageClient(client)
 	client.Setting = newSettingClient(client)
@@ -171,12 +180,17 @@ func constructClient(rancherBaseClient *RancherBaseClientImpl) *RancherClient {
 	client.DiskUpdateInput = newDiskUpdateInputClient(client)
 	client.DiskInfo = newDiskInfoClient(client)
 	client.KubernetesStatus = newKubernetesStatusClient(client)
+	client.NfsMountOptions = newNfsMountOptionsClient(client)
+	client.ReplicaTopologySpreadConstraint = newReplicaTopologySpreadConstraintClient(client)
+	client.NodeMaintenanceStatus = newNodeMaintenanceStatusClient(client)
 	client.BackupTargetListOutput = newBackupTargetListOutputClient(client)
 	client.BackupVolumeListOutput = newBackupVolumeListOutputClient(client)
 	client.BackupListOutput = newBackupListOutputClient(client)
 	client.SnapshotListOutput = newSnapshotListOutputClient(client)
 	client.SystemBackup = newSystemBackupClient(client)
 	client.SystemRestore = newSystemRestoreClient(client)
+	client.DRGroupVolumeStatus = newDRGroupVolumeStatusClient(client)
+	client.DRGroup = newDRGroupClient(client)
 	client.SnapshotCRListOutput = newSnapshotCRListOutputClient(client)
 
 	return client
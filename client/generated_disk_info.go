@@ -25,6 +25,12 @@ type DiskInfo struct {
 
 	Path string `json:"path,omitempty" yaml:"path,omitempty"`
 
+	ProjectedFullAt string `json:"projectedFullAt,omitempty" yaml:"projected_full_at,omitempty"`
+
+	ReplicaActualSize int64 `json:"replicaActualSize,omitempty" yaml:"replica_actual_size,omitempty"`
+
+	ReplicaActualSizeGrowthRate int64 `json:"replicaActualSizeGrowthRate,omitempty" yaml:"replica_actual_size_growth_rate,omitempty"`
+
 	ScheduledBackingImage map[string]string `json:"scheduledBackingImage,omitempty" yaml:"scheduled_backing_image,omitempty"`
 
 	ScheduledReplica map[string]string `json:"scheduledReplica,omitempty" yaml:"scheduled_replica,omitempty"`
@@ -37,7 +43,11 @@ type DiskInfo struct {
 
 	StorageScheduled int64 `json:"storageScheduled,omitempty" yaml:"storage_scheduled,omitempty"`
 
+	StorageUsageGrowthRate int64 `json:"storageUsageGrowthRate,omitempty" yaml:"storage_usage_growth_rate,omitempty"`
+
 	Tags []string `json:"tags,omitempty" yaml:"tags,omitempty"`
+
+	Tier string `json:"tier,omitempty" yaml:"tier,omitempty"`
 }
 
 type DiskInfoCollection struct {
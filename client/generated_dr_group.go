@@ -0,0 +1,123 @@
+package client
+
+const (
+	DR_GROUP_TYPE = "drGroup"
+)
+
+type DRGroup struct {
+	Resource `yaml:"-"`
+
+	BackupTargetName string `json:"backupTargetName,omitempty" yaml:"backup_target_name,omitempty"`
+
+	CurrentRole string `json:"currentRole,omitempty" yaml:"current_role,omitempty"`
+
+	Error string `json:"error,omitempty" yaml:"error,omitempty"`
+
+	LastFailbackAt string `json:"lastFailbackAt,omitempty" yaml:"last_failback_at,omitempty"`
+
+	LastFailoverAt string `json:"lastFailoverAt,omitempty" yaml:"last_failover_at,omitempty"`
+
+	Name string `json:"name,omitempty" yaml:"name,omitempty"`
+
+	NumberOfReplicas int64 `json:"numberOfReplicas,omitempty" yaml:"number_of_replicas,omitempty"`
+
+	RecoveryPoint string `json:"recoveryPoint,omitempty" yaml:"recovery_point,omitempty"`
+
+	Role string `json:"role,omitempty" yaml:"role,omitempty"`
+
+	State string `json:"state,omitempty" yaml:"state,omitempty"`
+
+	VolumeSelector map[string]string `json:"volumeSelector,omitempty" yaml:"volume_selector,omitempty"`
+
+	Volumes map[string]interface{} `json:"volumes,omitempty" yaml:"volumes,omitempty"`
+}
+
+type DRGroupCollection struct {
+	Collection
+	Data   []DRGroup `json:"data,omitempty"`
+	client *DRGroupClient
+}
+
+type DRGroupClient struct {
+	rancherClient *RancherClient
+}
+
+type DRGroupOperations interface {
+	List(opts *ListOpts) (*DRGroupCollection, error)
+	Create(opts *DRGroup) (*DRGroup, error)
+	Update(existing *DRGroup, updates interface{}) (*DRGroup, error)
+	ById(id string) (*DRGroup, error)
+	Delete(container *DRGroup) error
+
+	ActionFailback(*DRGroup) (*DRGroup, error)
+
+	ActionFailover(*DRGroup) (*DRGroup, error)
+}
+
+func newDRGroupClient(rancherClient *RancherClient) *DRGroupClient {
+	return &DRGroupClient{
+		rancherClient: rancherClient,
+	}
+}
+
+func (c *DRGroupClient) Create(container *DRGroup) (*DRGroup, error) {
+	resp := &DRGroup{}
+	err := c.rancherClient.doCreate(DR_GROUP_TYPE, container, resp)
+	return resp, err
+}
+
+func (c *DRGroupClient) Update(existing *DRGroup, updates interface{}) (*DRGroup, error) {
+	resp := &DRGroup{}
+	err := c.rancherClient.doUpdate(DR_GROUP_TYPE, &existing.Resource, updates, resp)
+	return resp, err
+}
+
+func (c *DRGroupClient) List(opts *ListOpts) (*DRGroupCollection, error) {
+	resp := &DRGroupCollection{}
+	err := c.rancherClient.doList(DR_GROUP_TYPE, opts, resp)
+	resp.client = c
+	return resp, err
+}
+
+func (cc *DRGroupCollection) Next() (*DRGroupCollection, error) {
+	if cc != nil && cc.Pagination != nil && cc.Pagination.Next != "" {
+		resp := &DRGroupCollection{}
+		err := cc.client.rancherClient.doNext(cc.Pagination.Next, resp)
+		resp.client = cc.client
+		return resp, err
+	}
+	return nil, nil
+}
+
+func (c *DRGroupClient) ById(id string) (*DRGroup, error) {
+	resp := &DRGroup{}
+	err := c.rancherClient.doById(DR_GROUP_TYPE, id, resp)
+	if apiError, ok := err.(*ApiError); ok {
+		if apiError.StatusCode == 404 {
+			return nil, nil
+		}
+	}
+	return resp, err
+}
+
+func (c *DRGroupClient) Delete(container *DRGroup) error {
+	return c.rancherClient.doResourceDelete(DR_GROUP_TYPE, &container.Resource)
+}
+
+func (c *DRGroupClient) ActionFailback(resource *DRGroup) (*DRGroup, error) {
+
+	resp := &DRGroup{}
+
+	err := c.rancherClient.doAction(DR_GROUP_TYPE, "failback", &resource.Resource, nil, resp)
+
+	return resp, err
+}
+
+func (c *DRGroupClient) ActionFailover(resource *DRGroup) (*DRGroup, error) {
+
+	resp := &DRGroup{}
+
+	err := c.rancherClient.doAction(DR_GROUP_TYPE, "failover", &resource.Resource, nil, resp)
+
+	return resp, err
+}
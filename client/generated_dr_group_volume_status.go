@@ -0,0 +1,91 @@
+package client
+
+const (
+	DR_GROUP_VOLUME_STATUS_TYPE = "drGroupVolumeStatus"
+)
+
+type DRGroupVolumeStatus struct {
+	Resource `yaml:"-"`
+
+	BackupVolumeName string `json:"backupVolumeName,omitempty" yaml:"backup_volume_name,omitempty"`
+
+	LastBackupAt string `json:"lastBackupAt,omitempty" yaml:"last_backup_at,omitempty"`
+
+	LastBackupName string `json:"lastBackupName,omitempty" yaml:"last_backup_name,omitempty"`
+
+	LastRestoredBackupAt string `json:"lastRestoredBackupAt,omitempty" yaml:"last_restored_backup_at,omitempty"`
+
+	LastRestoredBackupName string `json:"lastRestoredBackupName,omitempty" yaml:"last_restored_backup_name,omitempty"`
+
+	Message string `json:"message,omitempty" yaml:"message,omitempty"`
+
+	Standby bool `json:"standby,omitempty" yaml:"standby,omitempty"`
+}
+
+type DRGroupVolumeStatusCollection struct {
+	Collection
+	Data   []DRGroupVolumeStatus `json:"data,omitempty"`
+	client *DRGroupVolumeStatusClient
+}
+
+type DRGroupVolumeStatusClient struct {
+	rancherClient *RancherClient
+}
+
+type DRGroupVolumeStatusOperations interface {
+	List(opts *ListOpts) (*DRGroupVolumeStatusCollection, error)
+	Create(opts *DRGroupVolumeStatus) (*DRGroupVolumeStatus, error)
+	Update(existing *DRGroupVolumeStatus, updates interface{}) (*DRGroupVolumeStatus, error)
+	ById(id string) (*DRGroupVolumeStatus, error)
+	Delete(container *DRGroupVolumeStatus) error
+}
+
+func newDRGroupVolumeStatusClient(rancherClient *RancherClient) *DRGroupVolumeStatusClient {
+	return &DRGroupVolumeStatusClient{
+		rancherClient: rancherClient,
+	}
+}
+
+func (c *DRGroupVolumeStatusClient) Create(container *DRGroupVolumeStatus) (*DRGroupVolumeStatus, error) {
+	resp := &DRGroupVolumeStatus{}
+	err := c.rancherClient.doCreate(DR_GROUP_VOLUME_STATUS_TYPE, container, resp)
+	return resp, err
+}
+
+func (c *DRGroupVolumeStatusClient) Update(existing *DRGroupVolumeStatus, updates interface{}) (*DRGroupVolumeStatus, error) {
+	resp := &DRGroupVolumeStatus{}
+	err := c.rancherClient.doUpdate(DR_GROUP_VOLUME_STATUS_TYPE, &existing.Resource, updates, resp)
+	return resp, err
+}
+
+func (c *DRGroupVolumeStatusClient) List(opts *ListOpts) (*DRGroupVolumeStatusCollection, error) {
+	resp := &DRGroupVolumeStatusCollection{}
+	err := c.rancherClient.doList(DR_GROUP_VOLUME_STATUS_TYPE, opts, resp)
+	resp.client = c
+	return resp, err
+}
+
+func (cc *DRGroupVolumeStatusCollection) Next() (*DRGroupVolumeStatusCollection, error) {
+	if cc != nil && cc.Pagination != nil && cc.Pagination.Next != "" {
+		resp := &DRGroupVolumeStatusCollection{}
+		err := cc.client.rancherClient.doNext(cc.Pagination.Next, resp)
+		resp.client = cc.client
+		return resp, err
+	}
+	return nil, nil
+}
+
+func (c *DRGroupVolumeStatusClient) ById(id string) (*DRGroupVolumeStatus, error) {
+	resp := &DRGroupVolumeStatus{}
+	err := c.rancherClient.doById(DR_GROUP_VOLUME_STATUS_TYPE, id, resp)
+	if apiError, ok := err.(*ApiError); ok {
+		if apiError.StatusCode == 404 {
+			return nil, nil
+		}
+	}
+	return resp, err
+}
+
+func (c *DRGroupVolumeStatusClient) Delete(container *DRGroupVolumeStatus) error {
+	return c.rancherClient.doResourceDelete(DR_GROUP_VOLUME_STATUS_TYPE, &container.Resource)
+}
@@ -0,0 +1,81 @@
+package client
+
+const (
+	NFS_MOUNT_OPTIONS_TYPE = "nfsMountOptions"
+)
+
+type NfsMountOptions struct {
+	Resource `yaml:"-"`
+
+	NfsVersion string `json:"nfsVersion,omitempty" yaml:"nfs_version,omitempty"`
+
+	ReadOnly bool `json:"readOnly,omitempty" yaml:"read_only,omitempty"`
+}
+
+type NfsMountOptionsCollection struct {
+	Collection
+	Data   []NfsMountOptions `json:"data,omitempty"`
+	client *NfsMountOptionsClient
+}
+
+type NfsMountOptionsClient struct {
+	rancherClient *RancherClient
+}
+
+type NfsMountOptionsOperations interface {
+	List(opts *ListOpts) (*NfsMountOptionsCollection, error)
+	Create(opts *NfsMountOptions) (*NfsMountOptions, error)
+	Update(existing *NfsMountOptions, updates interface{}) (*NfsMountOptions, error)
+	ById(id string) (*NfsMountOptions, error)
+	Delete(container *NfsMountOptions) error
+}
+
+func newNfsMountOptionsClient(rancherClient *RancherClient) *NfsMountOptionsClient {
+	return &NfsMountOptionsClient{
+		rancherClient: rancherClient,
+	}
+}
+
+func (c *NfsMountOptionsClient) Create(container *NfsMountOptions) (*NfsMountOptions, error) {
+	resp := &NfsMountOptions{}
+	err := c.rancherClient.doCreate(NFS_MOUNT_OPTIONS_TYPE, container, resp)
+	return resp, err
+}
+
+func (c *NfsMountOptionsClient) Update(existing *NfsMountOptions, updates interface{}) (*NfsMountOptions, error) {
+	resp := &NfsMountOptions{}
+	err := c.rancherClient.doUpdate(NFS_MOUNT_OPTIONS_TYPE, &existing.Resource, updates, resp)
+	return resp, err
+}
+
+func (c *NfsMountOptionsClient) List(opts *ListOpts) (*NfsMountOptionsCollection, error) {
+	resp := &NfsMountOptionsCollection{}
+	err := c.rancherClient.doList(NFS_MOUNT_OPTIONS_TYPE, opts, resp)
+	resp.client = c
+	return resp, err
+}
+
+func (cc *NfsMountOptionsCollection) Next() (*NfsMountOptionsCollection, error) {
+	if cc != nil && cc.Pagination != nil && cc.Pagination.Next != "" {
+		resp := &NfsMountOptionsCollection{}
+		err := cc.client.rancherClient.doNext(cc.Pagination.Next, resp)
+		resp.client = cc.client
+		return resp, err
+	}
+	return nil, nil
+}
+
+func (c *NfsMountOptionsClient) ById(id string) (*NfsMountOptions, error) {
+	resp := &NfsMountOptions{}
+	err := c.rancherClient.doById(NFS_MOUNT_OPTIONS_TYPE, id, resp)
+	if apiError, ok := err.(*ApiError); ok {
+		if apiError.StatusCode == 404 {
+			return nil, nil
+		}
+	}
+	return resp, err
+}
+
+func (c *NfsMountOptionsClient) Delete(container *NfsMountOptions) error {
+	return c.rancherClient.doResourceDelete(NFS_MOUNT_OPTIONS_TYPE, &container.Resource)
+}
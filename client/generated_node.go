@@ -21,6 +21,10 @@ type Node struct {
 
 	InstanceManagerCPURequest int64 `json:"instanceManagerCPURequest,omitempty" yaml:"instance_manager_cpurequest,omitempty"`
 
+	Maintenance bool `json:"maintenance,omitempty" yaml:"maintenance,omitempty"`
+
+	MaintenanceStatus NodeMaintenanceStatus `json:"maintenanceStatus,omitempty" yaml:"maintenance_status,omitempty"`
+
 	Name string `json:"name,omitempty" yaml:"name,omitempty"`
 
 	Region string `json:"region,omitempty" yaml:"region,omitempty"`
@@ -48,6 +52,10 @@ type NodeOperations interface {
 	Delete(container *Node) error
 
 	ActionDiskUpdate(*Node, *DiskUpdateInput) (*Node, error)
+
+	ActionExitMaintenance(*Node) (*Node, error)
+
+	ActionMaintenance(*Node) (*Node, error)
 }
 
 func newNodeClient(rancherClient *RancherClient) *NodeClient {
@@ -108,3 +116,21 @@ func (c *NodeClient) ActionDiskUpdate(resource *Node, input *DiskUpdateInput) (*
 
 	return resp, err
 }
+
+func (c *NodeClient) ActionExitMaintenance(resource *Node) (*Node, error) {
+
+	resp := &Node{}
+
+	err := c.rancherClient.doAction(NODE_TYPE, "exitMaintenance", &resource.Resource, nil, resp)
+
+	return resp, err
+}
+
+func (c *NodeClient) ActionMaintenance(resource *Node) (*Node, error) {
+
+	resp := &Node{}
+
+	err := c.rancherClient.doAction(NODE_TYPE, "maintenance", &resource.Resource, nil, resp)
+
+	return resp, err
+}
@@ -0,0 +1,95 @@
+package client
+
+const (
+	NODE_MAINTENANCE_STATUS_TYPE = "nodeMaintenanceStatus"
+)
+
+type NodeMaintenanceStatus struct {
+	Resource `yaml:"-"`
+
+	CompletedAt string `json:"completedAt,omitempty" yaml:"completed_at,omitempty"`
+
+	EstimatedCompletionAt string `json:"estimatedCompletionAt,omitempty" yaml:"estimated_completion_at,omitempty"`
+
+	EvictingReplicas int64 `json:"evictingReplicas,omitempty" yaml:"evicting_replicas,omitempty"`
+
+	Message string `json:"message,omitempty" yaml:"message,omitempty"`
+
+	Progress int64 `json:"progress,omitempty" yaml:"progress,omitempty"`
+
+	StartedAt string `json:"startedAt,omitempty" yaml:"started_at,omitempty"`
+
+	State string `json:"state,omitempty" yaml:"state,omitempty"`
+
+	TotalTasks int64 `json:"totalTasks,omitempty" yaml:"total_tasks,omitempty"`
+
+	UnhealthyVolumes []string `json:"unhealthyVolumes,omitempty" yaml:"unhealthy_volumes,omitempty"`
+}
+
+type NodeMaintenanceStatusCollection struct {
+	Collection
+	Data   []NodeMaintenanceStatus `json:"data,omitempty"`
+	client *NodeMaintenanceStatusClient
+}
+
+type NodeMaintenanceStatusClient struct {
+	rancherClient *RancherClient
+}
+
+type NodeMaintenanceStatusOperations interface {
+	List(opts *ListOpts) (*NodeMaintenanceStatusCollection, error)
+	Create(opts *NodeMaintenanceStatus) (*NodeMaintenanceStatus, error)
+	Update(existing *NodeMaintenanceStatus, updates interface{}) (*NodeMaintenanceStatus, error)
+	ById(id string) (*NodeMaintenanceStatus, error)
+	Delete(container *NodeMaintenanceStatus) error
+}
+
+func newNodeMaintenanceStatusClient(rancherClient *RancherClient) *NodeMaintenanceStatusClient {
+	return &NodeMaintenanceStatusClient{
+		rancherClient: rancherClient,
+	}
+}
+
+func (c *NodeMaintenanceStatusClient) Create(container *NodeMaintenanceStatus) (*NodeMaintenanceStatus, error) {
+	resp := &NodeMaintenanceStatus{}
+	err := c.rancherClient.doCreate(NODE_MAINTENANCE_STATUS_TYPE, container, resp)
+	return resp, err
+}
+
+func (c *NodeMaintenanceStatusClient) Update(existing *NodeMaintenanceStatus, updates interface{}) (*NodeMaintenanceStatus, error) {
+	resp := &NodeMaintenanceStatus{}
+	err := c.rancherClient.doUpdate(NODE_MAINTENANCE_STATUS_TYPE, &existing.Resource, updates, resp)
+	return resp, err
+}
+
+func (c *NodeMaintenanceStatusClient) List(opts *ListOpts) (*NodeMaintenanceStatusCollection, error) {
+	resp := &NodeMaintenanceStatusCollection{}
+	err := c.rancherClient.doList(NODE_MAINTENANCE_STATUS_TYPE, opts, resp)
+	resp.client = c
+	return resp, err
+}
+
+func (cc *NodeMaintenanceStatusCollection) Next() (*NodeMaintenanceStatusCollection, error) {
+	if cc != nil && cc.Pagination != nil && cc.Pagination.Next != "" {
+		resp := &NodeMaintenanceStatusCollection{}
+		err := cc.client.rancherClient.doNext(cc.Pagination.Next, resp)
+		resp.client = cc.client
+		return resp, err
+	}
+	return nil, nil
+}
+
+func (c *NodeMaintenanceStatusClient) ById(id string) (*NodeMaintenanceStatus, error) {
+	resp := &NodeMaintenanceStatus{}
+	err := c.rancherClient.doById(NODE_MAINTENANCE_STATUS_TYPE, id, resp)
+	if apiError, ok := err.(*ApiError); ok {
+		if apiError.StatusCode == 404 {
+			return nil, nil
+		}
+	}
+	return resp, err
+}
+
+func (c *NodeMaintenanceStatusClient) Delete(container *NodeMaintenanceStatus) error {
+	return c.rancherClient.doResourceDelete(NODE_MAINTENANCE_STATUS_TYPE, &container.Resource)
+}
@@ -25,6 +25,8 @@ type RecurringJob struct {
 
 	Retain int64 `json:"retain,omitempty" yaml:"retain,omitempty"`
 
+	RetentionPolicy RecurringJobRetentionPolicy `json:"retentionPolicy,omitempty" yaml:"retention_policy,omitempty"`
+
 	Task string `json:"task,omitempty" yaml:"task,omitempty"`
 }
 
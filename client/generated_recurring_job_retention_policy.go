@@ -0,0 +1,87 @@
+package client
+
+const (
+	RECURRING_JOB_RETENTION_POLICY_TYPE = "recurringJobRetentionPolicy"
+)
+
+type RecurringJobRetentionPolicy struct {
+	Resource `yaml:"-"`
+
+	Daily int64 `json:"daily,omitempty" yaml:"daily,omitempty"`
+
+	Hourly int64 `json:"hourly,omitempty" yaml:"hourly,omitempty"`
+
+	Monthly int64 `json:"monthly,omitempty" yaml:"monthly,omitempty"`
+
+	Weekly int64 `json:"weekly,omitempty" yaml:"weekly,omitempty"`
+
+	Yearly int64 `json:"yearly,omitempty" yaml:"yearly,omitempty"`
+}
+
+type RecurringJobRetentionPolicyCollection struct {
+	Collection
+	Data   []RecurringJobRetentionPolicy `json:"data,omitempty"`
+	client *RecurringJobRetentionPolicyClient
+}
+
+type RecurringJobRetentionPolicyClient struct {
+	rancherClient *RancherClient
+}
+
+type RecurringJobRetentionPolicyOperations interface {
+	List(opts *ListOpts) (*RecurringJobRetentionPolicyCollection, error)
+	Create(opts *RecurringJobRetentionPolicy) (*RecurringJobRetentionPolicy, error)
+	Update(existing *RecurringJobRetentionPolicy, updates interface{}) (*RecurringJobRetentionPolicy, error)
+	ById(id string) (*RecurringJobRetentionPolicy, error)
+	Delete(container *RecurringJobRetentionPolicy) error
+}
+
+func newRecurringJobRetentionPolicyClient(rancherClient *RancherClient) *RecurringJobRetentionPolicyClient {
+	return &RecurringJobRetentionPolicyClient{
+		rancherClient: rancherClient,
+	}
+}
+
+func (c *RecurringJobRetentionPolicyClient) Create(container *RecurringJobRetentionPolicy) (*RecurringJobRetentionPolicy, error) {
+	resp := &RecurringJobRetentionPolicy{}
+	err := c.rancherClient.doCreate(RECURRING_JOB_RETENTION_POLICY_TYPE, container, resp)
+	return resp, err
+}
+
+func (c *RecurringJobRetentionPolicyClient) Update(existing *RecurringJobRetentionPolicy, updates interface{}) (*RecurringJobRetentionPolicy, error) {
+	resp := &RecurringJobRetentionPolicy{}
+	err := c.rancherClient.doUpdate(RECURRING_JOB_RETENTION_POLICY_TYPE, &existing.Resource, updates, resp)
+	return resp, err
+}
+
+func (c *RecurringJobRetentionPolicyClient) List(opts *ListOpts) (*RecurringJobRetentionPolicyCollection, error) {
+	resp := &RecurringJobRetentionPolicyCollection{}
+	err := c.rancherClient.doList(RECURRING_JOB_RETENTION_POLICY_TYPE, opts, resp)
+	resp.client = c
+	return resp, err
+}
+
+func (cc *RecurringJobRetentionPolicyCollection) Next() (*RecurringJobRetentionPolicyCollection, error) {
+	if cc != nil && cc.Pagination != nil && cc.Pagination.Next != "" {
+		resp := &RecurringJobRetentionPolicyCollection{}
+		err := cc.client.rancherClient.doNext(cc.Pagination.Next, resp)
+		resp.client = cc.client
+		return resp, err
+	}
+	return nil, nil
+}
+
+func (c *RecurringJobRetentionPolicyClient) ById(id string) (*RecurringJobRetentionPolicy, error) {
+	resp := &RecurringJobRetentionPolicy{}
+	err := c.rancherClient.doById(RECURRING_JOB_RETENTION_POLICY_TYPE, id, resp)
+	if apiError, ok := err.(*ApiError); ok {
+		if apiError.StatusCode == 404 {
+			return nil, nil
+		}
+	}
+	return resp, err
+}
+
+func (c *RecurringJobRetentionPolicyClient) Delete(container *RecurringJobRetentionPolicy) error {
+	return c.rancherClient.doResourceDelete(RECURRING_JOB_RETENTION_POLICY_TYPE, &container.Resource)
+}
@@ -27,11 +27,17 @@ type Replica struct {
 
 	InstanceManagerName string `json:"instanceManagerName,omitempty" yaml:"instance_manager_name,omitempty"`
 
+	LastSnapshotChecksumCheckedAt string `json:"lastSnapshotChecksumCheckedAt,omitempty" yaml:"last_snapshot_checksum_checked_at,omitempty"`
+
 	Mode string `json:"mode,omitempty" yaml:"mode,omitempty"`
 
 	Name string `json:"name,omitempty" yaml:"name,omitempty"`
 
 	Running bool `json:"running,omitempty" yaml:"running,omitempty"`
+
+	MismatchedSnapshotChecksums int64 `json:"mismatchedSnapshotChecksums,omitempty" yaml:"mismatched_snapshot_checksums,omitempty"`
+
+	MismatchedBlocks int64 `json:"mismatchedBlocks,omitempty" yaml:"mismatched_blocks,omitempty"`
 }
 
 type ReplicaCollection struct {
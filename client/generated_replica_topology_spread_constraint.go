@@ -0,0 +1,83 @@
+package client
+
+const (
+	REPLICA_TOPOLOGY_SPREAD_CONSTRAINT_TYPE = "replicaTopologySpreadConstraint"
+)
+
+type ReplicaTopologySpreadConstraint struct {
+	Resource `yaml:"-"`
+
+	MaxSkew int64 `json:"maxSkew,omitempty" yaml:"max_skew,omitempty"`
+
+	Mode string `json:"mode,omitempty" yaml:"mode,omitempty"`
+
+	TopologyKey string `json:"topologyKey,omitempty" yaml:"topology_key,omitempty"`
+}
+
+type ReplicaTopologySpreadConstraintCollection struct {
+	Collection
+	Data   []ReplicaTopologySpreadConstraint `json:"data,omitempty"`
+	client *ReplicaTopologySpreadConstraintClient
+}
+
+type ReplicaTopologySpreadConstraintClient struct {
+	rancherClient *RancherClient
+}
+
+type ReplicaTopologySpreadConstraintOperations interface {
+	List(opts *ListOpts) (*ReplicaTopologySpreadConstraintCollection, error)
+	Create(opts *ReplicaTopologySpreadConstraint) (*ReplicaTopologySpreadConstraint, error)
+	Update(existing *ReplicaTopologySpreadConstraint, updates interface{}) (*ReplicaTopologySpreadConstraint, error)
+	ById(id string) (*ReplicaTopologySpreadConstraint, error)
+	Delete(container *ReplicaTopologySpreadConstraint) error
+}
+
+func newReplicaTopologySpreadConstraintClient(rancherClient *RancherClient) *ReplicaTopologySpreadConstraintClient {
+	return &ReplicaTopologySpreadConstraintClient{
+		rancherClient: rancherClient,
+	}
+}
+
+func (c *ReplicaTopologySpreadConstraintClient) Create(container *ReplicaTopologySpreadConstraint) (*ReplicaTopologySpreadConstraint, error) {
+	resp := &ReplicaTopologySpreadConstraint{}
+	err := c.rancherClient.doCreate(REPLICA_TOPOLOGY_SPREAD_CONSTRAINT_TYPE, container, resp)
+	return resp, err
+}
+
+func (c *ReplicaTopologySpreadConstraintClient) Update(existing *ReplicaTopologySpreadConstraint, updates interface{}) (*ReplicaTopologySpreadConstraint, error) {
+	resp := &ReplicaTopologySpreadConstraint{}
+	err := c.rancherClient.doUpdate(REPLICA_TOPOLOGY_SPREAD_CONSTRAINT_TYPE, &existing.Resource, updates, resp)
+	return resp, err
+}
+
+func (c *ReplicaTopologySpreadConstraintClient) List(opts *ListOpts) (*ReplicaTopologySpreadConstraintCollection, error) {
+	resp := &ReplicaTopologySpreadConstraintCollection{}
+	err := c.rancherClient.doList(REPLICA_TOPOLOGY_SPREAD_CONSTRAINT_TYPE, opts, resp)
+	resp.client = c
+	return resp, err
+}
+
+func (cc *ReplicaTopologySpreadConstraintCollection) Next() (*ReplicaTopologySpreadConstraintCollection, error) {
+	if cc != nil && cc.Pagination != nil && cc.Pagination.Next != "" {
+		resp := &ReplicaTopologySpreadConstraintCollection{}
+		err := cc.client.rancherClient.doNext(cc.Pagination.Next, resp)
+		resp.client = cc.client
+		return resp, err
+	}
+	return nil, nil
+}
+
+func (c *ReplicaTopologySpreadConstraintClient) ById(id string) (*ReplicaTopologySpreadConstraint, error) {
+	resp := &ReplicaTopologySpreadConstraint{}
+	err := c.rancherClient.doById(REPLICA_TOPOLOGY_SPREAD_CONSTRAINT_TYPE, id, resp)
+	if apiError, ok := err.(*ApiError); ok {
+		if apiError.StatusCode == 404 {
+			return nil, nil
+		}
+	}
+	return resp, err
+}
+
+func (c *ReplicaTopologySpreadConstraintClient) Delete(container *ReplicaTopologySpreadConstraint) error {
+	return c.rancherClient.doResourceDelete(REPLICA_TOPOLOGY_SPREAD_CONSTRAINT_TYPE, &container.Resource)
+}
@@ -41,6 +41,8 @@ type Volume struct {
 
 	DiskSelector []string `json:"diskSelector,omitempty" yaml:"disk_selector,omitempty"`
 
+	DiskTiers []string `json:"diskTiers,omitempty" yaml:"disk_tiers,omitempty"`
+
 	Encrypted bool `json:"encrypted,omitempty" yaml:"encrypted,omitempty"`
 
 	FreezeFilesystemForSnapshot string `json:"freezeFilesystemForSnapshot,omitempty" yaml:"freeze_filesystem_for_snapshot,omitempty"`
@@ -59,16 +61,28 @@ type Volume struct {
 
 	LastBackupAt string `json:"lastBackupAt,omitempty" yaml:"last_backup_at,omitempty"`
 
+	LastRestoreCompletedAt string `json:"lastRestoreCompletedAt,omitempty" yaml:"last_restore_completed_at,omitempty"`
+
+	LastRestoredBackup string `json:"lastRestoredBackup,omitempty" yaml:"last_restored_backup,omitempty"`
+
+	LastRestoredBackupAt string `json:"lastRestoredBackupAt,omitempty" yaml:"last_restored_backup_at,omitempty"`
+
+	LastSnapshotChecksumCheckCompleteAt string `json:"lastSnapshotChecksumCheckCompleteAt,omitempty" yaml:"last_snapshot_checksum_check_complete_at,omitempty"`
+
 	Migratable bool `json:"migratable,omitempty" yaml:"migratable,omitempty"`
 
 	Name string `json:"name,omitempty" yaml:"name,omitempty"`
 
+	NfsMountOptions NfsMountOptions `json:"nfsMountOptions,omitempty" yaml:"nfs_mount_options,omitempty"`
+
 	NodeSelector []string `json:"nodeSelector,omitempty" yaml:"node_selector,omitempty"`
 
 	NumberOfReplicas int64 `json:"numberOfReplicas,omitempty" yaml:"number_of_replicas,omitempty"`
 
 	OfflineRebuilding string `json:"offlineRebuilding,omitempty" yaml:"offline_rebuilding,omitempty"`
 
+	PendingRestoreBackupCount int64 `json:"pendingRestoreBackupCount,omitempty" yaml:"pending_restore_backup_count,omitempty"`
+
 	PurgeStatus []PurgeStatus `json:"purgeStatus,omitempty" yaml:"purge_status,omitempty"`
 
 	Ready bool `json:"ready,omitempty" yaml:"ready,omitempty"`
@@ -85,8 +99,12 @@ type Volume struct {
 
 	ReplicaRebuildingBandwidthLimit int64 `json:"replicaRebuildingBandwidthLimit,omitempty" yaml:"replica_rebuilding_bandwidth_limit,omitempty"`
 
+	ReplicaSchedulingPolicy string `json:"replicaSchedulingPolicy,omitempty" yaml:"replica_scheduling_policy,omitempty"`
+
 	ReplicaSoftAntiAffinity string `json:"replicaSoftAntiAffinity,omitempty" yaml:"replica_soft_anti_affinity,omitempty"`
 
+	ReplicaTopologySpread []ReplicaTopologySpreadConstraint `json:"replicaTopologySpread,omitempty" yaml:"replica_topology_spread,omitempty"`
+
 	ReplicaZoneSoftAntiAffinity string `json:"replicaZoneSoftAntiAffinity,omitempty" yaml:"replica_zone_soft_anti_affinity,omitempty"`
 
 	Replicas []Replica `json:"replicas,omitempty" yaml:"replicas,omitempty"`
@@ -103,6 +121,8 @@ type Volume struct {
 
 	Robustness string `json:"robustness,omitempty" yaml:"robustness,omitempty"`
 
+	SnapshotChecksumCheckRequestedAt string `json:"snapshotChecksumCheckRequestedAt,omitempty" yaml:"snapshot_checksum_check_requested_at,omitempty"`
+
 	ShareEndpoint string `json:"shareEndpoint,omitempty" yaml:"share_endpoint,omitempty"`
 
 	ShareState string `json:"shareState,omitempty" yaml:"share_state,omitempty"`
@@ -153,6 +173,10 @@ type VolumeOperations interface {
 
 	ActionCancelExpansion(*Volume) (*Volume, error)
 
+	ActionCheckBackupBlocks(*Volume) (*Volume, error)
+
+	ActionCheckFilesystem(*Volume) (*Volume, error)
+
 	ActionDetach(*Volume, *DetachInput) (*Volume, error)
 
 	ActionExpand(*Volume, *ExpandInput) (*Volume, error)
@@ -173,6 +197,8 @@ type VolumeOperations interface {
 
 	ActionSalvage(*Volume, *SalvageInput) (*Volume, error)
 
+	ActionSnapshotChecksumCheck(*Volume) (*Volume, error)
+
 	ActionSnapshotBackup(*Volume, *SnapshotInput) (*Volume, error)
 
 	ActionSnapshotCRCreate(*Volume, *SnapshotCRInput) (*SnapshotCR, error)
@@ -198,6 +224,20 @@ type VolumeOperations interface {
 	ActionTrimFilesystem(*Volume) (*Volume, error)
 
 	ActionUpdateAccessMode(*Volume, *UpdateAccessModeInput) (*Volume, error)
+
+	ActionUpdateBackupTargetName(*Volume, *UpdateBackupTargetInput) (*Volume, error)
+
+	ActionUpdateDataLocality(*Volume, *UpdateDataLocalityInput) (*Volume, error)
+
+	ActionUpdateReplicaAutoBalance(*Volume, *UpdateReplicaAutoBalanceInput) (*Volume, error)
+
+	ActionUpdateReplicaCount(*Volume, *UpdateReplicaCountInput) (*Volume, error)
+
+	ActionUpdateReplicaRebuildingBandwidthLimit(*Volume, *UpdateReplicaRebuildingBandwidthLimitInput) (*Volume, error)
+
+	ActionUpdateSnapshotMaxCount(*Volume, *UpdateSnapshotMaxCountInput) (*Volume, error)
+
+	ActionUpdateSnapshotMaxSize(*Volume, *UpdateSnapshotMaxSizeInput) (*Volume, error)
 }
 
 func newVolumeClient(rancherClient *RancherClient) *VolumeClient {
@@ -277,6 +317,24 @@ func (c *VolumeClient) ActionCancelExpansion(resource *Volume) (*Volume, error)
 	return resp, err
 }
 
+func (c *VolumeClient) ActionCheckBackupBlocks(resource *Volume) (*Volume, error) {
+
+	resp := &Volume{}
+
+	err := c.rancherClient.doAction(VOLUME_TYPE, "checkBackupBlocks", &resource.Resource, nil, resp)
+
+	return resp, err
+}
+
+func (c *VolumeClient) ActionCheckFilesystem(resource *Volume) (*Volume, error) {
+
+	resp := &Volume{}
+
+	err := c.rancherClient.doAction(VOLUME_TYPE, "checkFilesystem", &resource.Resource, nil, resp)
+
+	return resp, err
+}
+
 func (c *VolumeClient) ActionDetach(resource *Volume, input *DetachInput) (*Volume, error) {
 
 	resp := &Volume{}
@@ -367,6 +425,15 @@ func (c *VolumeClient) ActionSalvage(resource *Volume, input *SalvageInput) (*Vo
 	return resp, err
 }
 
+func (c *VolumeClient) ActionSnapshotChecksumCheck(resource *Volume) (*Volume, error) {
+
+	resp := &Volume{}
+
+	err := c.rancherClient.doAction(VOLUME_TYPE, "snapshotChecksumCheck", &resource.Resource, nil, resp)
+
+	return resp, err
+}
+
 func (c *VolumeClient) ActionSnapshotBackup(resource *Volume, input *SnapshotInput) (*Volume, error) {
 
 	resp := &Volume{}
@@ -483,3 +550,66 @@ func (c *VolumeClient) ActionUpdateAccessMode(resource *Volume, input *UpdateAcc
 
 	return resp, err
 }
+
+func (c *VolumeClient) ActionUpdateBackupTargetName(resource *Volume, input *UpdateBackupTargetInput) (*Volume, error) {
+
+	resp := &Volume{}
+
+	err := c.rancherClient.doAction(VOLUME_TYPE, "updateBackupTargetName", &resource.Resource, input, resp)
+
+	return resp, err
+}
+
+func (c *VolumeClient) ActionUpdateDataLocality(resource *Volume, input *UpdateDataLocalityInput) (*Volume, error) {
+
+	resp := &Volume{}
+
+	err := c.rancherClient.doAction(VOLUME_TYPE, "updateDataLocality", &resource.Resource, input, resp)
+
+	return resp, err
+}
+
+func (c *VolumeClient) ActionUpdateReplicaAutoBalance(resource *Volume, input *UpdateReplicaAutoBalanceInput) (*Volume, error) {
+
+	resp := &Volume{}
+
+	err := c.rancherClient.doAction(VOLUME_TYPE, "updateReplicaAutoBalance", &resource.Resource, input, resp)
+
+	return resp, err
+}
+
+func (c *VolumeClient) ActionUpdateReplicaCount(resource *Volume, input *UpdateReplicaCountInput) (*Volume, error) {
+
+	resp := &Volume{}
+
+	err := c.rancherClient.doAction(VOLUME_TYPE, "updateReplicaCount", &resource.Resource, input, resp)
+
+	return resp, err
+}
+
+func (c *VolumeClient) ActionUpdateReplicaRebuildingBandwidthLimit(resource *Volume, input *UpdateReplicaRebuildingBandwidthLimitInput) (*Volume, error) {
+
+	resp := &Volume{}
+
+	err := c.rancherClient.doAction(VOLUME_TYPE, "updateReplicaRebuildingBandwidthLimit", &resource.Resource, input, resp)
+
+	return resp, err
+}
+
+func (c *VolumeClient) ActionUpdateSnapshotMaxCount(resource *Volume, input *UpdateSnapshotMaxCountInput) (*Volume, error) {
+
+	resp := &Volume{}
+
+	err := c.rancherClient.doAction(VOLUME_TYPE, "updateSnapshotMaxCount", &resource.Resource, input, resp)
+
+	return resp, err
+}
+
+func (c *VolumeClient) ActionUpdateSnapshotMaxSize(resource *Volume, input *UpdateSnapshotMaxSizeInput) (*Volume, error) {
+
+	resp := &Volume{}
+
+	err := c.rancherClient.doAction(VOLUME_TYPE, "updateSnapshotMaxSize", &resource.Resource, input, resp)
+
+	return resp, err
+}
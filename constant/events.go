@@ -26,6 +26,9 @@ const (
 	EventReasonTimeoutSnapshotPurge        = "TimeoutSnapshotPurge"
 	EventReasonFailedSnapshotPurge         = "FailedSnapshotPurge"
 
+	EventReasonSucceededSnapshotHook = "SucceededSnapshotHook"
+	EventReasonFailedSnapshotHook    = "FailedSnapshotHook"
+
 	EventReasonRestored      = "Restored"
 	EventReasonRestoredFmt   = "Restored %v"
 	EventReasonFailedRestore = "FailedRestore"
@@ -46,6 +49,11 @@ const (
 	EventReasonEvictionUserRequested = "EvictionUserRequested"
 	EventReasonEvictionCanceled      = "EvictionCanceled"
 	EventReasonEvictionFailed        = "EvictionFailed"
+	EventReasonEvictionMaintenance   = "EvictionMaintenance"
+
+	EventReasonMaintenanceStarted       = "MaintenanceStarted"
+	EventReasonMaintenanceSafeForReboot = "MaintenanceSafeForReboot"
+	EventReasonMaintenanceExited        = "MaintenanceExited"
 
 	EventReasonDetachedUnexpectedly = "DetachedUnexpectedly"
 	EventReasonRemount              = "Remount"
@@ -59,6 +67,13 @@ const (
 
 	EventReasonFailedSnapshotDataIntegrityCheck = "FailedSnapshotDataIntegrityCheck"
 
+	EventReasonSnapshotChecksumChecked     = "SnapshotChecksumChecked"
+	EventReasonSnapshotChecksumMismatch    = "SnapshotChecksumMismatch"
+	EventReasonFailedSnapshotChecksumCheck = "FailedSnapshotChecksumCheck"
+
+	EventReasonFailedOver = "FailedOver"
+	EventReasonFailedBack = "FailedBack"
+
 	EventReasonFailed   = "Failed"
 	EventReasonReady    = "Ready"
 	EventReasonUploaded = "Uploaded"
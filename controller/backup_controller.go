@@ -4,6 +4,7 @@ import (
 	"fmt"
 	"reflect"
 	"strconv"
+	"strings"
 	"sync"
 	"time"
 
@@ -48,6 +49,7 @@ const (
 	FailedToGetSnapshotMessage             = "Failed to get the Snapshot %v"
 	FailedToDeleteBackupMessage            = "Failed to delete the backup %v in the backupstore, err %v"
 	NoDeletionInProgressRecordMessage      = "No deletion in progress record, retry the deletion command"
+	WaitForBackupWindowMessage             = "Waiting for the backup window %v of the backup target to open"
 )
 
 const (
@@ -330,6 +332,11 @@ func (bc *BackupController) reconcile(backupName string) (err error) {
 			return errors.Wrap(err, "failed to check if it needs to delete remote backup data")
 		}
 		if needsCleanupRemoteData && backupVolume != nil && backupVolume.DeletionTimestamp == nil {
+			if datastore.IsBackupRetentionLocked(backup) {
+				lockedUntil := backup.Status.RetentionLockedUntil.Time
+				log.Warnf("Waiting for the retention lock of the backup to expire at %v before deleting it from the backup target", util.FormatTimeZ(lockedUntil))
+				return enqueueAfterDelay(bc.queue, backup, time.Until(lockedUntil))
+			}
 			backupTargetClient, err := newBackupTargetClientFromDefaultEngineImage(bc.ds, backupTarget)
 			if err != nil {
 				log.WithError(err).Warn("Failed to init backup target clients")
@@ -434,6 +441,10 @@ func (bc *BackupController) reconcile(backupName string) (err error) {
 			return nil // Ignore error to prevent enqueue
 		}
 
+		if delay := bc.checkBackupWindow(backup, backupTarget); delay > 0 {
+			return enqueueAfterDelay(bc.queue, backup, delay)
+		}
+
 		if err := bc.handleAttachmentTicketCreation(backup, canonicalBackupVolumeName); err != nil {
 			return err
 		}
@@ -526,6 +537,7 @@ func (bc *BackupController) reconcile(backupName string) (err error) {
 	backup.Status.LastSyncedAt = syncTime
 	backup.Status.NewlyUploadedDataSize = backupInfo.NewlyUploadedDataSize
 	backup.Status.ReUploadedDataSize = backupInfo.ReUploadedDataSize
+	backup.Status.RetentionLockedUntil = metav1.Time{Time: datastore.GetBackupRetentionLockedUntil(backup, backupTarget)}
 	return err
 }
 
@@ -975,6 +987,42 @@ func (bc *BackupController) syncBackupVolume(backupTargetName, volumeName string
 	return nil
 }
 
+// checkBackupWindow keeps a backup that has not started yet pending while the current time is outside the
+// backup window of the backup target, and returns the duration until the window opens. The snapshot name is
+// recorded in the status of a pending backup so that the volume backup status already lists it.
+func (bc *BackupController) checkBackupWindow(backup *longhorn.Backup, backupTarget *longhorn.BackupTarget) time.Duration {
+	if bc.hasMonitor(backup.Name) != nil {
+		return 0
+	}
+	if backup.Status.State != longhorn.BackupStateNew && backup.Status.State != longhorn.BackupStatePending {
+		return 0
+	}
+
+	window := backupTarget.Spec.BackupWindow
+	inWindow, untilOpen, err := types.IsInBackupWindow(window, time.Now())
+	if err != nil {
+		bc.logger.WithError(err).Warnf("Ignoring the invalid backup window %v of backup target %v", window, backupTarget.Name)
+		return 0
+	}
+
+	if inWindow {
+		// The window may have changed since the message was recorded
+		windowMessagePrefix := strings.SplitN(WaitForBackupWindowMessage, "%v", 2)[0]
+		if strings.HasPrefix(backup.Status.Messages[MessageTypeReconcileInfo], windowMessagePrefix) {
+			delete(backup.Status.Messages, MessageTypeReconcileInfo)
+		}
+		return 0
+	}
+
+	if backup.Status.Messages == nil {
+		backup.Status.Messages = map[string]string{}
+	}
+	backup.Status.State = longhorn.BackupStatePending
+	backup.Status.SnapshotName = backup.Spec.SnapshotName
+	backup.Status.Messages[MessageTypeReconcileInfo] = fmt.Sprintf(WaitForBackupWindowMessage, window)
+	return untilOpen
+}
+
 func (bc *BackupController) hasMonitor(backupName string) *engineapi.BackupMonitor {
 	bc.monitorLock.RLock()
 	defer bc.monitorLock.RUnlock()
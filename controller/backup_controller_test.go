@@ -0,0 +1,48 @@
+package controller
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/sirupsen/logrus"
+
+	"github.com/longhorn/longhorn-manager/engineapi"
+
+	longhorn "github.com/longhorn/longhorn-manager/k8s/pkg/apis/longhorn/v1beta2"
+
+	. "gopkg.in/check.v1"
+)
+
+func (s *TestSuite) TestCheckBackupWindow(c *C) {
+	bc := &BackupController{
+		baseController: newBaseController("longhorn-backup", logrus.StandardLogger()),
+		monitors:       map[string]*engineapi.BackupMonitor{},
+	}
+
+	now := time.Now().UTC()
+	closedWindow := fmt.Sprintf("%s-%s", now.Add(time.Hour).Format("15:04"), now.Add(2*time.Hour).Format("15:04"))
+	backupTarget := &longhorn.BackupTarget{Spec: longhorn.BackupTargetSpec{BackupWindow: closedWindow}}
+
+	backup := &longhorn.Backup{Spec: longhorn.BackupSpec{SnapshotName: "snap-1"}}
+	backup.Name = "backup-1"
+	backup.Status.State = longhorn.BackupStateNew
+
+	// A backup outside the window is pending and already reports its snapshot
+	delay := bc.checkBackupWindow(backup, backupTarget)
+	c.Assert(delay > 0, Equals, true)
+	c.Assert(delay <= time.Hour, Equals, true)
+	c.Assert(backup.Status.State, Equals, longhorn.BackupStatePending)
+	c.Assert(backup.Status.SnapshotName, Equals, "snap-1")
+	c.Assert(backup.Status.Messages[MessageTypeReconcileInfo], Equals, fmt.Sprintf(WaitForBackupWindowMessage, closedWindow))
+
+	// The backup starts once the window opens
+	backupTarget.Spec.BackupWindow = fmt.Sprintf("%s-%s", now.Add(-time.Hour).Format("15:04"), now.Add(time.Hour).Format("15:04"))
+	c.Assert(bc.checkBackupWindow(backup, backupTarget), Equals, time.Duration(0))
+	c.Assert(backup.Status.Messages[MessageTypeReconcileInfo], Equals, "")
+
+	// A backup that already started is not deferred
+	backupTarget.Spec.BackupWindow = closedWindow
+	backup.Status.State = longhorn.BackupStateInProgress
+	c.Assert(bc.checkBackupWindow(backup, backupTarget), Equals, time.Duration(0))
+	c.Assert(backup.Status.State, Equals, longhorn.BackupStateInProgress)
+}
@@ -52,6 +52,16 @@ type BackupTargetController struct {
 	bsTimerMap     map[string]*BackupStoreTimer
 	bsTimerMapLock *sync.RWMutex
 
+	// system backup replications keeps the system backups being copied to the replication targets
+	systemBackupReplications    sets.Set[string]
+	systemBackupReplicationLock *sync.Mutex
+
+	// backup replications keeps the backups being copied to the replication targets, and the errors of the
+	// failed copies until they are retried
+	backupReplications      sets.Set[string]
+	backupReplicationErrors map[string]string
+	backupReplicationLock   *sync.Mutex
+
 	ds *datastore.DataStore
 
 	cacheSyncs []cache.InformerSynced
@@ -94,6 +104,13 @@ func NewBackupTargetController(
 		bsTimerMap:     map[string]*BackupStoreTimer{},
 		bsTimerMapLock: &sync.RWMutex{},
 
+		systemBackupReplications:    sets.New[string](),
+		systemBackupReplicationLock: &sync.Mutex{},
+
+		backupReplications:      sets.New[string](),
+		backupReplicationErrors: map[string]string{},
+		backupReplicationLock:   &sync.Mutex{},
+
 		ds: ds,
 
 		kubeClient:    kubeClient,
@@ -505,6 +522,10 @@ func (btc *BackupTargetController) reconcile(name string) (err error) {
 		}
 	}
 
+	if err = btc.replicateBackupTarget(backupTarget, backupTargetClient, log); err != nil {
+		return err
+	}
+
 	return nil
 }
 
@@ -676,6 +697,16 @@ func (btc *BackupTargetController) cleanupBackupVolumeNotExistOnBackupTarget(clu
 		}
 
 		backupVolumeName := bv.Name
+		isLocked, err := btc.isBackupVolumeRetentionLocked(bv)
+		if err != nil {
+			errs.Append("errors", err)
+			continue
+		}
+		// The retention lock keeps the backups and the backup volume in the cluster until it expires
+		if isLocked {
+			log.WithField("backupVolume", backupVolumeName).Warn("Skipped deleting BackupVolume not exist in backupstore since its backups are retention locked")
+			continue
+		}
 		log.WithField("backupVolume", backupVolumeName).Info("Deleting BackupVolume not exist in backupstore")
 		if err := btc.deleteBackupVolumeCROnly(backupVolumeName, log); err != nil {
 			if apierrors.IsNotFound(err) {
@@ -691,6 +722,19 @@ func (btc *BackupTargetController) cleanupBackupVolumeNotExistOnBackupTarget(clu
 	return nil
 }
 
+func (btc *BackupTargetController) isBackupVolumeRetentionLocked(backupVolume *longhorn.BackupVolume) (bool, error) {
+	backups, err := btc.ds.ListBackupsWithBackupVolumeName(backupVolume.Spec.BackupTargetName, backupVolume.Spec.VolumeName)
+	if err != nil {
+		return false, errors.Wrapf(err, "failed to list backups of BackupVolume %s", backupVolume.Name)
+	}
+	for _, backup := range backups {
+		if datastore.IsBackupRetentionLocked(backup) {
+			return true, nil
+		}
+	}
+	return false, nil
+}
+
 func (btc *BackupTargetController) deleteBackupVolumeCROnly(backupVolumeName string, log logrus.FieldLogger) error {
 	if err := datastore.AddBackupVolumeDeleteCustomResourceOnlyLabel(btc.ds, backupVolumeName); err != nil {
 		return errors.Wrapf(err, "failed to add label delete-custom-resource-only to BackupVolume %s", backupVolumeName)
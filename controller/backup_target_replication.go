@@ -0,0 +1,554 @@
+package controller
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/cockroachdb/errors"
+	"github.com/sirupsen/logrus"
+
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/longhorn/backupstore"
+
+	systembackupstore "github.com/longhorn/backupstore/systembackup"
+
+	"github.com/longhorn/longhorn-manager/datastore"
+	"github.com/longhorn/longhorn-manager/engineapi"
+	"github.com/longhorn/longhorn-manager/types"
+	"github.com/longhorn/longhorn-manager/util"
+
+	longhorn "github.com/longhorn/longhorn-manager/k8s/pkg/apis/longhorn/v1beta2"
+)
+
+const (
+	// backupReplicationConcurrentLimit is the maximum number of backups being copied to a replication target at the same time
+	backupReplicationConcurrentLimit = 5
+	// replicationStatusMessageLimit is the maximum number of messages kept in the replication status
+	replicationStatusMessageLimit = 5
+)
+
+// backupReplicationPlan describes what to do for the backups of a backup target to be in sync with its replication target
+type backupReplicationPlan struct {
+	// The primary backups without a copy on the replication target, in the order of creation.
+	toCopy []*longhorn.Backup
+	// The copies on the replication target that failed to be synced and have to be recreated.
+	toDelete []*longhorn.Backup
+	// The number of copies still in progress.
+	inProgress int
+	replicated int
+	messages   []string
+}
+
+// planBackupReplication compares the completed backups of the primary backup target with their copies on the
+// replication target. A copy is matched to a primary backup by the replicated-from-backup label, so the plan can be
+// resumed from the existing backups after an interruption. The copies are verified by their block checksums before
+// they are published on the replication target, so a completed copy is not checked again here.
+func planBackupReplication(primaryBackups, replicationBackups map[string]*longhorn.Backup) *backupReplicationPlan {
+	copies := map[string]*longhorn.Backup{}
+	for _, b := range replicationBackups {
+		origin := datastore.GetReplicatedBackupOrigin(b)
+		if existing, ok := copies[origin]; ok && existing.Status.State == longhorn.BackupStateCompleted {
+			continue
+		}
+		copies[origin] = b
+	}
+
+	primaries := []*longhorn.Backup{}
+	for _, b := range primaryBackups {
+		if b.Status.State == longhorn.BackupStateCompleted {
+			primaries = append(primaries, b)
+		}
+	}
+	sort.Slice(primaries, func(i, j int) bool {
+		if primaries[i].Status.BackupCreatedAt != primaries[j].Status.BackupCreatedAt {
+			return primaries[i].Status.BackupCreatedAt < primaries[j].Status.BackupCreatedAt
+		}
+		return primaries[i].Name < primaries[j].Name
+	})
+
+	plan := &backupReplicationPlan{}
+	for _, primary := range primaries {
+		backupCopy := copies[datastore.GetReplicatedBackupOrigin(primary)]
+		if backupCopy == nil {
+			plan.toCopy = append(plan.toCopy, primary)
+			continue
+		}
+		switch backupCopy.Status.State {
+		case longhorn.BackupStateCompleted:
+			plan.replicated++
+		case longhorn.BackupStateError, longhorn.BackupStateUnknown:
+			plan.toDelete = append(plan.toDelete, backupCopy)
+			plan.messages = append(plan.messages, fmt.Sprintf("failed to copy backup %v: %v", primary.Name, backupCopy.Status.Error))
+		default:
+			plan.inProgress++
+		}
+	}
+	return plan
+}
+
+// replicateBackupTarget copies the backups, backing image backups and system backups of the backup target to its
+// replication target, and records the progress in the backup target status.
+func (btc *BackupTargetController) replicateBackupTarget(backupTarget *longhorn.BackupTarget, backupTargetClient *engineapi.BackupTargetClient, log logrus.FieldLogger) error {
+	replicationTargetName := backupTarget.Spec.ReplicationTargetName
+	for name := range backupTarget.Status.ReplicationStatus {
+		if name != replicationTargetName {
+			delete(backupTarget.Status.ReplicationStatus, name)
+		}
+	}
+	if replicationTargetName == "" {
+		backupTarget.Status.ReplicationStatus = nil
+		return nil
+	}
+	if backupTarget.Status.ReplicationStatus == nil {
+		backupTarget.Status.ReplicationStatus = map[string]*longhorn.BackupTargetReplicationStatus{}
+	}
+	status := &longhorn.BackupTargetReplicationStatus{}
+	if existing := backupTarget.Status.ReplicationStatus[replicationTargetName]; existing != nil {
+		status.LastReplicatedAt = existing.LastReplicatedAt
+	}
+	backupTarget.Status.ReplicationStatus[replicationTargetName] = status
+
+	log = log.WithField("replicationTarget", replicationTargetName)
+
+	replicationTarget, err := btc.ds.GetBackupTargetRO(replicationTargetName)
+	if err != nil {
+		if !apierrors.IsNotFound(err) {
+			return errors.Wrapf(err, "failed to get replication target %v", replicationTargetName)
+		}
+		status.State = longhorn.BackupTargetReplicationStateError
+		status.Message = fmt.Sprintf("cannot find replication target %v", replicationTargetName)
+		return nil
+	}
+	if !replicationTarget.Status.Available {
+		status.State = longhorn.BackupTargetReplicationStateUnavailable
+		status.Message = fmt.Sprintf("replication target %v is unavailable", replicationTargetName)
+		return nil
+	}
+
+	replicationTargetClient, err := newBackupTargetClientFromDefaultEngineImage(btc.ds, replicationTarget)
+	if err != nil {
+		status.State = longhorn.BackupTargetReplicationStateError
+		status.Message = fmt.Sprintf("failed to init client for replication target %v: %v", replicationTargetName, err)
+		return nil
+	}
+
+	messages := []string{}
+
+	backupMessages, err := btc.replicateBackups(backupTarget, replicationTarget, backupTargetClient, replicationTargetClient, status, log)
+	if err != nil {
+		return err
+	}
+	messages = append(messages, backupMessages...)
+
+	backingImageMessages, err := btc.replicateBackupBackingImages(backupTarget, replicationTarget, status, log)
+	if err != nil {
+		return err
+	}
+	messages = append(messages, backingImageMessages...)
+
+	// System backups are only stored in the default backup target.
+	if backupTarget.Name == types.DefaultBackupTargetName {
+		if err := btc.replicateSystemBackups(backupTarget, replicationTarget, backupTargetClient, replicationTargetClient, status, log); err != nil {
+			status.State = longhorn.BackupTargetReplicationStateError
+			status.Message = err.Error()
+			log.WithError(err).Warn("Failed to replicate system backups")
+			return nil
+		}
+	}
+
+	if len(messages) > replicationStatusMessageLimit {
+		messages = append(messages[:replicationStatusMessageLimit], fmt.Sprintf("and %d more", len(messages)-replicationStatusMessageLimit))
+	}
+	status.Message = strings.Join(messages, "; ")
+
+	switch {
+	case status.PendingBackups > 0 || status.PendingBackingImageBackups > 0 || status.PendingSystemBackups > 0:
+		status.State = longhorn.BackupTargetReplicationStateReplicating
+	case status.FailedBackups > 0:
+		status.State = longhorn.BackupTargetReplicationStateError
+	default:
+		status.State = longhorn.BackupTargetReplicationStateSynced
+		status.LastReplicatedAt = util.Now()
+	}
+	return nil
+}
+
+// replicateBackups copies the primary backups missing on the replication target in the background. The backup
+// config and the blocks are copied from the backup store of the backup target to the one of the replication target,
+// so the copies do not depend on the volumes and the snapshots in the cluster.
+func (btc *BackupTargetController) replicateBackups(backupTarget, replicationTarget *longhorn.BackupTarget, backupTargetClient, replicationTargetClient *engineapi.BackupTargetClient, status *longhorn.BackupTargetReplicationStatus, log logrus.FieldLogger) ([]string, error) {
+	primaryBackups, err := btc.ds.ListBackupsWithBackupTargetNameRO(backupTarget.Name)
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to list backups of backup target %v", backupTarget.Name)
+	}
+	replicationBackups, err := btc.ds.ListBackupsWithBackupTargetNameRO(replicationTarget.Name)
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to list backups of backup target %v", replicationTarget.Name)
+	}
+
+	plan := planBackupReplication(primaryBackups, replicationBackups)
+	messages := plan.messages
+
+	// The copies are deleted to be recreated in the next round. The blocks already existing on the replication target
+	// are not uploaded again, so the next copy resumes instead of starting over.
+	for _, backupCopy := range plan.toDelete {
+		log.WithField("backup", backupCopy.Name).Info("Deleting failed backup copy on replication target to retry")
+		if err := btc.ds.DeleteBackup(backupCopy.Name); err != nil && !apierrors.IsNotFound(err) {
+			return nil, errors.Wrapf(err, "failed to delete backup copy %v", backupCopy.Name)
+		}
+	}
+
+	inProgress := plan.inProgress + btc.countBackupReplications(replicationTarget.Name)
+	pending := plan.inProgress
+	failed := len(plan.toDelete)
+	for _, primary := range plan.toCopy {
+		if primary.Status.URL == "" {
+			messages = append(messages, fmt.Sprintf("cannot copy backup %v since its URL is unknown", primary.Name))
+			continue
+		}
+		pending++
+
+		key := getBackupReplicationKey(replicationTarget.Name, primary.Name)
+		replicating, lastErr := btc.getBackupReplication(key)
+		if replicating {
+			continue
+		}
+		if lastErr != "" {
+			failed++
+			messages = append(messages, fmt.Sprintf("failed to copy backup %v: %v", primary.Name, lastErr))
+		}
+		if inProgress >= backupReplicationConcurrentLimit {
+			continue
+		}
+		inProgress++
+
+		btc.backupReplicationLock.Lock()
+		btc.backupReplications.Insert(key)
+		btc.backupReplicationLock.Unlock()
+
+		log.WithField("backup", primary.Name).Info("Copying backup to replication target")
+		go btc.copyBackup(backupTarget, replicationTarget, key, primary.DeepCopy(), backupTargetClient, replicationTargetClient, log)
+	}
+
+	status.ReplicatedBackups = plan.replicated
+	status.PendingBackups = pending
+	status.FailedBackups = failed
+	return messages, nil
+}
+
+func getBackupReplicationKey(replicationTargetName, backupName string) string {
+	return replicationTargetName + "/" + backupName
+}
+
+// getBackupReplication returns if the backup is being copied, and the error of the last failed copy
+func (btc *BackupTargetController) getBackupReplication(key string) (bool, string) {
+	btc.backupReplicationLock.Lock()
+	defer btc.backupReplicationLock.Unlock()
+	return btc.backupReplications.Has(key), btc.backupReplicationErrors[key]
+}
+
+func (btc *BackupTargetController) countBackupReplications(replicationTargetName string) int {
+	btc.backupReplicationLock.Lock()
+	defer btc.backupReplicationLock.Unlock()
+	count := 0
+	for key := range btc.backupReplications {
+		if strings.HasPrefix(key, replicationTargetName+"/") {
+			count++
+		}
+	}
+	return count
+}
+
+// copyBackup copies the primary backup store-to-store and requests the replication target to sync, so the copy
+// shows up in the cluster. The copy is named after the primary backup and the replication target, so a retried copy
+// overwrites the config of an interrupted one instead of leaving a duplicate behind.
+func (btc *BackupTargetController) copyBackup(backupTarget, replicationTarget *longhorn.BackupTarget, key string, primary *longhorn.Backup, backupTargetClient, replicationTargetClient *engineapi.BackupTargetClient, log logrus.FieldLogger) {
+	log = log.WithField("backup", primary.Name)
+
+	var err error
+	defer func() {
+		btc.backupReplicationLock.Lock()
+		btc.backupReplications.Delete(key)
+		if err != nil {
+			btc.backupReplicationErrors[key] = err.Error()
+		} else {
+			delete(btc.backupReplicationErrors, key)
+		}
+		btc.backupReplicationLock.Unlock()
+
+		btc.enqueueBackupTarget(backupTarget)
+	}()
+
+	err = btc.copyBackupStoreToStore(replicationTarget, primary, backupTargetClient, replicationTargetClient, log)
+	if err != nil {
+		log.WithError(err).Error("Failed to copy backup to replication target")
+		return
+	}
+	log.Info("Copied backup to replication target")
+
+	// Sync the replication target right away instead of waiting for its poll interval
+	if err := btc.requestBackupTargetSync(replicationTarget.Name); err != nil {
+		log.WithError(err).Warnf("Failed to request replication target %v to sync", replicationTarget.Name)
+	}
+}
+
+func (btc *BackupTargetController) copyBackupStoreToStore(replicationTarget *longhorn.BackupTarget, primary *longhorn.Backup, backupTargetClient, replicationTargetClient *engineapi.BackupTargetClient, log logrus.FieldLogger) error {
+	backupName, volumeName, _, err := backupstore.DecodeBackupURL(primary.Status.URL)
+	if err != nil {
+		return errors.Wrapf(err, "failed to decode backup URL %v", primary.Status.URL)
+	}
+
+	src, err := engineapi.NewBackupStoreDriver(backupTargetClient.URL, backupTargetClient.Credential)
+	if err != nil {
+		return err
+	}
+	dst, err := engineapi.NewBackupStoreDriver(replicationTargetClient.URL, replicationTargetClient.Credential)
+	if err != nil {
+		return err
+	}
+
+	// The retention lock of the copy follows the replication target instead of the primary backup target.
+	labels := map[string]string{}
+	for k, v := range primary.Status.Labels {
+		if k == types.GetLonghornLabelKey(types.LonghornLabelBackupRetentionLockedUntil) {
+			continue
+		}
+		labels[k] = v
+	}
+	origin := datastore.GetReplicatedBackupOrigin(primary)
+	labels[types.GetLonghornLabelKey(types.LonghornLabelReplicatedFromBackup)] = origin
+
+	copyName := "backup-" + util.GetStringChecksum(replicationTarget.Name + "/" + origin)[:16]
+	return engineapi.CopyBackup(src, dst, volumeName, backupName, copyName, labels, log)
+}
+
+func (btc *BackupTargetController) requestBackupTargetSync(backupTargetName string) error {
+	backupTarget, err := btc.ds.GetBackupTarget(backupTargetName)
+	if err != nil {
+		return err
+	}
+	backupTarget.Spec.SyncRequestedAt = metav1.Time{Time: time.Now().UTC()}
+	_, err = btc.ds.UpdateBackupTarget(backupTarget)
+	return err
+}
+
+// replicateBackupBackingImages creates the backing image backups on the replication target and verifies their
+// checksums match the primary backing image backups.
+func (btc *BackupTargetController) replicateBackupBackingImages(backupTarget, replicationTarget *longhorn.BackupTarget, status *longhorn.BackupTargetReplicationStatus, log logrus.FieldLogger) ([]string, error) {
+	primaryBBIs, err := btc.ds.ListBackupBackingImagesWithBackupTargetNameRO(backupTarget.Name)
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to list backup backing images of backup target %v", backupTarget.Name)
+	}
+
+	messages := []string{}
+	replicated, pending := 0, 0
+	for _, primary := range primaryBBIs {
+		if primary.Status.State != longhorn.BackupStateCompleted {
+			continue
+		}
+		biName := primary.Spec.BackingImage
+
+		backupCopy, err := btc.ds.GetBackupBackingImagesWithBackupTargetNameRO(replicationTarget.Name, biName)
+		if err != nil && !apierrors.IsNotFound(err) {
+			return nil, errors.Wrapf(err, "failed to get backup backing image of %v on replication target", biName)
+		}
+
+		if backupCopy == nil {
+			if _, err := btc.ds.GetBackingImageRO(biName); err != nil {
+				if !apierrors.IsNotFound(err) {
+					return nil, errors.Wrapf(err, "failed to get backing image %v", biName)
+				}
+				messages = append(messages, fmt.Sprintf("cannot copy backup backing image %v since backing image %v does not exist in the cluster", primary.Name, biName))
+				continue
+			}
+			pending++
+			log.WithField("backingImage", biName).Info("Copying backup backing image to replication target")
+			backupBackingImage := &longhorn.BackupBackingImage{
+				ObjectMeta: metav1.ObjectMeta{
+					Name: types.GetBackupBackingImageNameFromBIName(biName),
+					Labels: map[string]string{
+						types.LonghornLabelBackupTarget: replicationTarget.Name,
+						types.LonghornLabelBackingImage: biName,
+					},
+				},
+				Spec: longhorn.BackupBackingImageSpec{
+					UserCreated:      true,
+					BackingImage:     biName,
+					BackupTargetName: replicationTarget.Name,
+					Labels:           primary.Status.Labels,
+				},
+			}
+			if _, err := btc.ds.CreateBackupBackingImage(backupBackingImage); err != nil && !apierrors.IsAlreadyExists(err) {
+				return nil, errors.Wrapf(err, "failed to create copy of backup backing image %v on replication target", biName)
+			}
+			continue
+		}
+
+		switch backupCopy.Status.State {
+		case longhorn.BackupStateCompleted:
+			if backupCopy.Status.Checksum == primary.Status.Checksum {
+				replicated++
+				continue
+			}
+			messages = append(messages, fmt.Sprintf("backup backing image %v copy has checksum %v instead of %v", biName, backupCopy.Status.Checksum, primary.Status.Checksum))
+		case longhorn.BackupStateError, longhorn.BackupStateUnknown:
+			messages = append(messages, fmt.Sprintf("failed to copy backup backing image %v: %v", biName, backupCopy.Status.Error))
+		default:
+			pending++
+			continue
+		}
+		pending++
+		log.WithField("backupBackingImage", backupCopy.Name).Info("Deleting failed backup backing image copy on replication target to retry")
+		if err := btc.ds.DeleteBackupBackingImage(backupCopy.Name); err != nil && !apierrors.IsNotFound(err) {
+			return nil, errors.Wrapf(err, "failed to delete backup backing image copy %v", backupCopy.Name)
+		}
+	}
+
+	status.ReplicatedBackingImageBackups = replicated
+	status.PendingBackingImageBackups = pending
+	return messages, nil
+}
+
+// replicateSystemBackups copies the system backups missing on the replication target in the background. A copy is
+// downloaded from the backup target, uploaded to the replication target and then verified by the checksums.
+// A copy whose checksum mismatches, for example after an interrupted upload, is deleted and copied again.
+func (btc *BackupTargetController) replicateSystemBackups(backupTarget, replicationTarget *longhorn.BackupTarget, backupTargetClient, replicationTargetClient *engineapi.BackupTargetClient, status *longhorn.BackupTargetReplicationStatus, log logrus.FieldLogger) error {
+	systemBackups, err := backupTargetClient.ListSystemBackup()
+	if err != nil {
+		return errors.Wrapf(err, "failed to list system backups in %v", backupTargetClient.URL)
+	}
+	replicationSystemBackups, err := replicationTargetClient.ListSystemBackup()
+	if err != nil {
+		return errors.Wrapf(err, "failed to list system backups in %v", replicationTargetClient.URL)
+	}
+
+	replicated, pending := 0, 0
+	for _, name := range util.GetSortedKeysFromMap(systemBackups) {
+		version, _, err := parseSystemBackupURI(string(systemBackups[systembackupstore.Name(name)]))
+		if err != nil {
+			return errors.Wrapf(err, "failed to parse system backup URI of %v", name)
+		}
+
+		key := replicationTarget.Name + "/" + name
+		if btc.isSystemBackupReplicating(key) {
+			pending++
+			continue
+		}
+
+		if _, exists := replicationSystemBackups[systembackupstore.Name(name)]; exists {
+			verified, err := verifyReplicatedSystemBackup(name, version, backupTargetClient, replicationTargetClient)
+			if err != nil {
+				return err
+			}
+			if verified {
+				replicated++
+				continue
+			}
+			log.WithField("systemBackup", name).Warn("Deleting system backup copy mismatching the checksum on replication target to retry")
+			if err := deleteReplicatedSystemBackup(name, version, replicationTargetClient); err != nil {
+				return err
+			}
+		}
+
+		pending++
+		btc.systemBackupReplicationLock.Lock()
+		btc.systemBackupReplications.Insert(key)
+		btc.systemBackupReplicationLock.Unlock()
+
+		log.WithField("systemBackup", name).Info("Copying system backup to replication target")
+		go btc.copySystemBackup(backupTarget, key, name, version, backupTargetClient, replicationTargetClient, log)
+	}
+
+	status.ReplicatedSystemBackups = replicated
+	status.PendingSystemBackups = pending
+	return nil
+}
+
+func verifyReplicatedSystemBackup(name, version string, backupTargetClient, replicationTargetClient *engineapi.BackupTargetClient) (bool, error) {
+	cfg, err := backupTargetClient.GetSystemBackupConfig(name, version)
+	if err != nil {
+		return false, err
+	}
+	replicationCfg, err := replicationTargetClient.GetSystemBackupConfig(name, version)
+	if err != nil {
+		return false, err
+	}
+	return replicationCfg.Checksum == cfg.Checksum, nil
+}
+
+func deleteReplicatedSystemBackup(name, version string, replicationTargetClient *engineapi.BackupTargetClient) error {
+	systemBackup := &longhorn.SystemBackup{
+		ObjectMeta: metav1.ObjectMeta{Name: name},
+		Status:     longhorn.SystemBackupStatus{Version: version},
+	}
+	_, err := replicationTargetClient.DeleteSystemBackup(systemBackup)
+	return err
+}
+
+func (btc *BackupTargetController) isSystemBackupReplicating(key string) bool {
+	btc.systemBackupReplicationLock.Lock()
+	defer btc.systemBackupReplicationLock.Unlock()
+	return btc.systemBackupReplications.Has(key)
+}
+
+func (btc *BackupTargetController) copySystemBackup(backupTarget *longhorn.BackupTarget, key, name, version string, backupTargetClient, replicationTargetClient *engineapi.BackupTargetClient, log logrus.FieldLogger) {
+	log = log.WithField("systemBackup", name)
+
+	defer func() {
+		btc.systemBackupReplicationLock.Lock()
+		btc.systemBackupReplications.Delete(key)
+		btc.systemBackupReplicationLock.Unlock()
+
+		btc.enqueueBackupTarget(backupTarget)
+	}()
+
+	tempDir, err := os.MkdirTemp(SystemBackupTempDir, fmt.Sprintf("*-%v", name))
+	if err != nil {
+		log.WithError(err).Error("Failed to create temporary directory for system backup replication")
+		return
+	}
+	defer func() {
+		if err := os.RemoveAll(tempDir); err != nil {
+			log.WithError(err).Warnf("Failed to remove temporary directory %v", tempDir)
+		}
+	}()
+
+	cfg, err := backupTargetClient.GetSystemBackupConfig(name, version)
+	if err != nil {
+		log.WithError(err).Error("Failed to get system backup config")
+		return
+	}
+
+	// The download verifies the checksum of the archive against the system backup config.
+	archivePath := filepath.Join(tempDir, name+types.SystemBackupExtension)
+	if err := backupTargetClient.DownloadSystemBackup(name, version, archivePath); err != nil {
+		log.WithError(err).Error("Failed to download system backup")
+		return
+	}
+
+	if _, err := replicationTargetClient.UploadSystemBackup(name, archivePath, version, cfg.LonghornGitCommit, cfg.ManagerImage, cfg.EngineImage); err != nil {
+		log.WithError(err).Error("Failed to upload system backup to replication target")
+		return
+	}
+
+	verified, err := verifyReplicatedSystemBackup(name, version, backupTargetClient, replicationTargetClient)
+	if err != nil {
+		log.WithError(err).Error("Failed to verify system backup copy on replication target")
+		return
+	}
+	if verified {
+		log.Info("Copied system backup to replication target")
+		return
+	}
+
+	log.Error("System backup copy mismatches the checksum, deleting it to retry")
+	if err := deleteReplicatedSystemBackup(name, version, replicationTargetClient); err != nil {
+		log.WithError(err).Error("Failed to delete system backup copy on replication target")
+	}
+}
@@ -0,0 +1,66 @@
+package controller
+
+import (
+	"github.com/longhorn/longhorn-manager/types"
+
+	longhorn "github.com/longhorn/longhorn-manager/k8s/pkg/apis/longhorn/v1beta2"
+
+	. "gopkg.in/check.v1"
+)
+
+func newReplicationTestBackup(name, replicatedFrom string, state longhorn.BackupState, size string) *longhorn.Backup {
+	backup := &longhorn.Backup{
+		Status: longhorn.BackupStatus{
+			State:           state,
+			SnapshotName:    "snap-" + name,
+			Size:            size,
+			VolumeSize:      "1073741824",
+			BackupCreatedAt: "2024-05-01T10:00:00Z",
+		},
+	}
+	backup.Name = name
+	if replicatedFrom != "" {
+		backup.Name = name + "-copy"
+		backup.Spec.Labels = map[string]string{
+			types.GetLonghornLabelKey(types.LonghornLabelReplicatedFromBackup): replicatedFrom,
+		}
+	}
+	return backup
+}
+
+func (s *TestSuite) TestPlanBackupReplication(c *C) {
+	primaryBackups := map[string]*longhorn.Backup{
+		"backup-1": newReplicationTestBackup("backup-1", "", longhorn.BackupStateCompleted, "4194304"),
+		"backup-2": newReplicationTestBackup("backup-2", "", longhorn.BackupStateCompleted, "4194304"),
+		"backup-3": newReplicationTestBackup("backup-3", "", longhorn.BackupStateCompleted, "4194304"),
+		"backup-4": newReplicationTestBackup("backup-4", "", longhorn.BackupStateCompleted, "4194304"),
+		"backup-5": newReplicationTestBackup("backup-5", "", longhorn.BackupStateCompleted, "4194304"),
+		"backup-6": newReplicationTestBackup("backup-6", "", longhorn.BackupStateInProgress, ""),
+	}
+	replicationBackups := map[string]*longhorn.Backup{
+		// Copy verified by its block checksums when it was copied
+		"backup-1-copy": newReplicationTestBackup("backup-1", "backup-1", longhorn.BackupStateCompleted, "4194304"),
+		// Copy in progress
+		"backup-2-copy": newReplicationTestBackup("backup-2", "backup-2", longhorn.BackupStateInProgress, ""),
+		// Failed copy
+		"backup-3-copy": newReplicationTestBackup("backup-3", "backup-3", longhorn.BackupStateError, ""),
+		// Completed copy reporting a different size, which is not compared since the blocks were verified on copy
+		"backup-4-copy": newReplicationTestBackup("backup-4", "backup-4", longhorn.BackupStateCompleted, "2097152"),
+	}
+
+	plan := planBackupReplication(primaryBackups, replicationBackups)
+	c.Assert(plan.replicated, Equals, 2)
+	c.Assert(plan.inProgress, Equals, 1)
+	c.Assert(plan.toCopy, HasLen, 1)
+	c.Assert(plan.toCopy[0].Name, Equals, "backup-5")
+	c.Assert(plan.toDelete, HasLen, 1)
+	c.Assert(plan.toDelete[0].Name, Equals, "backup-3-copy")
+	c.Assert(plan.messages, HasLen, 1)
+
+	// A backup replicated from the replication target is not copied back
+	replicationBackups["backup-7"] = newReplicationTestBackup("backup-7", "", longhorn.BackupStateCompleted, "4194304")
+	primaryBackups["backup-7-copy"] = newReplicationTestBackup("backup-7", "backup-7", longhorn.BackupStateCompleted, "4194304")
+	plan = planBackupReplication(primaryBackups, replicationBackups)
+	c.Assert(plan.replicated, Equals, 3)
+	c.Assert(plan.toCopy, HasLen, 1)
+}
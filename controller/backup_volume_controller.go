@@ -232,6 +232,15 @@ func (bvc *BackupVolumeController) reconcile(backupVolumeName string) (err error
 		}
 		// Delete the backup volume from the remote backup target
 		if needsCleanupRemoteData {
+			lockedUntil, err := bvc.getBackupVolumeRetentionLockedUntil(backupVolume)
+			if err != nil {
+				return err
+			}
+			if time.Now().Before(lockedUntil) {
+				log.Warnf("Waiting for the retention lock of the backups to expire at %v before deleting the backup volume from the backup target", util.FormatTimeZ(lockedUntil))
+				return enqueueAfterDelay(bvc.queue, backupVolume, time.Until(lockedUntil))
+			}
+
 			// The request can be executed by any instance manager regardless of data engine.
 			engineClientProxy, backupTargetClient, err := getBackupTarget(bvc.controllerID, backupTarget, bvc.ds, log, bvc.proxyConnCounter, longhorn.DataEngineTypeAll)
 			if err != nil || engineClientProxy == nil {
@@ -381,6 +390,18 @@ func (bvc *BackupVolumeController) reconcile(backupVolumeName string) (err error
 		log.Infof("Found %d backups in the backup target that do not exist in the cluster and need to be deleted from the cluster", count)
 	}
 	for backupName := range backupsToDelete {
+		backup, err := bvc.ds.GetBackupRO(backupName)
+		if err != nil {
+			if apierrors.IsNotFound(err) {
+				continue
+			}
+			return errors.Wrapf(err, "failed to get backup %s", backupName)
+		}
+		// The retention lock keeps the backup in the cluster until it expires
+		if datastore.IsBackupRetentionLocked(backup) {
+			log.Warnf("Skipped deleting backup %s from cluster since it is retention locked until %v", backupName, util.FormatTimeZ(backup.Status.RetentionLockedUntil.Time))
+			continue
+		}
 		if err = datastore.AddBackupDeleteCustomResourceOnlyLabel(bvc.ds, backupName); err != nil {
 			return errors.Wrapf(err, "failed to add label delete-custom-resource-only to backup %s", backupName)
 		}
@@ -484,3 +505,19 @@ func (bvc *BackupVolumeController) isResponsibleFor(bv *longhorn.BackupVolume, d
 
 	return isPreferredOwner || continueToBeOwner || requiresNewOwner, nil
 }
+
+// getBackupVolumeRetentionLockedUntil returns the latest retention lock time of the backups in the backup volume
+func (bvc *BackupVolumeController) getBackupVolumeRetentionLockedUntil(backupVolume *longhorn.BackupVolume) (time.Time, error) {
+	backups, err := bvc.ds.ListBackupsWithBackupVolumeName(backupVolume.Spec.BackupTargetName, backupVolume.Spec.VolumeName)
+	if err != nil {
+		return time.Time{}, errors.Wrapf(err, "failed to list backups of backup volume %v", backupVolume.Name)
+	}
+
+	lockedUntil := time.Time{}
+	for _, backup := range backups {
+		if backup.Status.RetentionLockedUntil.After(lockedUntil) {
+			lockedUntil = backup.Status.RetentionLockedUntil.Time
+		}
+	}
+	return lockedUntil, nil
+}
@@ -112,7 +112,7 @@ func StartControllers(logger logrus.FieldLogger, clients *client.Clients,
 	if err != nil {
 		return nil, err
 	}
-	snapshotController, err := NewSnapshotController(logger, ds, scheme, kubeClient, namespace, controllerID, &engineapi.EngineCollection{}, proxyConnCounter, snapshotConcurrentLimiter)
+	snapshotController, err := NewSnapshotController(logger, ds, scheme, kubeClient, namespace, controllerID, &engineapi.EngineCollection{}, proxyConnCounter, snapshotConcurrentLimiter, util.NewPodExecutor(clients.RESTConfig, kubeClient))
 	if err != nil {
 		return nil, err
 	}
@@ -132,6 +132,14 @@ func StartControllers(logger logrus.FieldLogger, clients *client.Clients,
 	if err != nil {
 		return nil, err
 	}
+	volumeGroupSnapshotController, err := NewVolumeGroupSnapshotController(logger, ds, scheme, kubeClient, namespace, controllerID, util.NewPodExecutor(clients.RESTConfig, kubeClient))
+	if err != nil {
+		return nil, err
+	}
+	drGroupController, err := NewDRGroupController(logger, ds, scheme, kubeClient, namespace, controllerID)
+	if err != nil {
+		return nil, err
+	}
 	volumeRestoreController, err := NewVolumeRestoreController(logger, ds, scheme, kubeClient, controllerID, namespace)
 	if err != nil {
 		return nil, err
@@ -203,6 +211,8 @@ func StartControllers(logger logrus.FieldLogger, clients *client.Clients,
 	go recurringJobController.Run(Workers, stopCh)
 	go orphanController.Run(Workers, stopCh)
 	go snapshotController.Run(Workers, stopCh)
+	go volumeGroupSnapshotController.Run(Workers, stopCh)
+	go drGroupController.Run(Workers, stopCh)
 	go supportBundleController.Run(Workers, stopCh)
 	go systemBackupController.Run(Workers, stopCh)
 	go systemRestoreController.Run(Workers, stopCh)
@@ -0,0 +1,244 @@
+package controller
+
+import (
+	"fmt"
+	"sync"
+	"time"
+
+	corev1 "k8s.io/api/core/v1"
+
+	"github.com/longhorn/longhorn-manager/controller/monitor"
+	"github.com/longhorn/longhorn-manager/datastore"
+	"github.com/longhorn/longhorn-manager/types"
+
+	longhorn "github.com/longhorn/longhorn-manager/k8s/pkg/apis/longhorn/v1beta2"
+)
+
+const (
+	// diskUsageSampleInterval is the minimal interval between two samples in the disk usage history
+	diskUsageSampleInterval = 5 * time.Minute
+	// diskUsageHistoryWindow is how long the disk usage samples are kept for the forecast
+	diskUsageHistoryWindow = 24 * time.Hour
+	// diskUsageForecastMinimumWindow is the minimal time span of the samples before forecasting,
+	// so a short burst of writes right after the manager starts is not projected
+	diskUsageForecastMinimumWindow = time.Hour
+)
+
+type diskUsageSample struct {
+	timestamp         time.Time
+	storageUsage      int64
+	replicaActualSize int64
+}
+
+// diskUsageHistory keeps the rolling usage samples of the disks on the node in memory, keyed by the disk UUID.
+// The history starts over when the longhorn-manager restarts.
+type diskUsageHistory struct {
+	lock    sync.Mutex
+	samples map[string][]diskUsageSample
+}
+
+func newDiskUsageHistory() *diskUsageHistory {
+	return &diskUsageHistory{
+		samples: map[string][]diskUsageSample{},
+	}
+}
+
+// record appends the sample if the latest sample of the disk is older than the sample interval, drops the samples
+// out of the history window, and returns a copy of the samples of the disk
+func (h *diskUsageHistory) record(diskUUID string, sample diskUsageSample) []diskUsageSample {
+	h.lock.Lock()
+	defer h.lock.Unlock()
+
+	samples := h.samples[diskUUID]
+	if len(samples) == 0 || sample.timestamp.Sub(samples[len(samples)-1].timestamp) >= diskUsageSampleInterval {
+		samples = append(samples, sample)
+	}
+
+	expired := 0
+	for expired < len(samples) && sample.timestamp.Sub(samples[expired].timestamp) > diskUsageHistoryWindow {
+		expired++
+	}
+	samples = samples[expired:]
+	h.samples[diskUUID] = samples
+
+	return append([]diskUsageSample{}, samples...)
+}
+
+// prune removes the history of the disks that are no longer on the node
+func (h *diskUsageHistory) prune(diskUUIDs map[string]struct{}) {
+	h.lock.Lock()
+	defer h.lock.Unlock()
+
+	for diskUUID := range h.samples {
+		if _, ok := diskUUIDs[diskUUID]; !ok {
+			delete(h.samples, diskUUID)
+		}
+	}
+}
+
+// getDiskUsageGrowthRates returns the growth rates of the storage usage and the replica actual size in bytes per hour
+// by the least squares fit of the samples. It returns false if the samples do not span the minimal forecast window.
+func getDiskUsageGrowthRates(samples []diskUsageSample) (usageGrowthRate, replicaActualSizeGrowthRate float64, ok bool) {
+	if len(samples) < 2 || samples[len(samples)-1].timestamp.Sub(samples[0].timestamp) < diskUsageForecastMinimumWindow {
+		return 0, 0, false
+	}
+
+	hours := make([]float64, len(samples))
+	usages := make([]float64, len(samples))
+	replicaActualSizes := make([]float64, len(samples))
+	for i, sample := range samples {
+		hours[i] = sample.timestamp.Sub(samples[0].timestamp).Hours()
+		usages[i] = float64(sample.storageUsage)
+		replicaActualSizes[i] = float64(sample.replicaActualSize)
+	}
+
+	return getLinearGrowthRate(hours, usages), getLinearGrowthRate(hours, replicaActualSizes), true
+}
+
+func getLinearGrowthRate(xs, ys []float64) float64 {
+	n := float64(len(xs))
+	meanX, meanY := 0.0, 0.0
+	for i := range xs {
+		meanX += xs[i]
+		meanY += ys[i]
+	}
+	meanX /= n
+	meanY /= n
+
+	covariance, variance := 0.0, 0.0
+	for i := range xs {
+		covariance += (xs[i] - meanX) * (ys[i] - meanY)
+		variance += (xs[i] - meanX) * (xs[i] - meanX)
+	}
+	if variance == 0 {
+		return 0
+	}
+	return covariance / variance
+}
+
+// getDiskProjectedFullTime returns the time the available storage drops below the minimal available percentage
+// if the usage keeps growing at the rate since the sample. It returns false if the usage is not growing.
+func getDiskProjectedFullTime(sample diskUsageSample, storageMaximum, minimalAvailablePercentage int64, usageGrowthRate float64) (time.Time, bool) {
+	if usageGrowthRate <= 0 || storageMaximum <= 0 {
+		return time.Time{}, false
+	}
+
+	minimalAvailable := storageMaximum * minimalAvailablePercentage / 100
+	available := storageMaximum - sample.storageUsage
+	if available <= minimalAvailable {
+		return sample.timestamp, true
+	}
+
+	hoursToFull := float64(available-minimalAvailable) / usageGrowthRate
+	return sample.timestamp.Add(time.Duration(hoursToFull * float64(time.Hour))), true
+}
+
+// syncDiskUsageForecast records the usage of the ready disks on the node, and updates the growth rates,
+// the projected full time and the FillingUp condition in the disk status.
+func (nc *NodeController) syncDiskUsageForecast(node *longhorn.Node, collectedDiskInfo map[string]*monitor.CollectedDiskInfo) error {
+	minimalAvailablePercentage, err := nc.ds.GetSettingAsInt(types.SettingNameStorageMinimalAvailablePercentage)
+	if err != nil {
+		return err
+	}
+	warningThreshold, err := nc.ds.GetSettingAsInt(types.SettingNameDiskTimeToFullWarningThreshold)
+	if err != nil {
+		return err
+	}
+
+	now := time.Now().UTC()
+	diskUUIDs := map[string]struct{}{}
+	for diskName, diskStatus := range node.Status.DiskStatus {
+		if diskStatus == nil {
+			continue
+		}
+
+		if diskStatus.DiskUUID == "" || diskStatus.StorageMaximum == 0 ||
+			types.GetCondition(diskStatus.Conditions, longhorn.DiskConditionTypeReady).Status != longhorn.ConditionStatusTrue {
+			diskStatus.StorageUsageGrowthRate = 0
+			diskStatus.ReplicaActualSizeGrowthRate = 0
+			diskStatus.ProjectedFullAt = ""
+			nc.clearDiskFillingUpCondition(node, diskName, diskStatus)
+			continue
+		}
+		diskUUIDs[diskStatus.DiskUUID] = struct{}{}
+
+		replicaActualSize, err := nc.getReplicaActualSizeOnDisk(diskStatus, collectedDiskInfo[diskName])
+		if err != nil {
+			return err
+		}
+
+		samples := nc.diskUsageHistory.record(diskStatus.DiskUUID, diskUsageSample{
+			timestamp:         now,
+			storageUsage:      diskStatus.StorageMaximum - diskStatus.StorageAvailable,
+			replicaActualSize: replicaActualSize,
+		})
+		// The status is derived from the samples only, so it does not change between two samples
+		latestSample := samples[len(samples)-1]
+		diskStatus.ReplicaActualSize = latestSample.replicaActualSize
+
+		usageGrowthRate, replicaActualSizeGrowthRate, ok := getDiskUsageGrowthRates(samples)
+		diskStatus.StorageUsageGrowthRate = int64(usageGrowthRate)
+		diskStatus.ReplicaActualSizeGrowthRate = int64(replicaActualSizeGrowthRate)
+		diskStatus.ProjectedFullAt = ""
+
+		projectedFullAt, projected := time.Time{}, false
+		if ok {
+			projectedFullAt, projected = getDiskProjectedFullTime(latestSample, diskStatus.StorageMaximum, minimalAvailablePercentage, usageGrowthRate)
+		}
+		if projected {
+			diskStatus.ProjectedFullAt = projectedFullAt.Format(time.RFC3339)
+		}
+
+		if projected && warningThreshold > 0 && projectedFullAt.Before(latestSample.timestamp.Add(time.Duration(warningThreshold)*time.Hour)) {
+			diskStatus.Conditions = types.SetConditionAndRecord(diskStatus.Conditions,
+				longhorn.DiskConditionTypeFillingUp, longhorn.ConditionStatusTrue,
+				string(longhorn.DiskConditionReasonProjectedFull),
+				fmt.Sprintf("Disk %v (%v) on the node %v is projected to drop below %v%% available storage at %v, the used storage grows %v bytes per hour",
+					diskName, node.Spec.Disks[diskName].Path, node.Name, minimalAvailablePercentage, diskStatus.ProjectedFullAt, diskStatus.StorageUsageGrowthRate),
+				nc.eventRecorder, node, corev1.EventTypeWarning)
+		} else {
+			nc.clearDiskFillingUpCondition(node, diskName, diskStatus)
+		}
+	}
+	nc.diskUsageHistory.prune(diskUUIDs)
+
+	return nil
+}
+
+// clearDiskFillingUpCondition only flips an existing FillingUp condition, so the disks that never fill up
+// do not carry the condition
+func (nc *NodeController) clearDiskFillingUpCondition(node *longhorn.Node, diskName string, diskStatus *longhorn.DiskStatus) {
+	if types.GetCondition(diskStatus.Conditions, longhorn.DiskConditionTypeFillingUp).Status != longhorn.ConditionStatusTrue {
+		return
+	}
+	diskStatus.Conditions = types.SetConditionAndRecord(diskStatus.Conditions,
+		longhorn.DiskConditionTypeFillingUp, longhorn.ConditionStatusFalse, "",
+		fmt.Sprintf("Disk %v on the node %v is no longer projected to fill up", diskName, node.Name),
+		nc.eventRecorder, node, corev1.EventTypeNormal)
+}
+
+// getReplicaActualSizeOnDisk sums up the space allocated on the disk by the replicas scheduled to it. If the disk
+// monitor failed to collect the replica sizes, the last reported size is kept.
+func (nc *NodeController) getReplicaActualSizeOnDisk(diskStatus *longhorn.DiskStatus, diskInfo *monitor.CollectedDiskInfo) (int64, error) {
+	if diskInfo == nil || diskInfo.ReplicaActualSizes == nil {
+		return diskStatus.ReplicaActualSize, nil
+	}
+
+	actualSize := int64(0)
+	for replicaName := range diskStatus.ScheduledReplica {
+		replica, err := nc.ds.GetReplicaRO(replicaName)
+		if err != nil {
+			if datastore.ErrorIsNotFound(err) {
+				continue
+			}
+			return 0, err
+		}
+		// The data store of a v1 replica is its directory, and the one of a v2 replica is the instance named after it
+		dataStore := replica.Spec.DataDirectoryName
+		if diskStatus.Type == longhorn.DiskTypeBlock {
+			dataStore = replica.Name
+		}
+		actualSize += diskInfo.ReplicaActualSizes[dataStore]
+	}
+	return actualSize, nil
+}
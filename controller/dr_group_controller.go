@@ -0,0 +1,1081 @@
+package controller
+
+import (
+	"fmt"
+	"reflect"
+	"time"
+
+	"github.com/cockroachdb/errors"
+	"github.com/sirupsen/logrus"
+
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/util/wait"
+	"k8s.io/client-go/tools/cache"
+	"k8s.io/client-go/tools/record"
+	"k8s.io/kubernetes/pkg/controller"
+
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	utilruntime "k8s.io/apimachinery/pkg/util/runtime"
+	clientset "k8s.io/client-go/kubernetes"
+	v1core "k8s.io/client-go/kubernetes/typed/core/v1"
+
+	"github.com/longhorn/longhorn-manager/constant"
+	"github.com/longhorn/longhorn-manager/datastore"
+	"github.com/longhorn/longhorn-manager/types"
+	"github.com/longhorn/longhorn-manager/util"
+
+	longhorn "github.com/longhorn/longhorn-manager/k8s/pkg/apis/longhorn/v1beta2"
+)
+
+const (
+	// drGroupPollInterval is the interval to check the member volumes of a DR group that is syncing,
+	// failing over or failing back
+	drGroupPollInterval = 30 * time.Second
+)
+
+type DRGroupController struct {
+	*baseController
+
+	// which namespace controller is running with
+	namespace string
+	// use as the OwnerID of the controller
+	controllerID string
+
+	kubeClient    clientset.Interface
+	eventRecorder record.EventRecorder
+
+	ds *datastore.DataStore
+
+	cacheSyncs []cache.InformerSynced
+}
+
+func NewDRGroupController(
+	logger logrus.FieldLogger,
+	ds *datastore.DataStore,
+	scheme *runtime.Scheme,
+	kubeClient clientset.Interface,
+	namespace string,
+	controllerID string,
+) (*DRGroupController, error) {
+	eventBroadcaster := record.NewBroadcaster()
+	eventBroadcaster.StartLogging(logrus.Infof)
+	// TODO: remove the wrapper when every clients have moved to use the clientset.
+	eventBroadcaster.StartRecordingToSink(&v1core.EventSinkImpl{
+		Interface: v1core.New(kubeClient.CoreV1().RESTClient()).Events(""),
+	})
+
+	c := &DRGroupController{
+		baseController: newBaseController("longhorn-dr-group", logger),
+
+		namespace:    namespace,
+		controllerID: controllerID,
+
+		ds: ds,
+
+		kubeClient:    kubeClient,
+		eventRecorder: eventBroadcaster.NewRecorder(scheme, corev1.EventSource{Component: "longhorn-dr-group-controller"}),
+	}
+
+	var err error
+	if _, err = ds.DRGroupInformer.AddEventHandler(cache.ResourceEventHandlerFuncs{
+		AddFunc:    c.enqueueDRGroup,
+		UpdateFunc: func(old, cur interface{}) { c.enqueueDRGroup(cur) },
+		DeleteFunc: c.enqueueDRGroup,
+	}); err != nil {
+		return nil, err
+	}
+	c.cacheSyncs = append(c.cacheSyncs, ds.DRGroupInformer.HasSynced)
+
+	if _, err = ds.VolumeInformer.AddEventHandlerWithResyncPeriod(cache.ResourceEventHandlerFuncs{
+		AddFunc:    c.enqueueForVolume,
+		UpdateFunc: func(old, cur interface{}) { c.enqueueForVolume(cur) },
+		DeleteFunc: c.enqueueForVolume,
+	}, 0); err != nil {
+		return nil, err
+	}
+	c.cacheSyncs = append(c.cacheSyncs, ds.VolumeInformer.HasSynced)
+
+	if _, err = ds.BackupVolumeInformer.AddEventHandlerWithResyncPeriod(cache.ResourceEventHandlerFuncs{
+		AddFunc:    c.enqueueForBackupVolume,
+		UpdateFunc: func(old, cur interface{}) { c.enqueueForBackupVolume(cur) },
+		DeleteFunc: c.enqueueForBackupVolume,
+	}, 0); err != nil {
+		return nil, err
+	}
+	c.cacheSyncs = append(c.cacheSyncs, ds.BackupVolumeInformer.HasSynced)
+
+	return c, nil
+}
+
+func (c *DRGroupController) enqueueDRGroup(obj interface{}) {
+	key, err := controller.KeyFunc(obj)
+	if err != nil {
+		utilruntime.HandleError(fmt.Errorf("failed to get key for object %#v: %v", obj, err))
+		return
+	}
+
+	c.queue.Add(key)
+}
+
+func (c *DRGroupController) enqueueForVolume(obj interface{}) {
+	volume, ok := obj.(*longhorn.Volume)
+	if !ok {
+		deletedState, ok := obj.(cache.DeletedFinalStateUnknown)
+		if !ok {
+			utilruntime.HandleError(fmt.Errorf("received unexpected obj: %#v", obj))
+			return
+		}
+		// use the last known state, to enqueue, dependent objects
+		volume, ok = deletedState.Obj.(*longhorn.Volume)
+		if !ok {
+			utilruntime.HandleError(fmt.Errorf("DeletedFinalStateUnknown contained invalid object: %#v", deletedState.Obj))
+			return
+		}
+	}
+
+	if drGroupName := volume.Labels[types.GetLonghornLabelKey(types.LonghornLabelDRGroup)]; drGroupName != "" {
+		c.queue.Add(volume.Namespace + "/" + drGroupName)
+		return
+	}
+
+	// The volume may be selected by a primary DR group but not labelled yet
+	drGroups, err := c.ds.ListDRGroupsRO()
+	if err != nil {
+		utilruntime.HandleError(fmt.Errorf("failed to list DR groups for volume %v: %v", volume.Name, err))
+		return
+	}
+	for _, drGroup := range drGroups {
+		if drGroup.Spec.Role == longhorn.DRGroupRolePrimary && isVolumeSelectedByDRGroup(drGroup, volume) {
+			c.enqueueDRGroup(drGroup)
+		}
+	}
+}
+
+func (c *DRGroupController) enqueueForBackupVolume(obj interface{}) {
+	backupVolume, ok := obj.(*longhorn.BackupVolume)
+	if !ok {
+		deletedState, ok := obj.(cache.DeletedFinalStateUnknown)
+		if !ok {
+			utilruntime.HandleError(fmt.Errorf("received unexpected obj: %#v", obj))
+			return
+		}
+		// use the last known state, to enqueue, dependent objects
+		backupVolume, ok = deletedState.Obj.(*longhorn.BackupVolume)
+		if !ok {
+			utilruntime.HandleError(fmt.Errorf("DeletedFinalStateUnknown contained invalid object: %#v", deletedState.Obj))
+			return
+		}
+	}
+
+	drGroupName := backupVolume.Status.Labels[types.GetLonghornLabelKey(types.LonghornLabelDRGroup)]
+	if drGroupName == "" {
+		return
+	}
+	c.queue.Add(backupVolume.Namespace + "/" + drGroupName)
+}
+
+func (c *DRGroupController) Run(workers int, stopCh <-chan struct{}) {
+	defer utilruntime.HandleCrash()
+	defer c.queue.ShutDown()
+
+	c.logger.Info("Starting Longhorn DRGroup controller")
+	defer c.logger.Info("Shut down Longhorn DRGroup controller")
+
+	if !cache.WaitForNamedCacheSync(c.name, stopCh, c.cacheSyncs...) {
+		return
+	}
+	for i := 0; i < workers; i++ {
+		go wait.Until(c.worker, time.Second, stopCh)
+	}
+	<-stopCh
+}
+
+func (c *DRGroupController) worker() {
+	for c.processNextWorkItem() {
+	}
+}
+
+func (c *DRGroupController) processNextWorkItem() bool {
+	key, quit := c.queue.Get()
+	if quit {
+		return false
+	}
+	defer c.queue.Done(key)
+	err := c.syncDRGroup(key.(string))
+	c.handleErr(err, key)
+	return true
+}
+
+func (c *DRGroupController) handleErr(err error, key interface{}) {
+	if err == nil {
+		c.queue.Forget(key)
+		return
+	}
+
+	log := c.logger.WithField("drGroup", key)
+	if c.queue.NumRequeues(key) < maxRetries {
+		handleReconcileErrorLogging(log, err, "Failed to sync Longhorn DR group")
+		c.queue.AddRateLimited(key)
+		return
+	}
+
+	utilruntime.HandleError(err)
+	handleReconcileErrorLogging(log, err, "Dropping Longhorn DR group out of the queue")
+	c.queue.Forget(key)
+}
+
+func getLoggerForDRGroup(logger logrus.FieldLogger, drGroup *longhorn.DRGroup) *logrus.Entry {
+	return logger.WithFields(
+		logrus.Fields{
+			"drGroup":      drGroup.Name,
+			"role":         drGroup.Spec.Role,
+			"backupTarget": drGroup.Spec.BackupTargetName,
+		},
+	)
+}
+
+func (c *DRGroupController) isResponsibleFor(drGroup *longhorn.DRGroup) bool {
+	return isControllerResponsibleFor(c.controllerID, c.ds, drGroup.Name, "", drGroup.Status.OwnerID)
+}
+
+func (c *DRGroupController) syncDRGroup(key string) (err error) {
+	defer func() {
+		err = errors.Wrapf(err, "failed to sync DR group %v", key)
+	}()
+
+	namespace, name, err := cache.SplitMetaNamespaceKey(key)
+	if err != nil {
+		return err
+	}
+	if namespace != c.namespace {
+		return nil
+	}
+	return c.reconcile(name)
+}
+
+func (c *DRGroupController) reconcile(name string) (err error) {
+	drGroup, err := c.ds.GetDRGroup(name)
+	if err != nil {
+		if !datastore.ErrorIsNotFound(err) {
+			return err
+		}
+		return nil
+	}
+
+	if !c.isResponsibleFor(drGroup) {
+		return nil
+	}
+
+	log := getLoggerForDRGroup(c.logger, drGroup)
+
+	if drGroup.Status.OwnerID != c.controllerID {
+		drGroup.Status.OwnerID = c.controllerID
+		drGroup, err = c.ds.UpdateDRGroupStatus(drGroup)
+		if err != nil {
+			// we don't mind others coming first
+			if datastore.ErrorIsConflict(errors.Cause(err)) {
+				return nil
+			}
+			return err
+		}
+		log.Infof("DR group got new owner %v", c.controllerID)
+	}
+
+	if !drGroup.DeletionTimestamp.IsZero() {
+		return c.handleDRGroupDeletion(drGroup, log)
+	}
+
+	existingDRGroup := drGroup.DeepCopy()
+	defer func() {
+		if err != nil {
+			return
+		}
+		if reflect.DeepEqual(existingDRGroup.Status, drGroup.Status) {
+			return
+		}
+		if _, err = c.ds.UpdateDRGroupStatus(drGroup); err != nil && datastore.ErrorIsConflict(errors.Cause(err)) {
+			log.WithError(err).Debugf("Requeue %v due to conflict", name)
+			c.enqueueDRGroup(drGroup)
+			err = nil
+		}
+	}()
+
+	if _, err := c.ds.GetBackupTargetRO(drGroup.Spec.BackupTargetName); err != nil {
+		if datastore.ErrorIsNotFound(err) {
+			c.markDRGroupError(drGroup, fmt.Sprintf("cannot find backup target %v", drGroup.Spec.BackupTargetName))
+			return nil
+		}
+		return err
+	}
+
+	if drGroup.Status.Role == "" {
+		drGroup.Status.Role = drGroup.Spec.Role
+	}
+	if drGroup.Status.Volumes == nil {
+		drGroup.Status.Volumes = map[string]*longhorn.DRGroupVolumeStatus{}
+	}
+
+	switch {
+	case drGroup.Status.Role == longhorn.DRGroupRoleSecondary && drGroup.Spec.Role == longhorn.DRGroupRolePrimary:
+		return c.failover(drGroup, log)
+	case drGroup.Status.Role == longhorn.DRGroupRolePrimary && drGroup.Spec.Role == longhorn.DRGroupRoleSecondary:
+		return c.failback(drGroup, log)
+	case drGroup.Status.Role == longhorn.DRGroupRolePrimary:
+		return c.syncPrimary(drGroup)
+	default:
+		return c.syncSecondary(drGroup, log)
+	}
+}
+
+// syncPrimary labels the source volumes selected by the DR group, so that the group is carried by their backups
+// to the secondary cluster, and tracks the latest backups of the source volumes.
+func (c *DRGroupController) syncPrimary(drGroup *longhorn.DRGroup) error {
+	drGroupLabelKey := types.GetLonghornLabelKey(types.LonghornLabelDRGroup)
+
+	selected, err := c.listDRGroupSelectedVolumes(drGroup)
+	if err != nil {
+		return err
+	}
+	members, err := c.ds.ListDRGroupMemberVolumesRO(drGroup.Name)
+	if err != nil {
+		return err
+	}
+	for volumeName := range members {
+		if _, ok := selected[volumeName]; !ok {
+			if err := c.setDRGroupLabel(volumeName, ""); err != nil {
+				return err
+			}
+		}
+	}
+
+	volumeStatuses := map[string]*longhorn.DRGroupVolumeStatus{}
+	for volumeName, volume := range selected {
+		status := &longhorn.DRGroupVolumeStatus{}
+		volumeStatuses[volumeName] = status
+
+		if owner := volume.Labels[drGroupLabelKey]; owner != "" && owner != drGroup.Name {
+			status.Message = fmt.Sprintf("volume is a member of DR group %v", owner)
+			continue
+		}
+		if volume.Labels[drGroupLabelKey] != drGroup.Name {
+			if err := c.setDRGroupLabel(volumeName, drGroup.Name); err != nil {
+				return err
+			}
+		}
+
+		backupVolume, err := c.ds.GetBackupVolumeByBackupTargetAndVolumeRO(drGroup.Spec.BackupTargetName, volumeName)
+		if err != nil {
+			if !datastore.ErrorIsNotFound(err) {
+				return err
+			}
+			status.Message = "waiting for the first backup of the volume"
+			continue
+		}
+		status.BackupVolumeName = backupVolume.Name
+		status.LastBackupName = backupVolume.Status.LastBackupName
+		status.LastBackupAt = backupVolume.Status.LastBackupAt
+	}
+
+	drGroup.Status.Volumes = volumeStatuses
+	drGroup.Status.RecoveryPoint = getDRGroupRecoveryPoint(drGroup.Status.Role, volumeStatuses)
+	drGroup.Status.State = longhorn.DRGroupStateActive
+	drGroup.Status.Error = ""
+	return nil
+}
+
+// syncSecondary creates a standby volume for every backup volume carrying the DR group on the backup target,
+// and tracks the latest backups restored to the standby volumes.
+func (c *DRGroupController) syncSecondary(drGroup *longhorn.DRGroup, log logrus.FieldLogger) error {
+	backupVolumes, err := c.listDRGroupBackupVolumes(drGroup)
+	if err != nil {
+		return err
+	}
+
+	synced := true
+	volumeStatuses := map[string]*longhorn.DRGroupVolumeStatus{}
+	for volumeName, backupVolume := range backupVolumes {
+		status := &longhorn.DRGroupVolumeStatus{
+			BackupVolumeName: backupVolume.Name,
+			LastBackupName:   backupVolume.Status.LastBackupName,
+			LastBackupAt:     backupVolume.Status.LastBackupAt,
+		}
+		volumeStatuses[volumeName] = status
+
+		if backupVolume.Status.LastBackupName == "" {
+			status.Message = "waiting for the first backup of the volume"
+			synced = false
+			continue
+		}
+
+		volume, err := c.ds.GetVolumeRO(volumeName)
+		if err != nil {
+			if !datastore.ErrorIsNotFound(err) {
+				return err
+			}
+			created, err := c.createStandbyVolume(drGroup, volumeName, backupVolume)
+			if err != nil {
+				return err
+			}
+			if created {
+				log.Infof("Created standby volume %v from backup %v", volumeName, backupVolume.Status.LastBackupName)
+				status.Message = "creating the standby volume"
+			} else {
+				status.Message = fmt.Sprintf("waiting for backup %v to be synced", backupVolume.Status.LastBackupName)
+			}
+			synced = false
+			continue
+		}
+		if !volume.Spec.Standby {
+			status.Message = "volume exists and is not a standby volume"
+			synced = false
+			continue
+		}
+
+		status.Standby = true
+		if err := c.syncLastRestoredBackup(volume, status); err != nil {
+			return err
+		}
+		if status.LastRestoredBackupName != status.LastBackupName {
+			synced = false
+		}
+	}
+
+	drGroup.Status.Volumes = volumeStatuses
+	drGroup.Status.RecoveryPoint = getDRGroupRecoveryPoint(longhorn.DRGroupRoleSecondary, volumeStatuses)
+	drGroup.Status.Error = ""
+	if synced && len(volumeStatuses) > 0 {
+		drGroup.Status.State = longhorn.DRGroupStateStandby
+		return nil
+	}
+	drGroup.Status.State = longhorn.DRGroupStateSyncing
+	return enqueueAfterDelay(c.queue, drGroup, drGroupPollInterval)
+}
+
+// failover activates every standby volume of the DR group once it has restored the latest backup on the backup
+// target, and recreates the PV/PVC recorded in the backups of the source volumes. The cluster becomes the primary
+// of the group once all member volumes are activated, and the oldest restored backup is reported as the recovery point.
+func (c *DRGroupController) failover(drGroup *longhorn.DRGroup, log logrus.FieldLogger) error {
+	members, err := c.ds.ListDRGroupMemberVolumesRO(drGroup.Name)
+	if err != nil {
+		return err
+	}
+
+	if drGroup.Status.State != longhorn.DRGroupStateFailingOver {
+		log.Info("Failing over DR group")
+		// Pick up the backups taken since the last poll before waiting for the standby volumes to restore them
+		for _, volume := range members {
+			if !volume.Spec.Standby {
+				continue
+			}
+			if err := c.requestBackupVolumeSync(volume); err != nil {
+				return err
+			}
+		}
+		drGroup.Status.State = longhorn.DRGroupStateFailingOver
+	}
+
+	activated := true
+	for volumeName, volume := range members {
+		status := drGroup.Status.Volumes[volumeName]
+		if status == nil {
+			status = &longhorn.DRGroupVolumeStatus{}
+			drGroup.Status.Volumes[volumeName] = status
+		}
+
+		if volume.Spec.Standby {
+			restored, err := c.isLastBackupRestored(volume, status)
+			if err != nil {
+				return err
+			}
+			if !restored {
+				activated = false
+				continue
+			}
+			if err := c.activateStandbyVolume(volumeName); err != nil {
+				return err
+			}
+			log.Infof("Activating standby volume %v", volumeName)
+			status.Message = "activating the standby volume"
+			activated = false
+			continue
+		}
+		if volume.Status.IsStandby || volume.Status.RestoreRequired {
+			status.Message = "waiting for the volume to finish the last restore"
+			activated = false
+			continue
+		}
+
+		status.Standby = false
+		done, err := c.recreateKubernetesResources(volume, status)
+		if err != nil {
+			return err
+		}
+		if !done {
+			activated = false
+			continue
+		}
+		status.Message = ""
+	}
+
+	if !activated {
+		return enqueueAfterDelay(c.queue, drGroup, drGroupPollInterval)
+	}
+
+	drGroup.Status.Role = longhorn.DRGroupRolePrimary
+	drGroup.Status.State = longhorn.DRGroupStateActive
+	drGroup.Status.RecoveryPoint = getDRGroupRecoveryPoint(longhorn.DRGroupRoleSecondary, drGroup.Status.Volumes)
+	drGroup.Status.LastFailoverAt = util.Now()
+	drGroup.Status.Error = ""
+	c.eventRecorder.Eventf(drGroup, corev1.EventTypeNormal, constant.EventReasonFailedOver, "failed over member volumes %v with recovery point %v",
+		util.GetSortedKeysFromMap(members), drGroup.Status.RecoveryPoint)
+	return nil
+}
+
+// failback replaces the stale source volumes of the DR group with the standby volumes seeded from the backups
+// of the new primary. A stale volume is deleted only once the new primary has completed a backup newer than the
+// data of the stale volume, the stale volume is detached, and the stale data is kept in a copy of the volume.
+func (c *DRGroupController) failback(drGroup *longhorn.DRGroup, log logrus.FieldLogger) error {
+	if drGroup.Status.State != longhorn.DRGroupStateFailingBack {
+		log.Info("Failing back DR group")
+		drGroup.Status.State = longhorn.DRGroupStateFailingBack
+	}
+
+	members, err := c.ds.ListDRGroupMemberVolumesRO(drGroup.Name)
+	if err != nil {
+		return err
+	}
+
+	pending := false
+	for volumeName, volume := range members {
+		if volume.Spec.Standby {
+			continue
+		}
+		status := drGroup.Status.Volumes[volumeName]
+		if status == nil {
+			status = &longhorn.DRGroupVolumeStatus{}
+			drGroup.Status.Volumes[volumeName] = status
+		}
+
+		pending = true
+		if !volume.DeletionTimestamp.IsZero() {
+			status.Message = "waiting for the stale volume to be deleted"
+			continue
+		}
+		copied, err := c.copyStaleVolume(drGroup, volume, status)
+		if err != nil {
+			return err
+		}
+		if !copied {
+			continue
+		}
+		if volume.Status.State != longhorn.VolumeStateDetached {
+			status.Message = "waiting for the stale volume to be detached"
+			continue
+		}
+		log.Infof("Deleting stale volume %v to seed it from the backups of the new primary", volumeName)
+		if err := c.ds.DeleteVolume(volumeName); err != nil && !datastore.ErrorIsNotFound(err) {
+			return errors.Wrapf(err, "failed to delete stale volume %v", volumeName)
+		}
+		status.Message = "waiting for the stale volume to be deleted"
+	}
+
+	if pending {
+		return enqueueAfterDelay(c.queue, drGroup, drGroupPollInterval)
+	}
+
+	drGroup.Status.Role = longhorn.DRGroupRoleSecondary
+	drGroup.Status.LastFailbackAt = util.Now()
+	c.eventRecorder.Eventf(drGroup, corev1.EventTypeNormal, constant.EventReasonFailedBack, "failed back member volumes %v", util.GetSortedKeysFromMap(members))
+	return c.syncSecondary(drGroup, log)
+}
+
+// hasNewerBackupThanStaleVolume returns true if the latest backup of the stale volume on the backup target is a
+// completed backup taken by the new primary, which is the case if it is not taken from a snapshot of the stale
+// volume and it is newer than the last failover of the group to the cluster and than all the snapshots of the
+// stale volume.
+func (c *DRGroupController) hasNewerBackupThanStaleVolume(drGroup *longhorn.DRGroup, volume *longhorn.Volume) (bool, error) {
+	backupVolume, err := c.ds.GetBackupVolumeByBackupTargetAndVolumeRO(drGroup.Spec.BackupTargetName, volume.Name)
+	if err != nil {
+		if datastore.ErrorIsNotFound(err) {
+			return false, nil
+		}
+		return false, err
+	}
+	if backupVolume.Status.LastBackupName == "" {
+		return false, nil
+	}
+	backup, err := c.ds.GetBackupRO(backupVolume.Status.LastBackupName)
+	if err != nil {
+		if datastore.ErrorIsNotFound(err) {
+			return false, nil
+		}
+		return false, err
+	}
+	if backup.Status.State != longhorn.BackupStateCompleted {
+		return false, nil
+	}
+
+	snapshots, err := c.ds.ListVolumeSnapshotsRO(volume.Name)
+	if err != nil {
+		return false, err
+	}
+	times := []string{drGroup.Status.LastFailoverAt}
+	for _, snapshot := range snapshots {
+		if snapshot.Name == backup.Status.SnapshotName {
+			return false, nil
+		}
+		times = append(times, snapshot.Status.CreationTime)
+	}
+	return isBackupNewerThan(backup.Status.BackupCreatedAt, times...), nil
+}
+
+// copyStaleVolume clones the stale volume into a volume kept after the failback, so that the data written to the
+// stale volume after its last backup is not lost. The copy is started once the new primary has completed a backup
+// newer than the stale volume, since the clone snapshot would be newer than the backup. It returns true once the
+// copy is completed.
+func (c *DRGroupController) copyStaleVolume(drGroup *longhorn.DRGroup, volume *longhorn.Volume, status *longhorn.DRGroupVolumeStatus) (bool, error) {
+	copyName := getStaleVolumeCopyName(volume)
+	staleCopy, err := c.ds.GetVolumeRO(copyName)
+	if err != nil {
+		if !datastore.ErrorIsNotFound(err) {
+			return false, err
+		}
+		newer, err := c.hasNewerBackupThanStaleVolume(drGroup, volume)
+		if err != nil {
+			return false, err
+		}
+		if !newer {
+			status.Message = "waiting for the new primary to complete a backup newer than the stale volume"
+			return false, nil
+		}
+		if volume.Status.State != longhorn.VolumeStateDetached {
+			status.Message = "waiting for the stale volume to be detached"
+			return false, nil
+		}
+		if _, err := c.ds.CreateVolume(&longhorn.Volume{
+			ObjectMeta: metav1.ObjectMeta{
+				Name: copyName,
+			},
+			Spec: longhorn.VolumeSpec{
+				Size:             volume.Spec.Size,
+				DataSource:       types.NewVolumeDataSourceTypeVolume(volume.Name),
+				DataEngine:       volume.Spec.DataEngine,
+				NumberOfReplicas: volume.Spec.NumberOfReplicas,
+				Encrypted:        volume.Spec.Encrypted,
+			},
+		}); err != nil && !apierrors.IsAlreadyExists(err) {
+			return false, errors.Wrapf(err, "failed to copy stale volume %v to %v", volume.Name, copyName)
+		}
+		status.Message = fmt.Sprintf("copying the stale volume to %v", copyName)
+		return false, nil
+	}
+
+	switch staleCopy.Status.CloneStatus.State {
+	case longhorn.VolumeCloneStateCompleted:
+		return true, nil
+	case longhorn.VolumeCloneStateFailed:
+		status.Message = fmt.Sprintf("failed to copy the stale volume to %v, delete the copy to retry", copyName)
+	default:
+		status.Message = fmt.Sprintf("copying the stale volume to %v", copyName)
+	}
+	return false, nil
+}
+
+func (c *DRGroupController) handleDRGroupDeletion(drGroup *longhorn.DRGroup, log logrus.FieldLogger) error {
+	members, err := c.ds.ListDRGroupMemberVolumesRO(drGroup.Name)
+	if err != nil {
+		return err
+	}
+	// The member volumes are left in place, only the group membership is removed
+	for volumeName := range members {
+		log.Infof("Removing volume %v from DR group", volumeName)
+		if err := c.setDRGroupLabel(volumeName, ""); err != nil {
+			return err
+		}
+	}
+
+	return c.ds.RemoveFinalizerForDRGroup(drGroup)
+}
+
+func (c *DRGroupController) listDRGroupSelectedVolumes(drGroup *longhorn.DRGroup) (map[string]*longhorn.Volume, error) {
+	selected := map[string]*longhorn.Volume{}
+	if len(drGroup.Spec.VolumeSelector) == 0 {
+		return selected, nil
+	}
+
+	volumes, err := c.ds.ListVolumesBySelectorRO(labels.SelectorFromSet(drGroup.Spec.VolumeSelector))
+	if err != nil {
+		return nil, err
+	}
+	for _, volume := range volumes {
+		if !volume.DeletionTimestamp.IsZero() {
+			continue
+		}
+		selected[volume.Name] = volume
+	}
+	return selected, nil
+}
+
+// listDRGroupBackupVolumes returns the backup volumes carrying the DR group on the backup target of the group,
+// keyed by the source volume names.
+func (c *DRGroupController) listDRGroupBackupVolumes(drGroup *longhorn.DRGroup) (map[string]*longhorn.BackupVolume, error) {
+	backupVolumes, err := c.ds.ListBackupVolumesWithBackupTargetNameRO(drGroup.Spec.BackupTargetName)
+	if err != nil {
+		return nil, err
+	}
+
+	drGroupLabelKey := types.GetLonghornLabelKey(types.LonghornLabelDRGroup)
+	result := map[string]*longhorn.BackupVolume{}
+	for _, backupVolume := range backupVolumes {
+		if !backupVolume.DeletionTimestamp.IsZero() || backupVolume.Status.Labels[drGroupLabelKey] != drGroup.Name {
+			continue
+		}
+		volumeName := backupVolume.Spec.VolumeName
+		if volumeName == "" {
+			volumeName = backupVolume.Labels[types.LonghornLabelBackupVolume]
+		}
+		if volumeName == "" {
+			continue
+		}
+		result[volumeName] = backupVolume
+	}
+	return result, nil
+}
+
+// createStandbyVolume creates the standby volume from the latest backup of the backup volume. It returns false if
+// the latest backup is not synced into the cluster yet.
+func (c *DRGroupController) createStandbyVolume(drGroup *longhorn.DRGroup, volumeName string, backupVolume *longhorn.BackupVolume) (bool, error) {
+	backup, err := c.ds.GetBackupRO(backupVolume.Status.LastBackupName)
+	if err != nil {
+		if datastore.ErrorIsNotFound(err) {
+			return false, nil
+		}
+		return false, err
+	}
+	if backup.Status.URL == "" {
+		return false, nil
+	}
+
+	volumeLabels := map[string]string{}
+	for key, value := range drGroup.Spec.VolumeSelector {
+		volumeLabels[key] = value
+	}
+	for key, value := range types.GetDRGroupLabels(drGroup.Name) {
+		volumeLabels[key] = value
+	}
+
+	if _, err := c.ds.CreateVolume(&longhorn.Volume{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:   volumeName,
+			Labels: volumeLabels,
+		},
+		Spec: longhorn.VolumeSpec{
+			FromBackup:       backup.Status.URL,
+			Standby:          true,
+			BackupTargetName: drGroup.Spec.BackupTargetName,
+			NumberOfReplicas: drGroup.Spec.NumberOfReplicas,
+		},
+	}); err != nil && !apierrors.IsAlreadyExists(err) {
+		return false, errors.Wrapf(err, "failed to create standby volume %v", volumeName)
+	}
+	return true, nil
+}
+
+func (c *DRGroupController) syncLastRestoredBackup(volume *longhorn.Volume, status *longhorn.DRGroupVolumeStatus) error {
+	engines, err := c.ds.ListVolumeEnginesRO(volume.Name)
+	if err != nil {
+		return err
+	}
+	if len(engines) == 0 {
+		return nil
+	}
+	engine, err := c.ds.PickVolumeCurrentEngine(volume, engines)
+	if err != nil {
+		return err
+	}
+
+	status.LastRestoredBackupName = engine.Status.LastRestoredBackup
+	status.LastRestoredBackupAt = ""
+	if status.LastRestoredBackupName == "" {
+		return nil
+	}
+	backup, err := c.ds.GetBackupRO(status.LastRestoredBackupName)
+	if err != nil {
+		if datastore.ErrorIsNotFound(err) {
+			return nil
+		}
+		return err
+	}
+	status.LastRestoredBackupAt = backup.Status.BackupCreatedAt
+	return nil
+}
+
+// requestBackupVolumeSync requests the backup volume of the standby volume to sync up the latest backup, the same
+// as the volume activate action.
+func (c *DRGroupController) requestBackupVolumeSync(volume *longhorn.Volume) error {
+	backupVolumeName := volume.Labels[types.LonghornLabelBackupVolume]
+	if backupVolumeName == "" {
+		return nil
+	}
+	backupVolume, err := c.ds.GetBackupVolumeByBackupTargetAndVolume(volume.Spec.BackupTargetName, backupVolumeName)
+	if err != nil {
+		if datastore.ErrorIsNotFound(err) {
+			return nil
+		}
+		return errors.Wrapf(err, "failed to get backup volume %v", backupVolumeName)
+	}
+	backupVolume.Spec.SyncRequestedAt = metav1.Time{Time: time.Now().UTC()}
+	if _, err := c.ds.UpdateBackupVolume(backupVolume); err != nil {
+		return errors.Wrapf(err, "failed to update backup volume %v", backupVolume.Name)
+	}
+	return nil
+}
+
+// isLastBackupRestored returns true once the backup volume of the standby volume is synced and the standby volume
+// has restored the latest backup of it. A standby volume whose backup volume is gone has nothing left to restore.
+func (c *DRGroupController) isLastBackupRestored(volume *longhorn.Volume, status *longhorn.DRGroupVolumeStatus) (bool, error) {
+	backupVolumeName := volume.Labels[types.LonghornLabelBackupVolume]
+	if backupVolumeName == "" {
+		return true, nil
+	}
+	backupVolume, err := c.ds.GetBackupVolumeByBackupTargetAndVolumeRO(volume.Spec.BackupTargetName, backupVolumeName)
+	if err != nil {
+		if datastore.ErrorIsNotFound(err) {
+			return true, nil
+		}
+		return false, errors.Wrapf(err, "failed to get backup volume %v", backupVolumeName)
+	}
+	if backupVolume.Status.LastSyncedAt.IsZero() || backupVolume.Spec.SyncRequestedAt.After(backupVolume.Status.LastSyncedAt.Time) {
+		status.Message = fmt.Sprintf("waiting for backup volume %v to be synced", backupVolume.Name)
+		return false, nil
+	}
+
+	status.LastBackupName = backupVolume.Status.LastBackupName
+	status.LastBackupAt = backupVolume.Status.LastBackupAt
+	if err := c.syncLastRestoredBackup(volume, status); err != nil {
+		return false, err
+	}
+	if status.LastRestoredBackupName != status.LastBackupName {
+		status.Message = fmt.Sprintf("waiting for the standby volume to restore the last backup %v", status.LastBackupName)
+		return false, nil
+	}
+	return true, nil
+}
+
+// activateStandbyVolume activates the standby volume, the same as the volume activate action
+func (c *DRGroupController) activateStandbyVolume(volumeName string) error {
+	volume, err := c.ds.GetVolume(volumeName)
+	if err != nil {
+		return err
+	}
+
+	volume.Spec.Standby = false
+	volume.Spec.Frontend = longhorn.VolumeFrontendBlockDev
+	if _, err := c.ds.UpdateVolume(volume); err != nil {
+		return errors.Wrapf(err, "failed to activate standby volume %v", volumeName)
+	}
+	return nil
+}
+
+// recreateKubernetesResources creates the PV of the activated volume and the PVC recorded in the backup of the
+// source volume. It returns true once the PVC is bound, or if the backup does not record any PVC.
+func (c *DRGroupController) recreateKubernetesResources(volume *longhorn.Volume, status *longhorn.DRGroupVolumeStatus) (bool, error) {
+	ks := volume.Status.KubernetesStatus
+
+	if ks.PVName == "" {
+		if err := c.createPersistentVolume(volume); err != nil {
+			return false, err
+		}
+		status.Message = "creating the PV"
+		return false, nil
+	}
+
+	if ks.PVCName == "" || ks.Namespace == "" || ks.LastPVCRefAt == "" {
+		return true, nil
+	}
+
+	if _, err := c.ds.GetPersistentVolumeClaimRO(ks.Namespace, ks.PVCName); err == nil {
+		status.Message = fmt.Sprintf("waiting for PVC %v/%v to be bound", ks.Namespace, ks.PVCName)
+		return false, nil
+	} else if !datastore.ErrorIsNotFound(err) {
+		return false, err
+	}
+	if ks.PVStatus != string(corev1.VolumeAvailable) && ks.PVStatus != string(corev1.VolumeReleased) {
+		status.Message = fmt.Sprintf("waiting for PV %v to be available", ks.PVName)
+		return false, nil
+	}
+
+	pv, err := c.ds.GetPersistentVolume(ks.PVName)
+	if err != nil {
+		return false, err
+	}
+	// cleanup ClaimRef of PV. Otherwise the existing PV cannot be reused.
+	if pv.Spec.ClaimRef != nil {
+		pv.Spec.ClaimRef = nil
+		if pv, err = c.ds.UpdatePersistentVolume(pv); err != nil {
+			return false, err
+		}
+	}
+
+	pvc := datastore.NewPVCManifestForVolume(volume, ks.PVName, ks.Namespace, ks.PVCName, pv.Spec.StorageClassName)
+	if _, err := c.ds.CreatePersistentVolumeClaim(ks.Namespace, pvc); err != nil && !apierrors.IsAlreadyExists(err) {
+		return false, err
+	}
+	status.Message = fmt.Sprintf("waiting for PVC %v/%v to be bound", ks.Namespace, ks.PVCName)
+	return false, nil
+}
+
+// createPersistentVolume creates the PV of the activated volume with the storage class, the filesystem type and
+// the CSI secret recorded in the backups of the source volume, falling back to the defaults of the PV create action.
+func (c *DRGroupController) createPersistentVolume(volume *longhorn.Volume) error {
+	storageClassName := ""
+	backupLabels := map[string]string{}
+	if backupVolumeName := volume.Labels[types.LonghornLabelBackupVolume]; backupVolumeName != "" {
+		backupVolume, err := c.ds.GetBackupVolumeByBackupTargetAndVolumeRO(volume.Spec.BackupTargetName, backupVolumeName)
+		if err != nil && !datastore.ErrorIsNotFound(err) {
+			return err
+		}
+		if backupVolume != nil && err == nil {
+			storageClassName = backupVolume.Status.StorageClassName
+			if backupVolume.Status.Labels != nil {
+				backupLabels = backupVolume.Status.Labels
+			}
+		}
+	}
+	if storageClassName == "" {
+		defaultStorageClassName, err := c.ds.GetSettingValueExisted(types.SettingNameDefaultLonghornStaticStorageClass)
+		if err != nil {
+			return err
+		}
+		storageClassName = defaultStorageClassName
+	}
+
+	fsType := backupLabels[types.PVFSTypeLabel]
+	if fsType == "" {
+		fsType = "ext4"
+	}
+
+	pv := datastore.NewPVManifestForVolume(volume, volume.Name, storageClassName, fsType)
+	if volume.Spec.Encrypted {
+		secretRef := &corev1.SecretReference{
+			Name:      backupLabels[types.PVSecretNameLabel],
+			Namespace: backupLabels[types.PVSecretNamespaceLabel],
+		}
+		if secretRef.Name == "" {
+			secretRef.Name = "longhorn-crypto"
+		}
+		if secretRef.Namespace == "" {
+			secretRef.Namespace = c.namespace
+		}
+		pv.Spec.CSI.NodeStageSecretRef = secretRef
+		pv.Spec.CSI.NodePublishSecretRef = secretRef
+	}
+
+	if _, err := c.ds.CreatePersistentVolume(pv); err != nil && !apierrors.IsAlreadyExists(err) {
+		return errors.Wrapf(err, "failed to create PV for volume %v", volume.Name)
+	}
+	return nil
+}
+
+// setDRGroupLabel sets the DR group label of the volume, or removes it if the DR group name is empty
+func (c *DRGroupController) setDRGroupLabel(volumeName, drGroupName string) error {
+	volume, err := c.ds.GetVolume(volumeName)
+	if err != nil {
+		if datastore.ErrorIsNotFound(err) {
+			return nil
+		}
+		return err
+	}
+
+	drGroupLabelKey := types.GetLonghornLabelKey(types.LonghornLabelDRGroup)
+	if drGroupName == "" {
+		if _, ok := volume.Labels[drGroupLabelKey]; !ok {
+			return nil
+		}
+		delete(volume.Labels, drGroupLabelKey)
+	} else {
+		if volume.Labels == nil {
+			volume.Labels = map[string]string{}
+		}
+		volume.Labels[drGroupLabelKey] = drGroupName
+	}
+
+	if _, err := c.ds.UpdateVolume(volume); err != nil {
+		return errors.Wrapf(err, "failed to update DR group label of volume %v", volumeName)
+	}
+	return nil
+}
+
+func (c *DRGroupController) markDRGroupError(drGroup *longhorn.DRGroup, message string) {
+	if drGroup.Status.State == longhorn.DRGroupStateError && drGroup.Status.Error == message {
+		return
+	}
+	drGroup.Status.State = longhorn.DRGroupStateError
+	drGroup.Status.Error = message
+	c.eventRecorder.Event(drGroup, corev1.EventTypeWarning, constant.EventReasonFailed, message)
+}
+
+// getStaleVolumeCopyName returns the name of the copy of the stale volume. The name is derived from the UID of the
+// stale volume, so every failback keeps its own copy.
+func getStaleVolumeCopyName(volume *longhorn.Volume) string {
+	return fmt.Sprintf("%s-stale-%s", volume.Name, util.GetStringChecksum(string(volume.UID))[:8])
+}
+
+// isBackupNewerThan returns true if the backup time is after all the given non-empty times
+func isBackupNewerThan(backupAt string, times ...string) bool {
+	backupTime, err := util.ParseTime(backupAt)
+	if err != nil {
+		return false
+	}
+	for _, t := range times {
+		if t == "" {
+			continue
+		}
+		parsed, err := util.ParseTime(t)
+		if err != nil {
+			return false
+		}
+		if !backupTime.After(parsed) {
+			return false
+		}
+	}
+	return true
+}
+
+// isVolumeSelectedByDRGroup returns true if the volume labels match the volume selector of the DR group.
+// A DR group without a volume selector selects nothing.
+func isVolumeSelectedByDRGroup(drGroup *longhorn.DRGroup, volume *longhorn.Volume) bool {
+	if len(drGroup.Spec.VolumeSelector) == 0 {
+		return false
+	}
+	return labels.SelectorFromSet(drGroup.Spec.VolumeSelector).Matches(labels.Set(volume.Labels))
+}
+
+// getDRGroupRecoveryPoint returns the oldest data among the member volumes of the DR group, which is the oldest
+// latest backup on the primary cluster and the oldest latest restored backup on the secondary cluster.
+// It returns an empty string if any member volume has no recovery point yet.
+func getDRGroupRecoveryPoint(role longhorn.DRGroupRole, volumeStatuses map[string]*longhorn.DRGroupVolumeStatus) string {
+	recoveryPoint := ""
+	var oldest time.Time
+	for _, status := range volumeStatuses {
+		point := status.LastBackupAt
+		if role == longhorn.DRGroupRoleSecondary {
+			point = status.LastRestoredBackupAt
+		}
+		if point == "" {
+			return ""
+		}
+		t, err := util.ParseTime(point)
+		if err != nil {
+			return ""
+		}
+		if recoveryPoint == "" || t.Before(oldest) {
+			recoveryPoint = point
+			oldest = t
+		}
+	}
+	return recoveryPoint
+}
@@ -0,0 +1,42 @@
+package controller
+
+import (
+	longhorn "github.com/longhorn/longhorn-manager/k8s/pkg/apis/longhorn/v1beta2"
+
+	. "gopkg.in/check.v1"
+)
+
+func (s *TestSuite) TestGetDRGroupRecoveryPoint(c *C) {
+	volumeStatuses := map[string]*longhorn.DRGroupVolumeStatus{
+		"vol-1": {
+			LastBackupAt:         "2024-05-01T10:00:00Z",
+			LastRestoredBackupAt: "2024-05-01T09:00:00Z",
+		},
+		"vol-2": {
+			LastBackupAt:         "2024-05-01T08:00:00Z",
+			LastRestoredBackupAt: "2024-05-01T07:00:00Z",
+		},
+	}
+	c.Assert(getDRGroupRecoveryPoint(longhorn.DRGroupRolePrimary, volumeStatuses), Equals, "2024-05-01T08:00:00Z")
+	c.Assert(getDRGroupRecoveryPoint(longhorn.DRGroupRoleSecondary, volumeStatuses), Equals, "2024-05-01T07:00:00Z")
+
+	// A member volume without any backup leaves the group without a recovery point
+	volumeStatuses["vol-3"] = &longhorn.DRGroupVolumeStatus{LastBackupAt: "2024-05-01T11:00:00Z"}
+	c.Assert(getDRGroupRecoveryPoint(longhorn.DRGroupRolePrimary, volumeStatuses), Equals, "2024-05-01T08:00:00Z")
+	c.Assert(getDRGroupRecoveryPoint(longhorn.DRGroupRoleSecondary, volumeStatuses), Equals, "")
+
+	c.Assert(getDRGroupRecoveryPoint(longhorn.DRGroupRolePrimary, map[string]*longhorn.DRGroupVolumeStatus{}), Equals, "")
+}
+
+func (s *TestSuite) TestIsBackupNewerThan(c *C) {
+	c.Assert(isBackupNewerThan("2024-05-01T10:00:00Z", "2024-05-01T09:00:00Z", "", "2024-05-01T08:00:00Z"), Equals, true)
+	c.Assert(isBackupNewerThan("2024-05-01T10:00:00Z"), Equals, true)
+
+	// The backup must be strictly newer than every given time
+	c.Assert(isBackupNewerThan("2024-05-01T10:00:00Z", "2024-05-01T09:00:00Z", "2024-05-01T10:00:00Z"), Equals, false)
+	c.Assert(isBackupNewerThan("2024-05-01T10:00:00Z", "2024-05-01T11:00:00Z"), Equals, false)
+
+	// A backup or a time that cannot be parsed never allows replacing the stale volume
+	c.Assert(isBackupNewerThan("", "2024-05-01T09:00:00Z"), Equals, false)
+	c.Assert(isBackupNewerThan("2024-05-01T10:00:00Z", "invalid"), Equals, false)
+}
@@ -55,9 +55,11 @@ type DiskMonitor struct {
 
 	getDiskStatHandler          GetDiskStatHandler
 	getDiskHealthHandler        GetDiskHealthHandler
+	getDiskTierHandler          GetDiskTierHandler
 	getDiskConfigHandler        GetDiskConfigHandler
 	generateDiskConfigHandler   GenerateDiskConfigHandler
 	getReplicaDataStoresHandler GetReplicaDataStoresHandler
+	getReplicaActualSizeHandler GetReplicaActualSizeHandler
 }
 
 type CollectedDiskInfo struct {
@@ -67,18 +69,23 @@ type CollectedDiskInfo struct {
 	DiskName                  string
 	DiskUUID                  string
 	DiskDriver                longhorn.DiskDriver
+	Tier                      longhorn.DiskTier
 	Condition                 *longhorn.Condition
 	OrphanedReplicaDataStores map[string]string
 	InstanceManagerName       string
 	HealthData                map[string]longhorn.HealthData
 	HealthDataLastCollectedAt time.Time
+	// ReplicaActualSizes are the space allocated by the replica data stores on the disk, keyed by the data store name
+	ReplicaActualSizes map[string]int64
 }
 
 type GetDiskStatHandler func(longhorn.DiskType, string, string, longhorn.DiskDriver, *DiskServiceClient) (*lhtypes.DiskStat, error)
 type GetDiskHealthHandler func(longhorn.DiskType, string, string, longhorn.DiskDriver, time.Time, *DiskServiceClient, logrus.FieldLogger) (map[string]longhorn.HealthData, time.Time, error)
+type GetDiskTierHandler func(longhorn.DiskType, string, longhorn.DiskDriver) (longhorn.DiskTier, error)
 type GetDiskConfigHandler func(longhorn.DiskType, string, string, longhorn.DiskDriver, *DiskServiceClient) (*util.DiskConfig, error)
 type GenerateDiskConfigHandler func(longhorn.DiskType, string, string, string, string, *DiskServiceClient, *datastore.DataStore) (*util.DiskConfig, error)
 type GetReplicaDataStoresHandler func(longhorn.DiskType, *longhorn.Node, string, string, string, string, *DiskServiceClient) (map[string]string, error)
+type GetReplicaActualSizeHandler func(longhorn.DiskType, string, string, string, map[string]string, *DiskServiceClient) (map[string]int64, error)
 
 func NewDiskMonitor(logger logrus.FieldLogger, ds *datastore.DataStore, nodeName string, syncCallback func(key string)) (*DiskMonitor, error) {
 	ctx, quit := context.WithCancel(context.Background())
@@ -96,9 +103,11 @@ func NewDiskMonitor(logger logrus.FieldLogger, ds *datastore.DataStore, nodeName
 
 		getDiskStatHandler:          getDiskStat,
 		getDiskHealthHandler:        getDiskHealth,
+		getDiskTierHandler:          getDiskTier,
 		getDiskConfigHandler:        getDiskConfig,
 		generateDiskConfigHandler:   generateDiskConfig,
 		getReplicaDataStoresHandler: getReplicaDataStores,
+		getReplicaActualSizeHandler: getReplicaActualSizes,
 	}
 
 	go m.Start()
@@ -352,6 +361,12 @@ func (m *DiskMonitor) collectDiskData(node *longhorn.Node) map[string]*Collected
 			continue
 		}
 
+		// The orphan check removes the data stores owned by replicas from the map, so the sizes are collected first
+		replicaActualSizes, err := m.getReplicaActualSizeHandler(disk.Type, diskName, disk.Path, string(diskConfig.DiskDriver), replicaDataStores, diskServiceClient)
+		if err != nil {
+			m.logger.WithError(err).Debugf("Failed to get replica actual sizes for disk %v(%v) on node %v", diskName, disk.Path, node.Name)
+		}
+
 		orphanedReplicaDataStores, err = m.getOrphanedReplicaDataStores(disk.Type, diskConfig.DiskUUID, disk.Path, replicaDataStores)
 		if err != nil {
 			m.logger.WithError(err).Warnf("Failed to get orphaned replica data stores for disk %v(%v) on node %v", diskName, disk.Path, node.Name)
@@ -360,15 +375,25 @@ func (m *DiskMonitor) collectDiskData(node *longhorn.Node) map[string]*Collected
 
 		diskInfoMap[diskName] = NewDiskInfo(diskConfig.DiskName, diskConfig.DiskUUID, disk.Path, diskConfig.DiskDriver, nodeOrDiskEvicted, stat,
 			orphanedReplicaDataStores, instanceManagerName, string(longhorn.DiskConditionReasonNoDiskInfo), "")
+		diskInfoMap[diskName].ReplicaActualSizes = replicaActualSizes
 
 		if node.Status.DiskStatus != nil {
 			if diskStatus, ok := node.Status.DiskStatus[diskName]; ok {
 				// Preserve existing health data to avoid losing it between collection intervals
 				diskInfoMap[diskName].HealthData = diskStatus.HealthData
 				diskInfoMap[diskName].HealthDataLastCollectedAt = diskStatus.HealthDataLastCollectedAt.Time
+				diskInfoMap[diskName].Tier = diskStatus.Tier
 			}
 		}
 
+		tier, err := m.getDiskTierHandler(disk.Type, disk.Path, diskConfig.DiskDriver)
+		if err != nil {
+			// Keep the previously detected tier, so the replica scheduling does not flap on a transient failure
+			m.logger.WithError(err).Debugf("Failed to detect disk tier for disk %v(%v)", diskName, disk.Path)
+		} else {
+			diskInfoMap[diskName].Tier = tier
+		}
+
 		if monitorDiskHealth {
 			healthData, collectedAt, err := m.getDiskHealthHandler(disk.Type, diskName, disk.Path, diskConfig.DiskDriver, diskInfoMap[diskName].HealthDataLastCollectedAt, diskServiceClient, m.logger)
 			if err != nil {
@@ -399,6 +424,17 @@ func getReplicaDataStores(diskType longhorn.DiskType, node *longhorn.Node, diskN
 	}
 }
 
+func getReplicaActualSizes(diskType longhorn.DiskType, diskName, diskPath, diskDriver string, replicaDataStores map[string]string, client *DiskServiceClient) (map[string]int64, error) {
+	switch diskType {
+	case longhorn.DiskTypeFilesystem:
+		return getReplicaDirectoryActualSizes(diskPath, replicaDataStores)
+	case longhorn.DiskTypeBlock:
+		return getSpdkReplicaInstanceActualSizes(client, string(diskType), diskName, diskDriver)
+	default:
+		return nil, fmt.Errorf("unknown disk type %v", diskType)
+	}
+}
+
 func getReplicaDirectoryNames(node *longhorn.Node, diskName, diskUUID, diskPath string) (map[string]string, error) {
 	if !canCollectDiskData(node, diskName, diskUUID, diskPath) {
 		return map[string]string{}, nil
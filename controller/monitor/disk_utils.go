@@ -66,6 +66,25 @@ func getBlockTypeDiskStat(client *DiskServiceClient, diskName, diskPath string,
 	}, nil
 }
 
+func getDiskTier(diskType longhorn.DiskType, diskPath string, diskDriver longhorn.DiskDriver) (longhorn.DiskTier, error) {
+	switch diskType {
+	case longhorn.DiskTypeFilesystem:
+		return util.GetDiskTierFromMountPath(diskPath)
+	case longhorn.DiskTypeBlock:
+		switch diskDriver {
+		case longhorn.DiskDriverNvme:
+			// NVME driver binds the device to SPDK, so it is no longer visible in sysfs
+			return longhorn.DiskTierNvme, nil
+		case longhorn.DiskDriverAio:
+			return util.GetDiskTierForBlockDevice(diskPath)
+		default:
+			return longhorn.DiskTierUnknown, fmt.Errorf("unknown block disk driver %v", diskDriver)
+		}
+	default:
+		return longhorn.DiskTierUnknown, fmt.Errorf("unknown disk type %v", diskType)
+	}
+}
+
 func getDiskHealth(diskType longhorn.DiskType, diskName, diskPath string, diskDriver longhorn.DiskDriver, lastCollectedAt time.Time, client *DiskServiceClient, logger logrus.FieldLogger) (map[string]longhorn.HealthData, time.Time, error) {
 	// Skip if health data was collected recently.
 	if time.Since(lastCollectedAt) < HealthDataUpdateInterval {
@@ -462,6 +481,37 @@ func getSpdkReplicaInstanceNames(client *DiskServiceClient, diskType, diskName,
 	return instanceNames, nil
 }
 
+func getSpdkReplicaInstanceActualSizes(client *DiskServiceClient, diskType, diskName, diskDriver string) (map[string]int64, error) {
+	if client == nil || client.c == nil {
+		return nil, errors.New("disk service client is nil")
+	}
+
+	instances, err := client.c.DiskReplicaInstanceList(diskType, diskName, diskDriver)
+	if err != nil {
+		return nil, err
+	}
+
+	actualSizes := map[string]int64{}
+	for name, instance := range instances {
+		actualSizes[name] = int64(instance.ActualSize)
+	}
+
+	return actualSizes, nil
+}
+
+func getReplicaDirectoryActualSizes(diskPath string, replicaDirectoryNames map[string]string) (map[string]int64, error) {
+	actualSizes := map[string]int64{}
+	for name := range replicaDirectoryNames {
+		actualSize, err := util.GetReplicaDirectoryActualSize(diskPath, name)
+		if err != nil {
+			return nil, err
+		}
+		actualSizes[name] = actualSize
+	}
+
+	return actualSizes, nil
+}
+
 func generateUniqueFirstFourCharUUID(allDiskUUIDFirstFourCharSet map[string]bool) (string, error) {
 	for i := 0; i < uuidGenerationRetries; i++ {
 		uuid := util.UUID()
@@ -40,9 +40,11 @@ func NewFakeDiskMonitor(logger logrus.FieldLogger, ds *datastore.DataStore, node
 
 		getDiskStatHandler:          fakeGetDiskStat,
 		getDiskHealthHandler:        fakeGetDiskHealth,
+		getDiskTierHandler:          fakeGetDiskTier,
 		getDiskConfigHandler:        fakeGetDiskConfig,
 		generateDiskConfigHandler:   fakeGenerateDiskConfig,
 		getReplicaDataStoresHandler: fakeGetReplicaDataStores,
+		getReplicaActualSizeHandler: fakeGetReplicaActualSizes,
 	}
 
 	return m, nil
@@ -54,6 +56,14 @@ func fakeGetReplicaDataStores(diskType longhorn.DiskType, node *longhorn.Node, d
 	}, nil
 }
 
+func fakeGetReplicaActualSizes(diskType longhorn.DiskType, diskName, diskPath, diskDriver string, replicaDataStores map[string]string, client *DiskServiceClient) (map[string]int64, error) {
+	return map[string]int64{}, nil
+}
+
+func fakeGetDiskTier(diskType longhorn.DiskType, diskPath string, diskDriver longhorn.DiskDriver) (longhorn.DiskTier, error) {
+	return longhorn.DiskTierUnknown, nil
+}
+
 func fakeGetDiskStat(diskType longhorn.DiskType, name, directory string, diskDriver longhorn.DiskDriver, client *DiskServiceClient) (*lhtypes.DiskStat, error) {
 	switch diskType {
 	case longhorn.DiskTypeFilesystem:
@@ -51,12 +51,24 @@ type SnapshotChangeEvent struct {
 	SnapshotName string
 }
 
+// VolumeSnapshotChecksumCheckEvent requests the monitor to check the snapshot checksums of the replicas of the volume
+// for the request issued at RequestedAt
+type VolumeSnapshotChecksumCheckEvent struct {
+	VolumeName  string
+	RequestedAt string
+}
+
 type snapshotCheckTask struct {
 	volumeName   string
 	snapshotName string
 	changeEvent  bool
 }
 
+type volumeSnapshotChecksumCheckTask struct {
+	volumeName  string
+	requestedAt string
+}
+
 type SnapshotMonitorStatus struct {
 	LastSnapshotPeriodicCheckedAt metav1.Time
 }
@@ -76,6 +88,9 @@ type SnapshotMonitor struct {
 	inProgressSnapshotCheckTasks     map[string]struct{}
 	inProgressSnapshotCheckTasksLock sync.RWMutex
 
+	inProgressVolumeSnapshotChecksumCheckTasks     map[string]struct{}
+	inProgressVolumeSnapshotChecksumCheckTasksLock sync.Mutex
+
 	existingDataIntegrityCronJobs map[longhorn.DataEngineType]string
 	scheduledJobs                 map[longhorn.DataEngineType]*gocron.Job
 
@@ -106,7 +121,8 @@ func NewSnapshotMonitor(logger logrus.FieldLogger, ds *datastore.DataStore, node
 			&workqueue.TypedBucketRateLimiter[any]{Limiter: rate.NewLimiter(rate.Limit(10), 100)},
 		)),
 
-		inProgressSnapshotCheckTasks: map[string]struct{}{},
+		inProgressSnapshotCheckTasks:               map[string]struct{}{},
+		inProgressVolumeSnapshotChecksumCheckTasks: map[string]struct{}{},
 
 		existingDataIntegrityCronJobs: make(map[longhorn.DataEngineType]string),
 		scheduledJobs:                 make(map[longhorn.DataEngineType]*gocron.Job),
@@ -143,13 +159,19 @@ func (m *SnapshotMonitor) processNextEvent() bool {
 	}
 	defer m.snapshotChangeEventQueue.Done(key)
 
-	event := key.(SnapshotChangeEvent)
-
-	m.snapshotCheckTaskQueue.Add(snapshotCheckTask{
-		volumeName:   event.VolumeName,
-		snapshotName: event.SnapshotName,
-		changeEvent:  true,
-	})
+	switch event := key.(type) {
+	case SnapshotChangeEvent:
+		m.snapshotCheckTaskQueue.Add(snapshotCheckTask{
+			volumeName:   event.VolumeName,
+			snapshotName: event.SnapshotName,
+			changeEvent:  true,
+		})
+	case VolumeSnapshotChecksumCheckEvent:
+		m.snapshotCheckTaskQueue.Add(volumeSnapshotChecksumCheckTask{
+			volumeName:  event.VolumeName,
+			requestedAt: event.RequestedAt,
+		})
+	}
 
 	return true
 }
@@ -208,6 +230,12 @@ func (m *SnapshotMonitor) processNextWorkItem(id int) bool {
 	}
 	defer m.snapshotCheckTaskQueue.Done(key)
 
+	if task, ok := key.(volumeSnapshotChecksumCheckTask); ok {
+		err := m.checkVolumeSnapshotChecksums(task)
+		m.handleVolumeSnapshotChecksumCheckErr(err, task)
+		return true
+	}
+
 	task := key.(snapshotCheckTask)
 
 	dataIntegrity, err := m.ds.GetVolumeSnapshotDataIntegrity(task.volumeName)
@@ -0,0 +1,512 @@
+package monitor
+
+import (
+	"fmt"
+	"slices"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/avast/retry-go"
+	"github.com/cockroachdb/errors"
+
+	corev1 "k8s.io/api/core/v1"
+
+	etypes "github.com/longhorn/longhorn-engine/pkg/types"
+
+	"github.com/longhorn/longhorn-manager/constant"
+	"github.com/longhorn/longhorn-manager/datastore"
+	"github.com/longhorn/longhorn-manager/engineapi"
+	"github.com/longhorn/longhorn-manager/types"
+	"github.com/longhorn/longhorn-manager/util"
+
+	longhorn "github.com/longhorn/longhorn-manager/k8s/pkg/apis/longhorn/v1beta2"
+)
+
+// maxReportedDivergedRanges is the maximum number of diverged ranges of a replica snapshot reported in an event
+const maxReportedDivergedRanges = 10
+
+func (m *SnapshotMonitor) shouldAddToInProgressVolumeSnapshotChecksumCheckTasks(volumeName string) bool {
+	m.inProgressVolumeSnapshotChecksumCheckTasksLock.Lock()
+	defer m.inProgressVolumeSnapshotChecksumCheckTasksLock.Unlock()
+
+	if _, ok := m.inProgressVolumeSnapshotChecksumCheckTasks[volumeName]; ok {
+		m.logger.WithField("monitor", monitorName).Infof("Checking snapshot checksums of volume %s", volumeName)
+		return false
+	}
+	m.inProgressVolumeSnapshotChecksumCheckTasks[volumeName] = struct{}{}
+
+	return true
+}
+
+func (m *SnapshotMonitor) deleteFromInProgressVolumeSnapshotChecksumCheckTasks(volumeName string) {
+	m.inProgressVolumeSnapshotChecksumCheckTasksLock.Lock()
+	defer m.inProgressVolumeSnapshotChecksumCheckTasksLock.Unlock()
+
+	delete(m.inProgressVolumeSnapshotChecksumCheckTasks, volumeName)
+}
+
+// handleVolumeSnapshotChecksumCheckErr retries the check blocked by an ongoing rebuilding, purging, restoring or migration.
+// Otherwise, the failed check is completed, so the volume can accept a new check request.
+func (m *SnapshotMonitor) handleVolumeSnapshotChecksumCheckErr(err error, task volumeSnapshotChecksumCheckTask) {
+	if err == nil {
+		m.snapshotCheckTaskQueue.Forget(task)
+		return
+	}
+
+	if strings.Contains(err.Error(), etypes.CannotRequestHashingSnapshotPrefix) &&
+		m.snapshotCheckTaskQueue.NumRequeues(task) < snapshotHashMaxRetries {
+		m.logger.WithError(err).Warnf("Error checking snapshot checksums of volume %v", task.volumeName)
+		m.snapshotCheckTaskQueue.AddRateLimited(task)
+		return
+	}
+
+	m.logger.WithError(err).Warnf("Dropping snapshot checksum check request of volume %v", task.volumeName)
+	m.snapshotCheckTaskQueue.Forget(task)
+
+	volume, getErr := m.ds.GetVolumeRO(task.volumeName)
+	if getErr != nil {
+		m.logger.WithError(getErr).Warnf("Failed to get volume %v for the failed snapshot checksum check", task.volumeName)
+		return
+	}
+	m.eventRecorder.Eventf(volume, corev1.EventTypeWarning, constant.EventReasonFailedSnapshotChecksumCheck,
+		"Failed to check snapshot checksums of volume %v: %v", task.volumeName, err)
+	if completeErr := m.completeVolumeSnapshotChecksumCheck(task); completeErr != nil {
+		m.logger.WithError(completeErr).Warnf("Failed to complete the failed snapshot checksum check of volume %v", task.volumeName)
+	}
+}
+
+// checkVolumeSnapshotChecksums hashes the user snapshots on all the replicas of the volume, records the number of
+// snapshots whose checksum on each replica differs from the majority, and fails the diverged replicas so they are
+// rebuilt from the healthy ones. The data of the volume head is checked in a snapshot taken for the check.
+// For the v2 data engine, the clusters of the diverged snapshots are compared with a healthy replica to locate the
+// mismatched blocks. The v1 data engine only reports the checksums of the whole snapshot disk files.
+func (m *SnapshotMonitor) checkVolumeSnapshotChecksums(task volumeSnapshotChecksumCheckTask) error {
+	if !m.shouldAddToInProgressVolumeSnapshotChecksumCheckTasks(task.volumeName) {
+		return nil
+	}
+	defer m.deleteFromInProgressVolumeSnapshotChecksumCheckTasks(task.volumeName)
+
+	volume, err := m.ds.GetVolumeRO(task.volumeName)
+	if err != nil {
+		if datastore.ErrorIsNotFound(err) {
+			return nil
+		}
+		return errors.Wrapf(err, "failed to get volume %v", task.volumeName)
+	}
+	if volume.Spec.SnapshotChecksumCheckRequestedAt != task.requestedAt || volume.Status.LastSnapshotChecksumCheckCompleteAt == task.requestedAt {
+		return nil
+	}
+
+	engine, err := m.ds.GetVolumeCurrentEngine(task.volumeName)
+	if err != nil {
+		return errors.Wrapf(err, "failed to get engine for volume %v", task.volumeName)
+	}
+
+	// The replicas cannot be compared if there are less than 2 healthy replicas
+	if getNumberOfHealthyReplicas(engine) < 2 {
+		m.eventRecorder.Eventf(volume, corev1.EventTypeWarning, constant.EventReasonFailedSnapshotChecksumCheck,
+			"Skipped checking snapshot checksums of volume %v since it has less than 2 healthy replicas", task.volumeName)
+		return m.completeVolumeSnapshotChecksumCheck(task)
+	}
+
+	if err := m.canRequestSnapshotHash(engine); err != nil {
+		return errors.Wrapf(err, etypes.CannotRequestHashingSnapshotPrefix)
+	}
+
+	engineCliClient, err := engineapi.GetEngineBinaryClient(m.ds, engine.Spec.VolumeName, m.nodeName)
+	if err != nil {
+		return err
+	}
+
+	engineClientProxy, err := engineapi.GetCompatibleClient(engine, engineCliClient, m.ds, m.logger, m.proxyConnCounter)
+	if err != nil {
+		return err
+	}
+	defer engineClientProxy.Close()
+
+	// The user snapshots are collected before taking the snapshot of the volume head. The system snapshots are skipped
+	// for the same reason as the snapshot checks, since they are out of sync between the replicas during rebuilding.
+	snapshotNames := []string{}
+	for _, snapshot := range engine.Status.Snapshots {
+		if snapshot.Name == etypes.VolumeHeadName || !snapshot.UserCreated {
+			continue
+		}
+		snapshotNames = append(snapshotNames, snapshot.Name)
+	}
+	sort.Strings(snapshotNames)
+
+	// The live volume head cannot be hashed, so its data is checked in a snapshot taken on all the replicas at once
+	headSnapshotName, err := m.createSnapshotForChecksumCheck(engine, engineClientProxy)
+	if err != nil {
+		return err
+	}
+	defer m.deleteSnapshotForChecksumCheck(engine, engineClientProxy, headSnapshotName)
+	snapshotNames = append(snapshotNames, headSnapshotName)
+
+	// The mismatched snapshots and blocks are counted by the replica name, and the replica addresses in the hash status
+	// are kept for failing the diverged replicas
+	mismatchedSnapshots := map[string]int64{}
+	mismatchedBlocks := map[string]int64{}
+	for replicaName, mode := range engine.Status.ReplicaModeMap {
+		if mode == longhorn.ReplicaModeRW {
+			mismatchedSnapshots[replicaName] = 0
+			mismatchedBlocks[replicaName] = 0
+		}
+	}
+	replicaAddresses := map[string]string{}
+
+	spdkReplicaServices := map[string]*engineapi.SPDKReplicaService{}
+	defer func() {
+		for _, spdkReplicaService := range spdkReplicaServices {
+			spdkReplicaService.Close()
+		}
+	}()
+
+	checkedSnapshots := 0
+	for _, snapshotName := range snapshotNames {
+		hashStatus, err := m.hashSnapshotForChecksumCheck(engine, engineClientProxy, snapshotName)
+		if err != nil {
+			return err
+		}
+
+		existingChecksum := ""
+		if snapshotCR, err := m.ds.GetSnapshotRO(snapshotName); err == nil {
+			existingChecksum = snapshotCR.Status.Checksum
+		}
+		checksum, err := determineChecksumFromHashStatus(m.logger, snapshotName, existingChecksum, hashStatus)
+		if err != nil {
+			m.eventRecorder.Eventf(volume, corev1.EventTypeWarning, constant.EventReasonFailedSnapshotChecksumCheck,
+				"Failed to determine the majority checksum of snapshot %v for volume %v: %v", snapshotName, task.volumeName, err)
+			continue
+		}
+
+		divergedReplicaNames := []string{}
+		for _, address := range getMismatchedChecksumReplicaAddresses(checksum, hashStatus) {
+			replicaName := getEngineReplicaNameByAddress(engine, address)
+			if _, ok := mismatchedSnapshots[replicaName]; ok {
+				mismatchedSnapshots[replicaName]++
+				replicaAddresses[replicaName] = address
+				divergedReplicaNames = append(divergedReplicaNames, replicaName)
+			}
+		}
+		checkedSnapshots++
+
+		if len(divergedReplicaNames) == 0 || !types.IsDataEngineV2(engine.Spec.DataEngine) {
+			continue
+		}
+
+		referenceReplicaName := getChecksumMatchedReplicaName(engine, checksum, hashStatus)
+		if referenceReplicaName == "" {
+			m.eventRecorder.Eventf(volume, corev1.EventTypeWarning, constant.EventReasonSnapshotChecksumMismatch,
+				"Cannot locate the mismatched blocks of snapshot %v of volume %v since no replica matches the majority checksum", snapshotName, task.volumeName)
+			continue
+		}
+		mismatchedClusters, err := m.getSnapshotMismatchedClusters(engine, spdkReplicaServices, snapshotName, referenceReplicaName, divergedReplicaNames)
+		if err != nil {
+			m.eventRecorder.Eventf(volume, corev1.EventTypeWarning, constant.EventReasonFailedSnapshotChecksumCheck,
+				"Failed to locate the mismatched blocks of snapshot %v of volume %v: %v", snapshotName, task.volumeName, err)
+			continue
+		}
+		for _, replicaName := range divergedReplicaNames {
+			clusters := mismatchedClusters[replicaName]
+			mismatchedBlocks[replicaName] += int64(len(clusters))
+			m.eventRecorder.Eventf(volume, corev1.EventTypeWarning, constant.EventReasonSnapshotChecksumMismatch,
+				"Detected %v mismatched blocks of snapshot %v on replica %v of volume %v at byte ranges %v",
+				len(clusters), snapshotName, replicaName, task.volumeName, formatDivergedRanges(getDivergedRanges(clusters, engineapi.SPDKLvolClusterSize)))
+		}
+	}
+
+	divergedReplicas := 0
+	for _, count := range mismatchedSnapshots {
+		if count > 0 {
+			divergedReplicas++
+		}
+	}
+
+	for replicaName, count := range mismatchedSnapshots {
+		if err := m.updateReplicaSnapshotChecksumCheckStatus(replicaName, task.requestedAt, count, mismatchedBlocks[replicaName]); err != nil {
+			return err
+		}
+		if count == 0 {
+			continue
+		}
+
+		// Never fail all the replicas, the data still needs to be salvaged from one of them
+		if divergedReplicas == len(mismatchedSnapshots) {
+			m.eventRecorder.Eventf(volume, corev1.EventTypeWarning, constant.EventReasonSnapshotChecksumMismatch,
+				"Detected %v mismatched snapshot checksums on replica %v of volume %v, but all the replicas diverged", count, replicaName, task.volumeName)
+			continue
+		}
+		m.eventRecorder.Eventf(volume, corev1.EventTypeWarning, constant.EventReasonSnapshotChecksumMismatch,
+			"Detected %v mismatched snapshot checksums on replica %v of volume %v, failing the replica for rebuilding", count, replicaName, task.volumeName)
+		if err := engineClientProxy.ReplicaModeUpdate(engine, replicaAddresses[replicaName], string(etypes.ERR)); err != nil {
+			m.logger.WithField("monitor", monitorName).WithError(err).Errorf("Failed to update replica %v mode to ERR", replicaName)
+		}
+	}
+
+	m.eventRecorder.Eventf(volume, corev1.EventTypeNormal, constant.EventReasonSnapshotChecksumChecked,
+		"Checked the checksums of %v snapshots of volume %v, %v of %v replicas diverged", checkedSnapshots, task.volumeName, divergedReplicas, len(mismatchedSnapshots))
+
+	return m.completeVolumeSnapshotChecksumCheck(task)
+}
+
+// hashSnapshotForChecksumCheck forces rehashing the snapshot on all the replicas and waits for the hash status
+func (m *SnapshotMonitor) hashSnapshotForChecksumCheck(engine *longhorn.Engine, engineClientProxy engineapi.EngineClientProxy,
+	snapshotName string) (map[string]*longhorn.HashStatus, error) {
+	if err := engineClientProxy.SnapshotHash(engine, snapshotName, true); err != nil {
+		return nil, errors.Wrapf(err, "failed to request hashing snapshot %v", snapshotName)
+	}
+
+	opts := []retry.Option{
+		retry.Context(m.ctx),
+		retry.Attempts(snapshotHashSyncStatusAttempts),
+		retry.DelayType(retry.FixedDelay),
+		retry.LastErrorOnly(true),
+		retry.Delay(snapshotHashSyncStatusPeriod * time.Second),
+		retry.RetryIf(func(err error) bool {
+			if err == nil {
+				return false
+			}
+			return strings.Contains(err.Error(), string(engineapi.ProcessStateInProgress))
+		}),
+	}
+
+	var hashStatus map[string]*longhorn.HashStatus
+	if err := retry.Do(func() (err error) {
+		hashStatus, err = engineClientProxy.SnapshotHashStatus(engine, snapshotName)
+		if err != nil {
+			return errors.Wrapf(err, "failed to get hash status for snapshot %v", snapshotName)
+		}
+		for _, status := range hashStatus {
+			if status.State == string(longhorn.SnapshotHashStatusError) {
+				return fmt.Errorf("failed to hash snapshot %v since %v", snapshotName, status.Error)
+			}
+			if status.State == string(engineapi.ProcessStateInProgress) {
+				return errors.New(string(engineapi.ProcessStateInProgress))
+			}
+		}
+		return nil
+	}, opts...); err != nil {
+		return nil, errors.Wrapf(err, "failed to hash snapshot %v for checking checksums", snapshotName)
+	}
+
+	return hashStatus, nil
+}
+
+// createSnapshotForChecksumCheck takes a snapshot of the volume head, so the data written since the latest snapshot is
+// checked as well
+func (m *SnapshotMonitor) createSnapshotForChecksumCheck(engine *longhorn.Engine, engineClientProxy engineapi.EngineClientProxy) (string, error) {
+	freezeFilesystem, err := m.ds.GetFreezeFilesystemForSnapshotSetting(engine)
+	if err != nil {
+		return "", err
+	}
+
+	labels := map[string]string{types.GetLonghornLabelKey(types.LonghornLabelSnapshotForChecksumCheck): engine.Spec.VolumeName}
+	snapshotName, err := engineClientProxy.SnapshotCreate(engine, engine.Spec.VolumeName+"-checksum-check-"+util.RandomID(), labels, freezeFilesystem)
+	if err != nil {
+		return "", errors.Wrapf(err, "failed to take a snapshot of the volume head for checking snapshot checksums of volume %v", engine.Spec.VolumeName)
+	}
+	return snapshotName, nil
+}
+
+// deleteSnapshotForChecksumCheck deletes the snapshot taken for the check. The snapshot CR is deleted if it is already
+// created, so the snapshot controller deletes and purges the snapshot as usual.
+func (m *SnapshotMonitor) deleteSnapshotForChecksumCheck(engine *longhorn.Engine, engineClientProxy engineapi.EngineClientProxy, snapshotName string) {
+	log := m.logger.WithField("monitor", monitorName)
+
+	err := m.ds.DeleteSnapshot(snapshotName)
+	if err == nil {
+		return
+	}
+	if !datastore.ErrorIsNotFound(err) {
+		log.WithError(err).Warnf("Failed to delete snapshot %v taken for checking snapshot checksums of volume %v", snapshotName, engine.Spec.VolumeName)
+		return
+	}
+	if err := engineClientProxy.SnapshotDelete(engine, snapshotName); err != nil {
+		log.WithError(err).Warnf("Failed to delete snapshot %v taken for checking snapshot checksums of volume %v", snapshotName, engine.Spec.VolumeName)
+	}
+}
+
+// getSnapshotMismatchedClusters compares the cluster checksums of the snapshot on the diverged replicas with the ones on
+// the reference replica, and returns the indexes of the mismatched clusters by the replica name
+func (m *SnapshotMonitor) getSnapshotMismatchedClusters(engine *longhorn.Engine, spdkReplicaServices map[string]*engineapi.SPDKReplicaService,
+	snapshotName, referenceReplicaName string, divergedReplicaNames []string) (map[string][]uint64, error) {
+	getSPDKReplicaService := func(replicaName string) (*engineapi.SPDKReplicaService, error) {
+		replica, err := m.ds.GetReplicaRO(replicaName)
+		if err != nil {
+			return nil, errors.Wrapf(err, "failed to get replica %v", replicaName)
+		}
+		if spdkReplicaService, ok := spdkReplicaServices[replica.Status.InstanceManagerName]; ok {
+			return spdkReplicaService, nil
+		}
+		im, err := m.ds.GetInstanceManagerRO(replica.Status.InstanceManagerName)
+		if err != nil {
+			return nil, errors.Wrapf(err, "failed to get instance manager of replica %v", replicaName)
+		}
+		spdkReplicaService, err := engineapi.NewSPDKReplicaService(im, m.logger)
+		if err != nil {
+			return nil, err
+		}
+		spdkReplicaServices[im.Name] = spdkReplicaService
+		return spdkReplicaService, nil
+	}
+
+	replicaNames := append([]string{referenceReplicaName}, divergedReplicaNames...)
+	services := map[string]*engineapi.SPDKReplicaService{}
+	for _, replicaName := range replicaNames {
+		spdkReplicaService, err := getSPDKReplicaService(replicaName)
+		if err != nil {
+			return nil, err
+		}
+		services[replicaName] = spdkReplicaService
+	}
+
+	mismatchedClusters := map[string][]uint64{}
+	clusterCount := uint64(engine.Spec.VolumeSize / engineapi.SPDKLvolClusterSize)
+	for start := uint64(0); start < clusterCount; start += engineapi.SPDKSnapshotRangeHashMaxClusterCount {
+		count := min(uint64(engineapi.SPDKSnapshotRangeHashMaxClusterCount), clusterCount-start)
+
+		referenceChecksums, err := services[referenceReplicaName].SnapshotRangeHashGet(referenceReplicaName, snapshotName, start, count)
+		if err != nil {
+			return nil, err
+		}
+		for _, replicaName := range divergedReplicaNames {
+			checksums, err := services[replicaName].SnapshotRangeHashGet(replicaName, snapshotName, start, count)
+			if err != nil {
+				return nil, err
+			}
+			mismatchedClusters[replicaName] = append(mismatchedClusters[replicaName], getMismatchedClusters(referenceChecksums, checksums)...)
+		}
+	}
+	return mismatchedClusters, nil
+}
+
+func (m *SnapshotMonitor) updateReplicaSnapshotChecksumCheckStatus(replicaName, requestedAt string, mismatchedSnapshots, mismatchedBlocks int64) error {
+	_, err := util.RetryOnConflictCause(func() (interface{}, error) {
+		replica, err := m.ds.GetReplica(replicaName)
+		if err != nil {
+			return nil, err
+		}
+		replica.Status.LastSnapshotChecksumCheckedAt = requestedAt
+		replica.Status.MismatchedSnapshotChecksums = mismatchedSnapshots
+		replica.Status.MismatchedBlocks = mismatchedBlocks
+		return m.ds.UpdateReplicaStatus(replica)
+	})
+	if err != nil && !datastore.ErrorIsNotFound(err) {
+		return errors.Wrapf(err, "failed to update snapshot checksum check status of replica %v", replicaName)
+	}
+	return nil
+}
+
+// completeVolumeSnapshotChecksumCheck acknowledges the check request, so the volume can accept a new one
+func (m *SnapshotMonitor) completeVolumeSnapshotChecksumCheck(task volumeSnapshotChecksumCheckTask) error {
+	_, err := util.RetryOnConflictCause(func() (interface{}, error) {
+		volume, err := m.ds.GetVolume(task.volumeName)
+		if err != nil {
+			return nil, err
+		}
+		if volume.Spec.SnapshotChecksumCheckRequestedAt != task.requestedAt {
+			return volume, nil
+		}
+		volume.Status.LastSnapshotChecksumCheckCompleteAt = task.requestedAt
+		return m.ds.UpdateVolumeStatus(volume)
+	})
+	if err != nil && !datastore.ErrorIsNotFound(err) {
+		return errors.Wrapf(err, "failed to complete snapshot checksum check of volume %v", task.volumeName)
+	}
+	return nil
+}
+
+// getEngineReplicaNameByAddress returns the name of the replica of the address in the snapshot hash status, which
+// may carry the backend replica URL scheme
+func getEngineReplicaNameByAddress(engine *longhorn.Engine, address string) string {
+	for replicaName, replicaAddress := range engine.Status.CurrentReplicaAddressMap {
+		if replicaAddress == engineapi.GetAddressFromBackendReplicaURL(address) {
+			return replicaName
+		}
+	}
+	return ""
+}
+
+// getMismatchedChecksumReplicaAddresses returns the addresses of the replicas whose snapshot checksums diverge
+// from the majority checksum
+func getMismatchedChecksumReplicaAddresses(checksum string, hashStatus map[string]*longhorn.HashStatus) []string {
+	addresses := []string{}
+	for address, status := range hashStatus {
+		if status.SilentlyCorrupted || status.Checksum != checksum {
+			addresses = append(addresses, address)
+		}
+	}
+	sort.Strings(addresses)
+	return addresses
+}
+
+// getChecksumMatchedReplicaName returns the name of a replica whose snapshot checksum matches the majority checksum
+func getChecksumMatchedReplicaName(engine *longhorn.Engine, checksum string, hashStatus map[string]*longhorn.HashStatus) string {
+	addresses := []string{}
+	for address, status := range hashStatus {
+		if !status.SilentlyCorrupted && status.Checksum == checksum {
+			addresses = append(addresses, address)
+		}
+	}
+	sort.Strings(addresses)
+
+	for _, address := range addresses {
+		if replicaName := getEngineReplicaNameByAddress(engine, address); replicaName != "" {
+			return replicaName
+		}
+	}
+	return ""
+}
+
+// getMismatchedClusters returns the sorted indexes of the clusters whose checksums differ from the reference ones.
+// A cluster missing on only one side is mismatched as well.
+func getMismatchedClusters(referenceChecksums, checksums map[uint64]uint64) []uint64 {
+	clusters := []uint64{}
+	for index, referenceChecksum := range referenceChecksums {
+		if checksum, ok := checksums[index]; !ok || checksum != referenceChecksum {
+			clusters = append(clusters, index)
+		}
+	}
+	for index := range checksums {
+		if _, ok := referenceChecksums[index]; !ok {
+			clusters = append(clusters, index)
+		}
+	}
+	slices.Sort(clusters)
+	return clusters
+}
+
+// divergedRange is the byte range [Offset, Offset+Length) of the mismatched data of a replica
+type divergedRange struct {
+	Offset int64
+	Length int64
+}
+
+// getDivergedRanges merges the sorted indexes of the mismatched clusters into the byte ranges
+func getDivergedRanges(clusters []uint64, clusterSize int64) []divergedRange {
+	ranges := []divergedRange{}
+	for _, index := range clusters {
+		offset := int64(index) * clusterSize
+		if last := len(ranges) - 1; last >= 0 && ranges[last].Offset+ranges[last].Length == offset {
+			ranges[last].Length += clusterSize
+			continue
+		}
+		ranges = append(ranges, divergedRange{Offset: offset, Length: clusterSize})
+	}
+	return ranges
+}
+
+// formatDivergedRanges formats the first few diverged ranges for the events
+func formatDivergedRanges(ranges []divergedRange) string {
+	formatted := []string{}
+	for i, r := range ranges {
+		if i == maxReportedDivergedRanges {
+			formatted = append(formatted, fmt.Sprintf("and %v more", len(ranges)-i))
+			break
+		}
+		formatted = append(formatted, fmt.Sprintf("[%v, %v)", r.Offset, r.Offset+r.Length))
+	}
+	return strings.Join(formatted, ", ")
+}
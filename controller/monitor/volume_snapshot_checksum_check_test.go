@@ -0,0 +1,92 @@
+package monitor
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	longhorn "github.com/longhorn/longhorn-manager/k8s/pkg/apis/longhorn/v1beta2"
+)
+
+func TestGetMismatchedChecksumReplicaAddresses(t *testing.T) {
+	assert := require.New(t)
+
+	hashStatus := map[string]*longhorn.HashStatus{
+		"tcp://10.0.0.1:10000": {State: "Completed", Checksum: "abc"},
+		"tcp://10.0.0.2:10000": {State: "Completed", Checksum: "abc"},
+		"tcp://10.0.0.3:10000": {State: "Completed", Checksum: "cde"},
+		"tcp://10.0.0.4:10000": {State: "Completed", Checksum: "abc", SilentlyCorrupted: true},
+	}
+
+	assert.Equal([]string{"tcp://10.0.0.3:10000", "tcp://10.0.0.4:10000"},
+		getMismatchedChecksumReplicaAddresses("abc", hashStatus))
+	assert.Empty(getMismatchedChecksumReplicaAddresses("abc", map[string]*longhorn.HashStatus{}))
+
+	engine := &longhorn.Engine{
+		Status: longhorn.EngineStatus{
+			CurrentReplicaAddressMap: map[string]string{
+				"replica-a": "10.0.0.1:10000",
+				"replica-b": "10.0.0.3:10000",
+			},
+		},
+	}
+	assert.Equal("replica-b", getEngineReplicaNameByAddress(engine, "tcp://10.0.0.3:10000"))
+	assert.Equal("replica-b", getEngineReplicaNameByAddress(engine, "10.0.0.3:10000"))
+	assert.Equal("", getEngineReplicaNameByAddress(engine, "tcp://10.0.0.5:10000"))
+}
+
+func TestGetMismatchedClusters(t *testing.T) {
+	assert := require.New(t)
+
+	reference := map[uint64]uint64{0: 11, 1: 12, 2: 13, 5: 16}
+	checksums := map[uint64]uint64{0: 11, 1: 99, 2: 13, 3: 14}
+
+	assert.Equal([]uint64{1, 3, 5}, getMismatchedClusters(reference, checksums))
+	assert.Empty(getMismatchedClusters(reference, reference))
+	assert.Empty(getMismatchedClusters(map[uint64]uint64{}, map[uint64]uint64{}))
+}
+
+func TestGetDivergedRanges(t *testing.T) {
+	assert := require.New(t)
+
+	ranges := getDivergedRanges([]uint64{1, 2, 3, 7, 9, 10}, 1024)
+	assert.Equal([]divergedRange{
+		{Offset: 1024, Length: 3072},
+		{Offset: 7168, Length: 1024},
+		{Offset: 9216, Length: 2048},
+	}, ranges)
+	assert.Equal("[1024, 4096), [7168, 8192), [9216, 11264)", formatDivergedRanges(ranges))
+	assert.Empty(getDivergedRanges([]uint64{}, 1024))
+
+	clusters := []uint64{}
+	for i := uint64(0); i < maxReportedDivergedRanges+2; i++ {
+		clusters = append(clusters, i*2)
+	}
+	formatted := formatDivergedRanges(getDivergedRanges(clusters, 1))
+	assert.True(strings.HasPrefix(formatted, "[0, 1), [2, 3)"))
+	assert.True(strings.HasSuffix(formatted, "[18, 19), and 2 more"))
+}
+
+func TestGetChecksumMatchedReplicaName(t *testing.T) {
+	assert := require.New(t)
+
+	engine := &longhorn.Engine{
+		Status: longhorn.EngineStatus{
+			CurrentReplicaAddressMap: map[string]string{
+				"replica-a": "10.0.0.1:10000",
+				"replica-b": "10.0.0.2:10000",
+				"replica-c": "10.0.0.3:10000",
+			},
+		},
+	}
+	hashStatus := map[string]*longhorn.HashStatus{
+		"tcp://10.0.0.1:10000": {State: "Completed", Checksum: "abc", SilentlyCorrupted: true},
+		"tcp://10.0.0.2:10000": {State: "Completed", Checksum: "abc"},
+		"tcp://10.0.0.3:10000": {State: "Completed", Checksum: "cde"},
+	}
+
+	assert.Equal("replica-b", getChecksumMatchedReplicaName(engine, "abc", hashStatus))
+	assert.Equal("replica-c", getChecksumMatchedReplicaName(engine, "cde", hashStatus))
+	assert.Equal("", getChecksumMatchedReplicaName(engine, "xyz", hashStatus))
+}
@@ -3,6 +3,7 @@ package controller
 import (
 	"fmt"
 	"reflect"
+	"sort"
 	"strings"
 	"sync"
 	"time"
@@ -65,6 +66,8 @@ type NodeController struct {
 	snapshotChangeEventQueue     workqueue.TypedInterface[any]
 	snapshotChangeEventQueueLock sync.Mutex
 
+	diskUsageHistory *diskUsageHistory
+
 	ds *datastore.DataStore
 
 	cacheSyncs []cache.InformerSynced
@@ -103,6 +106,8 @@ func NewNodeController(
 		topologyLabelsChecker: util.IsKubernetesVersionAtLeast,
 
 		snapshotChangeEventQueue: workqueue.NewTyped[any](),
+
+		diskUsageHistory: newDiskUsageHistory(),
 	}
 
 	nc.scheduler = scheduler.NewReplicaScheduler(ds)
@@ -187,6 +192,16 @@ func NewNodeController(
 		}, 0); err != nil {
 		return nil, err
 	}
+	if _, err = ds.VolumeInformer.AddEventHandlerWithResyncPeriod(
+		cache.FilteringResourceEventHandler{
+			FilterFunc: nc.isResponsibleForVolumeSnapshotChecksumCheck,
+			Handler: cache.ResourceEventHandlerFuncs{
+				AddFunc:    nc.enqueueVolumeSnapshotChecksumCheck,
+				UpdateFunc: func(old, cur interface{}) { nc.enqueueVolumeSnapshotChecksumCheck(cur) },
+			},
+		}, 0); err != nil {
+		return nil, err
+	}
 	nc.cacheSyncs = append(nc.cacheSyncs, ds.VolumeInformer.HasSynced)
 
 	return nc, nil
@@ -208,6 +223,7 @@ func (nc *NodeController) isResponsibleForSetting(obj interface{}) bool {
 	}
 
 	return types.SettingName(setting.Name) == types.SettingNameStorageMinimalAvailablePercentage ||
+		types.SettingName(setting.Name) == types.SettingNameDiskTimeToFullWarningThreshold ||
 		types.SettingName(setting.Name) == types.SettingNameBackingImageCleanupWaitInterval ||
 		types.SettingName(setting.Name) == types.SettingNameOrphanResourceAutoDeletion ||
 		types.SettingName(setting.Name) == types.SettingNameNodeDrainPolicy
@@ -276,6 +292,19 @@ func (nc *NodeController) isResponsibleForSnapshotHashing(obj interface{}) bool
 	return dataIntegrity != longhorn.SnapshotDataIntegrityDisabled
 }
 
+func (nc *NodeController) isResponsibleForVolumeSnapshotChecksumCheck(obj interface{}) bool {
+	volume, ok := obj.(*longhorn.Volume)
+	if !ok {
+		return false
+	}
+
+	if volume.Status.OwnerID != nc.controllerID {
+		return false
+	}
+
+	return volume.Spec.SnapshotChecksumCheckRequestedAt != "" && volume.Spec.SnapshotChecksumCheckRequestedAt != volume.Status.LastSnapshotChecksumCheckCompleteAt
+}
+
 func (nc *NodeController) snapshotHashRequired(volume *longhorn.Volume) bool {
 	dataIntegrityImmediateChecking, err := nc.ds.GetSettingAsBoolByDataEngine(types.SettingNameSnapshotDataIntegrityImmediateCheckAfterSnapshotCreation, volume.Spec.DataEngine)
 	if err != nil {
@@ -500,6 +529,10 @@ func (nc *NodeController) syncNode(key string) (err error) {
 		return err
 	}
 
+	if err := nc.syncDiskUsageForecast(node, collectedDiskInfo); err != nil {
+		return err
+	}
+
 	collectedEnvironmentCheckConditions, err := nc.syncWithEnvironmentCheckMonitor()
 	if err == nil {
 		// Best effort to update the environment check conditions
@@ -550,6 +583,10 @@ func (nc *NodeController) syncNode(key string) (err error) {
 		return err
 	}
 
+	if err := nc.syncNodeMaintenance(node); err != nil {
+		return err
+	}
+
 	return nil
 }
 
@@ -662,6 +699,26 @@ func (nc *NodeController) enqueueSnapshotHashEvent(currentSnapshot *longhorn.Sna
 	}
 }
 
+func (nc *NodeController) enqueueVolumeSnapshotChecksumCheck(obj interface{}) {
+	volume, ok := obj.(*longhorn.Volume)
+	if !ok {
+		nc.logger.Warnf("Failed to convert object to Volume: %v", obj)
+		return
+	}
+
+	nc.snapshotChangeEventQueueLock.Lock()
+	defer nc.snapshotChangeEventQueueLock.Unlock()
+
+	if nc.snapshotChangeEventQueue.Len() < snapshotChangeEventQueueMax {
+		nc.snapshotChangeEventQueue.Add(monitor.VolumeSnapshotChecksumCheckEvent{
+			VolumeName:  volume.Name,
+			RequestedAt: volume.Spec.SnapshotChecksumCheckRequestedAt,
+		})
+	} else {
+		nc.logger.Warnf("Dropped the snapshot checksum check event of volume %v since snapshotChangeEventQueue is full", volume.Name)
+	}
+}
+
 func (nc *NodeController) enqueueManagerPod(obj interface{}) {
 	nodes, err := nc.ds.ListNodesRO()
 	if err != nil {
@@ -879,6 +936,7 @@ func (nc *NodeController) updateReadyDiskStatusReadyCondition(node *longhorn.Nod
 			diskStatus.StorageAvailable = usableStorage
 			diskStatus.StorageMaximum = diskInfoMap[diskName].DiskStat.StorageMaximum
 			diskStatus.InstanceManagerName = diskInfoMap[diskName].InstanceManagerName
+			diskStatus.Tier = diskInfoMap[diskName].Tier
 
 			if monitorDiskHealth {
 				diskStatus.HealthData = info.HealthData
@@ -1949,6 +2007,17 @@ func (nc *NodeController) shouldEvictReplica(node *longhorn.Node, kubeNode *core
 	if node.Spec.EvictionRequested || diskSpec.EvictionRequested {
 		return true, constant.EventReasonEvictionUserRequested, nil
 	}
+	if node.Spec.Maintenance {
+		// Only the replicas that are the last healthy copy of their volumes have to be moved before the node reboots.
+		hasPDBOnAnotherNode, err := nc.hasPDBProtectedHealthyReplicaOnAnotherNode(replica)
+		if err != nil {
+			return false, "", err
+		}
+		if !hasPDBOnAnotherNode {
+			return true, constant.EventReasonEvictionMaintenance, nil
+		}
+		return false, constant.EventReasonEvictionCanceled, nil
+	}
 	if !kubeNode.Spec.Unschedulable {
 		// Node drain policy only takes effect on cordoned nodes.
 		return false, constant.EventReasonEvictionCanceled, nil
@@ -1960,22 +2029,147 @@ func (nc *NodeController) shouldEvictReplica(node *longhorn.Node, kubeNode *core
 		return false, constant.EventReasonEvictionCanceled, nil
 	}
 
-	pdbProtectedHealthyReplicas, err := nc.ds.ListVolumePDBProtectedHealthyReplicasRO(replica.Spec.VolumeName)
+	hasPDBOnAnotherNode, err := nc.hasPDBProtectedHealthyReplicaOnAnotherNode(replica)
 	if err != nil {
 		return false, "", err
 	}
-	hasPDBOnAnotherNode := false
+	if !hasPDBOnAnotherNode {
+		return true, constant.EventReasonEvictionAutomatic, nil
+	}
+
+	return false, constant.EventReasonEvictionCanceled, nil
+}
+
+// hasPDBProtectedHealthyReplicaOnAnotherNode returns true if the volume of the replica still has a healthy copy
+// on another node after the node of the replica goes down.
+func (nc *NodeController) hasPDBProtectedHealthyReplicaOnAnotherNode(replica *longhorn.Replica) (bool, error) {
+	pdbProtectedHealthyReplicas, err := nc.ds.ListVolumePDBProtectedHealthyReplicasRO(replica.Spec.VolumeName)
+	if err != nil {
+		return false, err
+	}
 	for _, pdbProtectedHealthyReplica := range pdbProtectedHealthyReplicas {
 		if pdbProtectedHealthyReplica.Spec.NodeID != replica.Spec.NodeID {
-			hasPDBOnAnotherNode = true
-			break
+			return true, nil
 		}
 	}
-	if !hasPDBOnAnotherNode {
-		return true, constant.EventReasonEvictionAutomatic, nil
+	return false, nil
+}
+
+// syncNodeMaintenance drives the maintenance of the node. Scheduling is stopped by the Schedulable condition and
+// the last healthy replicas are evicted by syncReplicaEvictionRequested, then this waits for the evictions and the
+// volumes with replicas on the node to be healthy before marking the node safe for reboot.
+func (nc *NodeController) syncNodeMaintenance(node *longhorn.Node) error {
+	log := getLoggerForNode(nc.logger, node)
+
+	if !node.Spec.Maintenance {
+		if node.Status.Maintenance.State != longhorn.NodeMaintenanceStateNone {
+			log.Info("Node exited maintenance")
+			nc.eventRecorder.Eventf(node, corev1.EventTypeNormal, constant.EventReasonMaintenanceExited,
+				"Node %v exited maintenance, replica scheduling is restored", node.Name)
+		}
+		node.Status.Maintenance = longhorn.NodeMaintenanceStatus{}
+		return nil
 	}
 
-	return false, constant.EventReasonEvictionCanceled, nil
+	evictingReplicas := 0
+	unhealthyVolumeSet := map[string]struct{}{}
+	for _, diskStatus := range node.Status.DiskStatus {
+		for replicaName := range diskStatus.ScheduledReplica {
+			replica, err := nc.ds.GetReplicaRO(replicaName)
+			if err != nil {
+				if datastore.ErrorIsNotFound(err) {
+					continue
+				}
+				return err
+			}
+			if replica.Spec.EvictionRequested {
+				evictingReplicas++
+			}
+
+			volume, err := nc.ds.GetVolumeRO(replica.Spec.VolumeName)
+			if err != nil {
+				if datastore.ErrorIsNotFound(err) {
+					continue
+				}
+				return err
+			}
+			if volume.Status.Robustness == longhorn.VolumeRobustnessDegraded ||
+				volume.Status.Robustness == longhorn.VolumeRobustnessFaulted {
+				unhealthyVolumeSet[volume.Name] = struct{}{}
+			}
+		}
+	}
+	unhealthyVolumes := []string{}
+	for volumeName := range unhealthyVolumeSet {
+		unhealthyVolumes = append(unhealthyVolumes, volumeName)
+	}
+	sort.Strings(unhealthyVolumes)
+
+	previousState := node.Status.Maintenance.State
+	updateNodeMaintenanceStatus(&node.Status.Maintenance, evictingReplicas, unhealthyVolumes, time.Now().UTC())
+	if previousState == node.Status.Maintenance.State {
+		return nil
+	}
+
+	log.Infof("Node maintenance state changed from %q to %q", previousState, node.Status.Maintenance.State)
+	if previousState == longhorn.NodeMaintenanceStateNone {
+		nc.eventRecorder.Eventf(node, corev1.EventTypeNormal, constant.EventReasonMaintenanceStarted,
+			"Node %v entered maintenance, replica scheduling is stopped", node.Name)
+	}
+	if node.Status.Maintenance.State == longhorn.NodeMaintenanceStateSafeForReboot {
+		nc.eventRecorder.Eventf(node, corev1.EventTypeNormal, constant.EventReasonMaintenanceSafeForReboot,
+			"Node %v is safe for reboot", node.Name)
+	}
+	return nil
+}
+
+// updateNodeMaintenanceStatus moves the maintenance state machine with the pending replica evictions and unhealthy
+// volumes, and estimates the completion time by the rate of the finished tasks since the maintenance started.
+func updateNodeMaintenanceStatus(status *longhorn.NodeMaintenanceStatus, evictingReplicas int, unhealthyVolumes []string, now time.Time) {
+	if status.State == longhorn.NodeMaintenanceStateNone || status.StartedAt == "" {
+		status.StartedAt = now.Format(time.RFC3339)
+		status.TotalTasks = 0
+	}
+
+	pendingTasks := evictingReplicas + len(unhealthyVolumes)
+	if pendingTasks > status.TotalTasks {
+		status.TotalTasks = pendingTasks
+	}
+	status.EvictingReplicas = evictingReplicas
+	status.UnhealthyVolumes = unhealthyVolumes
+
+	switch {
+	case evictingReplicas > 0:
+		status.State = longhorn.NodeMaintenanceStateEvicting
+		status.Message = fmt.Sprintf("Evicting %v replicas that are the last healthy copy of their volumes", evictingReplicas)
+	case len(unhealthyVolumes) > 0:
+		status.State = longhorn.NodeMaintenanceStateWaitingForHealthyVolumes
+		status.Message = fmt.Sprintf("Waiting for volumes %v to become healthy", strings.Join(unhealthyVolumes, ", "))
+	default:
+		status.State = longhorn.NodeMaintenanceStateSafeForReboot
+		status.Message = "Node is safe for reboot"
+		status.Progress = 100
+		status.EstimatedCompletionAt = ""
+		if status.CompletedAt == "" {
+			status.CompletedAt = now.Format(time.RFC3339)
+		}
+		return
+	}
+
+	status.CompletedAt = ""
+	finishedTasks := status.TotalTasks - pendingTasks
+	status.Progress = finishedTasks * 100 / status.TotalTasks
+	status.EstimatedCompletionAt = ""
+	if finishedTasks == 0 {
+		return
+	}
+	startedAt, err := time.Parse(time.RFC3339, status.StartedAt)
+	if err != nil {
+		return
+	}
+	elapsed := now.Sub(startedAt)
+	remaining := time.Duration(int64(elapsed) * int64(pendingTasks) / int64(finishedTasks))
+	status.EstimatedCompletionAt = now.Add(remaining).Format(time.RFC3339)
 }
 
 func isNodeOrDisksEvictionRequested(node *longhorn.Node) bool {
@@ -2129,7 +2323,16 @@ func (nc *NodeController) setReadyConditionForKubeNode(node *longhorn.Node, kube
 func (nc *NodeController) SetSchedulableCondition(node *longhorn.Node, kubeNode *corev1.Node,
 	disableSchedulingOnCordonedNode bool) {
 	kubeSpec := kubeNode.Spec
-	if disableSchedulingOnCordonedNode &&
+	if node.Spec.Maintenance {
+		node.Status.Conditions =
+			types.SetConditionAndRecord(node.Status.Conditions,
+				longhorn.NodeConditionTypeSchedulable,
+				longhorn.ConditionStatusFalse,
+				string(longhorn.NodeConditionReasonNodeMaintenance),
+				fmt.Sprintf("Node %v is in maintenance", node.Name),
+				nc.eventRecorder, node,
+				corev1.EventTypeNormal)
+	} else if disableSchedulingOnCordonedNode &&
 		kubeSpec.Unschedulable {
 		node.Status.Conditions =
 			types.SetConditionAndRecord(node.Status.Conditions,
@@ -5,6 +5,7 @@ import (
 	"fmt"
 	"os"
 	"strings"
+	"time"
 
 	"github.com/sirupsen/logrus"
 	. "gopkg.in/check.v1"
@@ -2289,6 +2290,81 @@ func (s *NodeControllerSuite) TestShouldConsiderOnDemandRequest(c *C) {
 	}
 }
 
+func (s *NodeControllerSuite) TestUpdateNodeMaintenanceStatus(c *C) {
+	startedAt := time.Date(2026, 3, 9, 9, 0, 0, 0, time.UTC)
+
+	status := &longhorn.NodeMaintenanceStatus{}
+	updateNodeMaintenanceStatus(status, 3, []string{"vol1"}, startedAt)
+	c.Assert(status.State, Equals, longhorn.NodeMaintenanceStateEvicting)
+	c.Assert(status.StartedAt, Equals, "2026-03-09T09:00:00Z")
+	c.Assert(status.TotalTasks, Equals, 4)
+	c.Assert(status.Progress, Equals, 0)
+	c.Assert(status.EstimatedCompletionAt, Equals, "")
+
+	// Half of the tasks are finished in 10 minutes, so the rest are expected to finish in another 10 minutes
+	updateNodeMaintenanceStatus(status, 1, []string{"vol1"}, startedAt.Add(10*time.Minute))
+	c.Assert(status.State, Equals, longhorn.NodeMaintenanceStateEvicting)
+	c.Assert(status.TotalTasks, Equals, 4)
+	c.Assert(status.Progress, Equals, 50)
+	c.Assert(status.EstimatedCompletionAt, Equals, "2026-03-09T09:20:00Z")
+
+	updateNodeMaintenanceStatus(status, 0, []string{"vol1"}, startedAt.Add(15*time.Minute))
+	c.Assert(status.State, Equals, longhorn.NodeMaintenanceStateWaitingForHealthyVolumes)
+	c.Assert(status.Progress, Equals, 75)
+	c.Assert(status.Message, Matches, ".*vol1.*")
+
+	updateNodeMaintenanceStatus(status, 0, []string{}, startedAt.Add(20*time.Minute))
+	c.Assert(status.State, Equals, longhorn.NodeMaintenanceStateSafeForReboot)
+	c.Assert(status.Progress, Equals, 100)
+	c.Assert(status.CompletedAt, Equals, "2026-03-09T09:20:00Z")
+	c.Assert(status.EstimatedCompletionAt, Equals, "")
+}
+
+func (s *NodeControllerSuite) TestDiskUsageForecast(c *C) {
+	startedAt := time.Date(2026, 3, 9, 9, 0, 0, 0, time.UTC)
+	history := newDiskUsageHistory()
+
+	// Samples within the sample interval are not recorded
+	samples := history.record("disk-uuid", diskUsageSample{timestamp: startedAt, storageUsage: 100 * util.GiB})
+	c.Assert(samples, HasLen, 1)
+	samples = history.record("disk-uuid", diskUsageSample{timestamp: startedAt.Add(time.Minute), storageUsage: 200 * util.GiB})
+	c.Assert(samples, HasLen, 1)
+
+	_, _, ok := getDiskUsageGrowthRates(samples)
+	c.Assert(ok, Equals, false)
+
+	// The usage grows 10 util.GiB and the replicas grow 5 util.GiB per hour
+	for i := 1; i <= 8; i++ {
+		samples = history.record("disk-uuid", diskUsageSample{
+			timestamp:         startedAt.Add(time.Duration(i) * 15 * time.Minute),
+			storageUsage:      100*util.GiB + int64(i)*10*util.GiB/4,
+			replicaActualSize: int64(i) * 5 * util.GiB / 4,
+		})
+	}
+	c.Assert(samples, HasLen, 9)
+
+	usageGrowthRate, replicaActualSizeGrowthRate, ok := getDiskUsageGrowthRates(samples)
+	c.Assert(ok, Equals, true)
+	c.Assert(int64(usageGrowthRate), Equals, int64(10*util.GiB))
+	c.Assert(int64(replicaActualSizeGrowthRate), Equals, int64(5*util.GiB))
+
+	// 120 util.GiB is used out of 200 util.GiB, so 30 util.GiB are left above the 25% minimal available storage
+	latestSample := samples[len(samples)-1]
+	projectedFullAt, projected := getDiskProjectedFullTime(latestSample, 200*util.GiB, 25, usageGrowthRate)
+	c.Assert(projected, Equals, true)
+	c.Assert(projectedFullAt.Sub(latestSample.timestamp).Round(time.Minute), Equals, 3*time.Hour)
+
+	_, projected = getDiskProjectedFullTime(latestSample, 200*util.GiB, 25, 0)
+	c.Assert(projected, Equals, false)
+
+	// Samples out of the history window are dropped
+	samples = history.record("disk-uuid", diskUsageSample{timestamp: startedAt.Add(diskUsageHistoryWindow + 30*time.Minute)})
+	c.Assert(samples, HasLen, 8)
+
+	history.prune(map[string]struct{}{})
+	c.Assert(history.samples, HasLen, 0)
+}
+
 // -- Helpers --
 
 func (s *NodeControllerSuite) checkNodeConditions(c *C, expectation *NodeControllerExpectation, node *longhorn.Node) {
@@ -52,6 +52,8 @@ type SnapshotController struct {
 	engineClientCollection engineapi.EngineClientCollection
 
 	proxyConnCounter util.Counter
+
+	hookRunner *snapshotHookRunner
 }
 
 func NewSnapshotController(
@@ -64,6 +66,7 @@ func NewSnapshotController(
 	engineClientCollection engineapi.EngineClientCollection,
 	proxyConnCounter util.Counter,
 	snapshotConcurrentLimiter *SnapshotConcurrentLimiter,
+	snapshotHookExecutor SnapshotHookExecutor,
 ) (*SnapshotController, error) {
 	eventBroadcaster := record.NewBroadcaster()
 	eventBroadcaster.StartLogging(logrus.Infof)
@@ -84,6 +87,7 @@ func NewSnapshotController(
 		engineClientCollection: engineClientCollection,
 		proxyConnCounter:       proxyConnCounter,
 	}
+	sc.hookRunner = newSnapshotHookRunner(ds, snapshotHookExecutor, sc.eventRecorder, sc.logger)
 
 	var err error
 	if _, err = ds.SnapshotInformer.AddEventHandlerWithResyncPeriod(cache.ResourceEventHandlerFuncs{
@@ -550,6 +554,15 @@ func (sc *SnapshotController) reconcile(snapshotName string) (err error) {
 
 	// Newly created snapshot CR by user
 	if requestCreateNewSnapshot && !alreadyCreatedBefore && !snapshotExistInEngine {
+		// A failed pre snapshot hook with the Fail policy is not retried, since the workload may not be in a state
+		// to be snapshotted. The snapshot has to be recreated instead.
+		if result := getAbortingSnapshotHookResult(snapshot.Status.HookResults); result != nil {
+			snapshot.Status.Error = fmt.Sprintf("snapshot creation aborted by the failed pre snapshot hook in container %v of pod %v/%v: %v",
+				result.Container, result.PodNamespace, result.PodName, result.Error)
+			snapshot.Status.ReadyToUse = false
+			return sc.handleAttachmentTicketDeletion(snapshot)
+		}
+
 		if err := sc.handleAttachmentTicketCreation(snapshot, false); err != nil {
 			return err
 		}
@@ -763,8 +776,33 @@ func (sc *SnapshotController) handleSnapshotCreate(snapshot *longhorn.Snapshot,
 		return err
 	}
 	if snapshotInfo == nil {
+		// The hook results are recorded per creation attempt
+		snapshot.Status.HookResults = nil
+
+		if err := sc.runSnapshotHooks(snapshot, longhorn.SnapshotHookTypePre); err != nil {
+			// Always give the workloads a chance to resume, e.g. unfreeze the application
+			if postErr := sc.runSnapshotHooks(snapshot, longhorn.SnapshotHookTypePost); postErr != nil {
+				sc.logger.WithError(postErr).Warnf("Failed to execute post snapshot hooks of snapshot %v after pre snapshot hook failure", snapshot.Name)
+			}
+			if getAbortingSnapshotHookResult(snapshot.Status.HookResults) == nil {
+				return err
+			}
+			// The failure recorded in the hook results stops the creation from being retried
+			sc.logger.WithError(err).Warnf("Aborted creating snapshot %v", snapshot.Name)
+			snapshot.Status.Error = err.Error()
+			snapshot.Status.ReadyToUse = false
+			return nil
+		}
+
 		sc.logger.Infof("Creating snapshot %v of volume %v", snapshot.Name, snapshot.Spec.Volume)
 		_, err = engineClientProxy.SnapshotCreate(engine, snapshot.Name, snapshot.Spec.Labels, freezeFilesystem)
+		if postErr := sc.runSnapshotHooks(snapshot, longhorn.SnapshotHookTypePost); postErr != nil {
+			if err != nil {
+				sc.logger.WithError(postErr).Warnf("Failed to execute post snapshot hooks of snapshot %v after snapshot creation failure", snapshot.Name)
+				return err
+			}
+			return postErr
+		}
 		if err != nil {
 			return err
 		}
@@ -772,6 +810,18 @@ func (sc *SnapshotController) handleSnapshotCreate(snapshot *longhorn.Snapshot,
 	return nil
 }
 
+// runSnapshotHooks executes the hooks of the given type for the snapshot and records the results in the snapshot status.
+// The hooks of a volume group snapshot member are executed by the volume group snapshot controller for the whole group instead.
+func (sc *SnapshotController) runSnapshotHooks(snapshot *longhorn.Snapshot, hookType longhorn.SnapshotHookType) error {
+	if isVolumeGroupSnapshotMember(snapshot) {
+		return nil
+	}
+
+	results, err := sc.hookRunner.run(snapshot, []string{snapshot.Spec.Volume}, hookType)
+	snapshot.Status.HookResults = append(snapshot.Status.HookResults, results...)
+	return err
+}
+
 // handleSnapshotDeletion reaches out to engine process to check and delete the snapshot
 func (sc *SnapshotController) handleSnapshotDeletion(snapshot *longhorn.Snapshot, engine *longhorn.Engine) error {
 	engineCliClient, err := GetBinaryClientForEngine(engine, sc.engineClientCollection, engine.Status.CurrentImage)
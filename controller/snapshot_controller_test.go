@@ -2,7 +2,16 @@ package controller
 
 import (
 	"fmt"
+	"reflect"
 	"testing"
+	"time"
+
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/longhorn/longhorn-manager/types"
+
+	longhorn "github.com/longhorn/longhorn-manager/k8s/pkg/apis/longhorn/v1beta2"
 )
 
 func TestShouldUpdateObject(t *testing.T) {
@@ -28,3 +37,76 @@ func TestShouldUpdateObject(t *testing.T) {
 		t.Fatal("reconcileErr1 must be non-updatable error")
 	}
 }
+
+func TestGetSnapshotHookFromPod(t *testing.T) {
+	newPod := func(annotations map[string]string) *corev1.Pod {
+		return &corev1.Pod{
+			ObjectMeta: metav1.ObjectMeta{
+				Namespace:   "default",
+				Name:        "workload",
+				Annotations: annotations,
+			},
+			Spec: corev1.PodSpec{
+				Containers: []corev1.Container{{Name: "app"}, {Name: "sidecar"}},
+			},
+		}
+	}
+
+	hook, err := getSnapshotHookFromPod(newPod(nil), longhorn.SnapshotHookTypePre)
+	if err != nil || hook != nil {
+		t.Fatalf("expected no hook for pod without annotations, got %v, %v", hook, err)
+	}
+
+	hook, err = getSnapshotHookFromPod(newPod(map[string]string{
+		"snapshot.longhorn.io/pre-hook-command": "fsfreeze -f /data",
+	}), longhorn.SnapshotHookTypePre)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if !reflect.DeepEqual(hook.command, []string{"/bin/sh", "-c", "fsfreeze -f /data"}) ||
+		hook.container != "app" || hook.timeout != types.SnapshotHookDefaultTimeout || hook.onError != types.SnapshotHookOnErrorFail {
+		t.Fatalf("unexpected default hook %+v", hook)
+	}
+
+	hook, err = getSnapshotHookFromPod(newPod(map[string]string{
+		"snapshot.longhorn.io/post-hook-command":   `["fsfreeze", "-u", "/data"]`,
+		"snapshot.longhorn.io/post-hook-container": "sidecar",
+		"snapshot.longhorn.io/post-hook-timeout":   "1m",
+		"snapshot.longhorn.io/post-hook-on-error":  types.SnapshotHookOnErrorContinue,
+	}), longhorn.SnapshotHookTypePost)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if !reflect.DeepEqual(hook.command, []string{"fsfreeze", "-u", "/data"}) ||
+		hook.container != "sidecar" || hook.timeout != time.Minute || hook.onError != types.SnapshotHookOnErrorContinue {
+		t.Fatalf("unexpected hook %+v", hook)
+	}
+
+	for _, annotations := range []map[string]string{
+		{"snapshot.longhorn.io/pre-hook-command": `["fsfreeze"`},
+		{"snapshot.longhorn.io/pre-hook-command": `[]`},
+		{"snapshot.longhorn.io/pre-hook-command": "sync", "snapshot.longhorn.io/pre-hook-container": "unknown"},
+		{"snapshot.longhorn.io/pre-hook-command": "sync", "snapshot.longhorn.io/pre-hook-timeout": "0s"},
+		{"snapshot.longhorn.io/pre-hook-command": "sync", "snapshot.longhorn.io/pre-hook-on-error": "Ignore"},
+	} {
+		if _, err := getSnapshotHookFromPod(newPod(annotations), longhorn.SnapshotHookTypePre); err == nil {
+			t.Fatalf("expected error for invalid hook annotations %v", annotations)
+		}
+	}
+}
+
+func TestGetAbortingSnapshotHookResult(t *testing.T) {
+	results := []longhorn.SnapshotHookResult{
+		{Type: longhorn.SnapshotHookTypePre, PodName: "ignored", Succeeded: false, OnError: types.SnapshotHookOnErrorContinue},
+		{Type: longhorn.SnapshotHookTypePre, PodName: "succeeded", Succeeded: true, OnError: types.SnapshotHookOnErrorFail},
+		{Type: longhorn.SnapshotHookTypePost, PodName: "post", Succeeded: false, OnError: types.SnapshotHookOnErrorFail},
+	}
+	if result := getAbortingSnapshotHookResult(results); result != nil {
+		t.Fatalf("expected no aborting hook result, got %+v", result)
+	}
+
+	results = append(results, longhorn.SnapshotHookResult{Type: longhorn.SnapshotHookTypePre, PodName: "failed", Succeeded: false, OnError: types.SnapshotHookOnErrorFail})
+	if result := getAbortingSnapshotHookResult(results); result == nil || result.PodName != "failed" {
+		t.Fatalf("expected the failed pre hook result, got %+v", result)
+	}
+}
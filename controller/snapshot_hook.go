@@ -0,0 +1,247 @@
+package controller
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/cockroachdb/errors"
+	"github.com/sirupsen/logrus"
+
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/client-go/tools/record"
+
+	corev1 "k8s.io/api/core/v1"
+
+	"github.com/longhorn/longhorn-manager/constant"
+	"github.com/longhorn/longhorn-manager/datastore"
+	"github.com/longhorn/longhorn-manager/types"
+	"github.com/longhorn/longhorn-manager/util"
+
+	longhorn "github.com/longhorn/longhorn-manager/k8s/pkg/apis/longhorn/v1beta2"
+)
+
+const (
+	// snapshotHookMaxOutputLength limits the command output recorded in the hook result
+	snapshotHookMaxOutputLength = 256
+)
+
+// SnapshotHookExecutor executes the snapshot hook commands in the containers of the workload pods
+type SnapshotHookExecutor interface {
+	Exec(ctx context.Context, namespace, podName, containerName string, command []string) (stdout, stderr string, err error)
+}
+
+// snapshotHook is a pre/post snapshot hook declared on the annotations of a workload pod
+type snapshotHook struct {
+	hookType  longhorn.SnapshotHookType
+	namespace string
+	podName   string
+	container string
+	command   []string
+	timeout   time.Duration
+	onError   string
+}
+
+// getSnapshotHookFromPod returns the hook of the given type declared on the pod annotations,
+// or nil if the pod does not declare one.
+func getSnapshotHookFromPod(pod *corev1.Pod, hookType longhorn.SnapshotHookType) (*snapshotHook, error) {
+	annotations := pod.GetAnnotations()
+
+	commandAnnotation := strings.TrimSpace(annotations[fmt.Sprintf(types.PodAnnotationSnapshotHookCommandFmt, hookType)])
+	if commandAnnotation == "" {
+		return nil, nil
+	}
+
+	hook := &snapshotHook{
+		hookType:  hookType,
+		namespace: pod.Namespace,
+		podName:   pod.Name,
+		timeout:   types.SnapshotHookDefaultTimeout,
+		onError:   types.SnapshotHookOnErrorFail,
+	}
+
+	if strings.HasPrefix(commandAnnotation, "[") {
+		if err := json.Unmarshal([]byte(commandAnnotation), &hook.command); err != nil {
+			return nil, errors.Wrapf(err, "invalid %v snapshot hook command %v of pod %v/%v", hookType, commandAnnotation, pod.Namespace, pod.Name)
+		}
+		if len(hook.command) == 0 {
+			return nil, fmt.Errorf("empty %v snapshot hook command of pod %v/%v", hookType, pod.Namespace, pod.Name)
+		}
+	} else {
+		hook.command = []string{"/bin/sh", "-c", commandAnnotation}
+	}
+
+	hook.container = annotations[fmt.Sprintf(types.PodAnnotationSnapshotHookContainerFmt, hookType)]
+	if hook.container == "" {
+		if len(pod.Spec.Containers) == 0 {
+			return nil, fmt.Errorf("cannot find container for %v snapshot hook of pod %v/%v", hookType, pod.Namespace, pod.Name)
+		}
+		hook.container = pod.Spec.Containers[0].Name
+	} else {
+		found := false
+		for _, container := range pod.Spec.Containers {
+			if container.Name == hook.container {
+				found = true
+				break
+			}
+		}
+		if !found {
+			return nil, fmt.Errorf("cannot find container %v for %v snapshot hook of pod %v/%v", hook.container, hookType, pod.Namespace, pod.Name)
+		}
+	}
+
+	if timeout := annotations[fmt.Sprintf(types.PodAnnotationSnapshotHookTimeoutFmt, hookType)]; timeout != "" {
+		duration, err := time.ParseDuration(timeout)
+		if err != nil {
+			return nil, errors.Wrapf(err, "invalid %v snapshot hook timeout %v of pod %v/%v", hookType, timeout, pod.Namespace, pod.Name)
+		}
+		if duration <= 0 {
+			return nil, fmt.Errorf("invalid %v snapshot hook timeout %v of pod %v/%v: must be positive", hookType, timeout, pod.Namespace, pod.Name)
+		}
+		hook.timeout = duration
+	}
+
+	if onError := annotations[fmt.Sprintf(types.PodAnnotationSnapshotHookOnErrorFmt, hookType)]; onError != "" {
+		if onError != types.SnapshotHookOnErrorFail && onError != types.SnapshotHookOnErrorContinue {
+			return nil, fmt.Errorf("invalid %v snapshot hook on-error policy %v of pod %v/%v: must be %v or %v",
+				hookType, onError, pod.Namespace, pod.Name, types.SnapshotHookOnErrorFail, types.SnapshotHookOnErrorContinue)
+		}
+		hook.onError = onError
+	}
+
+	return hook, nil
+}
+
+// snapshotHookRunner executes the pre/post snapshot hooks declared on the running workload pods of volumes
+type snapshotHookRunner struct {
+	ds            *datastore.DataStore
+	executor      SnapshotHookExecutor
+	eventRecorder record.EventRecorder
+	logger        logrus.FieldLogger
+}
+
+func newSnapshotHookRunner(ds *datastore.DataStore, executor SnapshotHookExecutor, eventRecorder record.EventRecorder, logger logrus.FieldLogger) *snapshotHookRunner {
+	return &snapshotHookRunner{
+		ds:            ds,
+		executor:      executor,
+		eventRecorder: eventRecorder,
+		logger:        logger,
+	}
+}
+
+// getHooks returns the hooks of the given type declared on the running workload pods of the volumes.
+// A pod using multiple volumes is returned once.
+func (r *snapshotHookRunner) getHooks(volumeNames []string, hookType longhorn.SnapshotHookType) ([]*snapshotHook, error) {
+	hooks := []*snapshotHook{}
+	visitedPods := map[string]struct{}{}
+	for _, volumeName := range volumeNames {
+		volume, err := r.ds.GetVolumeRO(volumeName)
+		if err != nil {
+			return nil, err
+		}
+
+		ks := volume.Status.KubernetesStatus
+		if ks.PVCName == "" || ks.Namespace == "" {
+			continue
+		}
+
+		pods, err := r.ds.ListPodsByPersistentVolumeClaimName(ks.PVCName, ks.Namespace)
+		if err != nil {
+			return nil, errors.Wrapf(err, "failed to list workload pods of volume %v", volumeName)
+		}
+
+		for _, pod := range pods {
+			if pod.Status.Phase != corev1.PodRunning || !pod.DeletionTimestamp.IsZero() {
+				continue
+			}
+			podKey := pod.Namespace + "/" + pod.Name
+			if _, ok := visitedPods[podKey]; ok {
+				continue
+			}
+			visitedPods[podKey] = struct{}{}
+
+			hook, err := getSnapshotHookFromPod(pod, hookType)
+			if err != nil {
+				return nil, err
+			}
+			if hook != nil {
+				hooks = append(hooks, hook)
+			}
+		}
+	}
+	return hooks, nil
+}
+
+// run executes the hooks of the given type for the volumes and emits the events on the object.
+// It returns the results of the executed hooks, and an error on the first failed hook with the Fail on-error policy.
+func (r *snapshotHookRunner) run(obj runtime.Object, volumeNames []string, hookType longhorn.SnapshotHookType) ([]longhorn.SnapshotHookResult, error) {
+	hooks, err := r.getHooks(volumeNames, hookType)
+	if err != nil {
+		return nil, err
+	}
+
+	results := []longhorn.SnapshotHookResult{}
+	for _, hook := range hooks {
+		result := longhorn.SnapshotHookResult{
+			Type:         hook.hookType,
+			PodNamespace: hook.namespace,
+			PodName:      hook.podName,
+			Container:    hook.container,
+			OnError:      hook.onError,
+			ExecutedAt:   util.Now(),
+		}
+
+		r.logger.Infof("Executing %v snapshot hook in container %v of pod %v/%v", hookType, hook.container, hook.namespace, hook.podName)
+		err := r.exec(hook)
+		if err == nil {
+			result.Succeeded = true
+			r.eventRecorder.Eventf(obj, corev1.EventTypeNormal, constant.EventReasonSucceededSnapshotHook,
+				"executed %v snapshot hook in container %v of pod %v/%v", hookType, hook.container, hook.namespace, hook.podName)
+		} else {
+			result.Error = err.Error()
+			r.eventRecorder.Eventf(obj, corev1.EventTypeWarning, constant.EventReasonFailedSnapshotHook,
+				"failed to execute %v snapshot hook in container %v of pod %v/%v: %v", hookType, hook.container, hook.namespace, hook.podName, err)
+		}
+		results = append(results, result)
+
+		if err != nil && hook.onError == types.SnapshotHookOnErrorFail {
+			return results, errors.Wrapf(err, "failed to execute %v snapshot hook in container %v of pod %v/%v", hookType, hook.container, hook.namespace, hook.podName)
+		}
+	}
+	return results, nil
+}
+
+// getAbortingSnapshotHookResult returns the failed pre hook result with the Fail on-error policy, which aborted the
+// snapshot creation, or nil if there is none.
+func getAbortingSnapshotHookResult(results []longhorn.SnapshotHookResult) *longhorn.SnapshotHookResult {
+	for i := range results {
+		result := &results[i]
+		if result.Type == longhorn.SnapshotHookTypePre && !result.Succeeded && result.OnError == types.SnapshotHookOnErrorFail {
+			return result
+		}
+	}
+	return nil
+}
+
+func (r *snapshotHookRunner) exec(hook *snapshotHook) error {
+	if r.executor == nil {
+		return fmt.Errorf("snapshot hook executor is not available")
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), hook.timeout)
+	defer cancel()
+
+	_, stderr, err := r.executor.Exec(ctx, hook.namespace, hook.podName, hook.container, hook.command)
+	if err != nil {
+		if stderr = strings.TrimSpace(stderr); stderr != "" {
+			if len(stderr) > snapshotHookMaxOutputLength {
+				stderr = stderr[:snapshotHookMaxOutputLength]
+			}
+			return fmt.Errorf("%w, stderr: %v", err, stderr)
+		}
+		return err
+	}
+	return nil
+}
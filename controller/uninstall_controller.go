@@ -46,6 +46,8 @@ const (
 	CRDRecurringJobName           = "recurringjobs.longhorn.io"
 	CRDOrphanName                 = "orphans.longhorn.io"
 	CRDSnapshotName               = "snapshots.longhorn.io"
+	CRDVolumeGroupSnapshotName    = "volumegroupsnapshots.longhorn.io"
+	CRDDRGroupName                = "drgroups.longhorn.io"
 
 	EnvLonghornNamespace = "LONGHORN_NAMESPACE"
 )
@@ -203,6 +205,18 @@ func NewUninstallController(
 		}
 		cacheSyncs = append(cacheSyncs, ds.SnapshotInformer.HasSynced)
 	}
+	if _, err := extensionsClient.ApiextensionsV1().CustomResourceDefinitions().Get(context.TODO(), CRDVolumeGroupSnapshotName, metav1.GetOptions{}); err == nil {
+		if _, err = ds.VolumeGroupSnapshotInformer.AddEventHandler(c.controlleeHandler()); err != nil {
+			return nil, err
+		}
+		cacheSyncs = append(cacheSyncs, ds.VolumeGroupSnapshotInformer.HasSynced)
+	}
+	if _, err := extensionsClient.ApiextensionsV1().CustomResourceDefinitions().Get(context.TODO(), CRDDRGroupName, metav1.GetOptions{}); err == nil {
+		if _, err = ds.DRGroupInformer.AddEventHandler(c.controlleeHandler()); err != nil {
+			return nil, err
+		}
+		cacheSyncs = append(cacheSyncs, ds.DRGroupInformer.HasSynced)
+	}
 
 	c.cacheSyncs = cacheSyncs
 
@@ -494,6 +508,20 @@ func (c *UninstallController) deleteRecreatedCRs() (bool, error) {
 // deleteCRs deletes all the longhorn CRs.
 // Note that this function is for those CRs which won't be recreated by managers after deletion.
 func (c *UninstallController) deleteCRs() (bool, error) {
+	if drGroups, err := c.ds.ListDRGroups(); err != nil {
+		return true, err
+	} else if len(drGroups) > 0 {
+		c.logger.Infof("Found %d DR groups remaining", len(drGroups))
+		return true, c.deleteDRGroups(drGroups)
+	}
+
+	if groupSnapshots, err := c.ds.ListVolumeGroupSnapshots(); err != nil {
+		return true, err
+	} else if len(groupSnapshots) > 0 {
+		c.logger.Infof("Found %d volume group snapshots remaining", len(groupSnapshots))
+		return true, c.deleteVolumeGroupSnapshots(groupSnapshots)
+	}
+
 	if volumes, err := c.ds.ListVolumes(); err != nil {
 		return true, err
 	} else if len(volumes) > 0 {
@@ -706,6 +734,76 @@ func (c *UninstallController) deleteSnapshots(snapshots map[string]*longhorn.Sna
 	return
 }
 
+func (c *UninstallController) deleteVolumeGroupSnapshots(groupSnapshots map[string]*longhorn.VolumeGroupSnapshot) (err error) {
+	defer func() {
+		err = errors.Wrapf(err, "failed to delete volume group snapshots")
+	}()
+	for _, groupSnapshot := range groupSnapshots {
+		log := getLoggerForVolumeGroupSnapshot(c.logger, groupSnapshot)
+
+		timeout := metav1.NewTime(time.Now().Add(-gracePeriod))
+		if groupSnapshot.DeletionTimestamp == nil {
+			if errDelete := c.ds.DeleteVolumeGroupSnapshot(groupSnapshot.Name); errDelete != nil {
+				if datastore.ErrorIsNotFound(errDelete) {
+					log.Info("Volume group snapshot is not found")
+				} else {
+					err = errors.Wrap(errDelete, "failed to mark for deletion")
+					return
+				}
+			} else {
+				log.Info("Marked for deletion")
+			}
+		} else if groupSnapshot.DeletionTimestamp.Before(&timeout) {
+			if errRemove := c.ds.RemoveFinalizerForVolumeGroupSnapshot(groupSnapshot); errRemove != nil {
+				if datastore.ErrorIsNotFound(errRemove) {
+					log.Info("Volume group snapshot is not found")
+				} else {
+					err = errors.Wrap(errRemove, "failed to remove finalizer")
+					return
+				}
+			} else {
+				log.Info("Removed finalizer")
+			}
+		}
+	}
+	return
+}
+
+func (c *UninstallController) deleteDRGroups(drGroups map[string]*longhorn.DRGroup) (err error) {
+	defer func() {
+		err = errors.Wrapf(err, "failed to delete DR groups")
+	}()
+	for _, drGroup := range drGroups {
+		log := getLoggerForDRGroup(c.logger, drGroup)
+
+		timeout := metav1.NewTime(time.Now().Add(-gracePeriod))
+		if drGroup.DeletionTimestamp == nil {
+			if errDelete := c.ds.DeleteDRGroup(drGroup.Name); errDelete != nil {
+				if datastore.ErrorIsNotFound(errDelete) {
+					log.Info("DR group is not found")
+				} else {
+					err = errors.Wrap(errDelete, "failed to mark for deletion")
+					return
+				}
+			} else {
+				log.Info("Marked for deletion")
+			}
+		} else if drGroup.DeletionTimestamp.Before(&timeout) {
+			if errRemove := c.ds.RemoveFinalizerForDRGroup(drGroup); errRemove != nil {
+				if datastore.ErrorIsNotFound(errRemove) {
+					log.Info("DR group is not found")
+				} else {
+					err = errors.Wrap(errRemove, "failed to remove finalizer")
+					return
+				}
+			} else {
+				log.Info("Removed finalizer")
+			}
+		}
+	}
+	return
+}
+
 func (c *UninstallController) deleteEngines(engines map[string]*longhorn.Engine) (err error) {
 	defer func() {
 		err = errors.Wrapf(err, "failed to delete engines")
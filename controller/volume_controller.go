@@ -1338,11 +1338,14 @@ func (c *VolumeController) cleanupAutoBalancedReplicas(v *longhorn.Volume, e *lo
 		if len(rNames) == 0 {
 			_, rNames, _ = c.getReplicaCountForAutoBalanceBestEffort(v, e, rs, c.getReplicaCountForAutoBalanceZone)
 		}
+		if len(rNames) == 0 {
+			_, rNames, _ = c.getReplicaCountForAutoBalanceBestEffort(v, e, rs, c.getReplicaCountForAutoBalanceTopology)
+		}
 	}
 
 	var err error
 	if len(rNames) == 0 {
-		rNames, err = c.getPreferredReplicaCandidatesForDeletion(rs)
+		rNames, err = c.getPreferredReplicaCandidatesForDeletion(v, rs)
 		if err != nil {
 			return false, err
 		}
@@ -1368,7 +1371,7 @@ func (c *VolumeController) cleanupAutoBalancedReplicas(v *longhorn.Volume, e *lo
 func (c *VolumeController) cleanupDataLocalityReplicas(v *longhorn.Volume, e *longhorn.Engine, rs map[string]*longhorn.Replica) (bool, error) {
 	if !isDataLocalityDisabled(v) &&
 		hasLocalReplicaOnSameNodeAsEngine(e, rs) {
-		rNames, err := c.getPreferredReplicaCandidatesForDeletion(rs)
+		rNames, err := c.getPreferredReplicaCandidatesForDeletion(v, rs)
 		if err != nil {
 			return false, err
 		}
@@ -1718,6 +1721,10 @@ func (c *VolumeController) ReconcileVolumeState(v *longhorn.Volume, es map[strin
 		}
 	}
 
+	if err := c.reconcileStandbyVolumeRPO(v, e); err != nil {
+		return err
+	}
+
 	return c.checkAndFinishVolumeRestore(v, e, rs)
 }
 
@@ -2336,7 +2343,7 @@ func imInStartingOrRunningState(im *longhorn.InstanceManager) bool {
 		im.Status.CurrentState == longhorn.InstanceManagerStateRunning
 }
 
-func (c *VolumeController) getPreferredReplicaCandidatesForDeletion(rs map[string]*longhorn.Replica) ([]string, error) {
+func (c *VolumeController) getPreferredReplicaCandidatesForDeletion(v *longhorn.Volume, rs map[string]*longhorn.Replica) ([]string, error) {
 	diskToReplicaMap := make(map[string][]string)
 	nodeToReplicaMap := make(map[string][]string)
 	zoneToReplicaMap := make(map[string][]string)
@@ -2372,6 +2379,31 @@ func (c *VolumeController) getPreferredReplicaCandidatesForDeletion(rs map[strin
 		return deletionCandidates, nil
 	}
 
+	// if all replicas are on different nodes, prefer to delete replicas in the most crowded topology domain of the
+	// replica topology spread constraints in order
+	if len(v.Spec.ReplicaTopologySpread) > 0 {
+		nodeNames := map[string]struct{}{}
+		for _, r := range rs {
+			nodeNames[r.Spec.NodeID] = struct{}{}
+		}
+		nodeLabels, err := c.scheduler.GetNodeTopologyLabels(nodeNames)
+		if err != nil {
+			return nil, err
+		}
+		for _, constraint := range v.Spec.ReplicaTopologySpread {
+			domainToReplicaMap := make(map[string][]string)
+			for _, r := range rs {
+				if domain, ok := scheduler.GetTopologyDomain(nodeLabels, r.Spec.NodeID, constraint.TopologyKey); ok {
+					domainToReplicaMap[domain] = append(domainToReplicaMap[domain], r.Name)
+				}
+			}
+			deletionCandidates = findValueWithBiggestLength(domainToReplicaMap)
+			if len(deletionCandidates) > 1 {
+				return deletionCandidates, nil
+			}
+		}
+	}
+
 	// if all replicas are on different nodes, prefer to delete replicas on the same zone
 	deletionCandidates = findValueWithBiggestLength(zoneToReplicaMap)
 	if len(deletionCandidates) > 1 {
@@ -3210,6 +3242,9 @@ func (c *VolumeController) getReplenishReplicasCount(v *longhorn.Volume, rs map[
 		if adjustCount := c.getReplicaCountForAutoBalanceLeastEffort(v, e, rs, c.getReplicaCountForAutoBalanceNode); adjustCount != 0 {
 			return adjustCount, ""
 		}
+		if adjustCount := c.getReplicaCountForAutoBalanceLeastEffort(v, e, rs, c.getReplicaCountForAutoBalanceTopology); adjustCount != 0 {
+			return adjustCount, ""
+		}
 
 		var nCandidates []string
 		adjustCount, _, nCandidates := c.getReplicaCountForAutoBalanceBestEffort(v, e, rs, c.getReplicaCountForAutoBalanceNode)
@@ -3229,6 +3264,99 @@ func (c *VolumeController) getReplenishReplicasCount(v *longhorn.Volume, rs map[
 	return 0, ""
 }
 
+// getReplicaCountForAutoBalanceTopology checks the replica topology spread constraints of the volume in order. For the
+// first constraint whose replica count skew between the topology domains exceeds the max skew, it returns 1 if a
+// least used domain has a node without replica to host a new one, along with the extra replicas in each used domain.
+func (c *VolumeController) getReplicaCountForAutoBalanceTopology(v *longhorn.Volume, e *longhorn.Engine, rs map[string]*longhorn.Replica) (int, map[string][]string, error) {
+	if len(v.Spec.ReplicaTopologySpread) == 0 {
+		return 0, nil, nil
+	}
+
+	log := getLoggerForVolume(c.logger, v).WithField("replicaAutoBalanceType", "topology")
+
+	readyNodes, err := c.listReadySchedulableAndScheduledNodesRO(v, rs, log)
+	if err != nil {
+		return 0, nil, err
+	}
+	nodeNames := map[string]struct{}{}
+	for nodeName := range readyNodes {
+		nodeNames[nodeName] = struct{}{}
+	}
+	nodeLabels, err := c.scheduler.GetNodeTopologyLabels(nodeNames)
+	if err != nil {
+		return 0, nil, err
+	}
+
+	// Count the engine node replica first so it doesn't get included in the duplicates list.
+	var runningReplicas []*longhorn.Replica
+	usedNodes := map[string]bool{}
+	for _, r := range rs {
+		if r.Status.CurrentState != longhorn.InstanceStateRunning {
+			continue
+		}
+		if _, exist := readyNodes[r.Spec.NodeID]; !exist {
+			// replica on node not count for auto-balance, could get evicted
+			continue
+		}
+		if r.Spec.NodeID == e.Spec.NodeID {
+			runningReplicas = append([]*longhorn.Replica{r}, runningReplicas...)
+		} else {
+			runningReplicas = append(runningReplicas, r)
+		}
+		usedNodes[r.Spec.NodeID] = true
+	}
+
+	for _, constraint := range v.Spec.ReplicaTopologySpread {
+		domainExtraRs := make(map[string][]string)
+		for _, r := range runningReplicas {
+			domain, ok := scheduler.GetTopologyDomain(nodeLabels, r.Spec.NodeID, constraint.TopologyKey)
+			if !ok {
+				continue
+			}
+			if _, exist := domainExtraRs[domain]; exist {
+				domainExtraRs[domain] = append(domainExtraRs[domain], r.Name)
+			} else {
+				domainExtraRs[domain] = []string{}
+			}
+		}
+
+		maxCount := 0
+		for _, extraRs := range domainExtraRs {
+			maxCount = max(maxCount, len(extraRs)+1)
+		}
+
+		// The least replica count of the domains with a node that can host a new replica
+		minCount := -1
+		for nodeName, node := range readyNodes {
+			if usedNodes[nodeName] || !node.Spec.AllowScheduling {
+				continue
+			}
+			domain, ok := scheduler.GetTopologyDomain(nodeLabels, nodeName, constraint.TopologyKey)
+			if !ok {
+				continue
+			}
+			count := 0
+			if extraRs, exist := domainExtraRs[domain]; exist {
+				count = len(extraRs) + 1
+			}
+			if minCount < 0 || count < minCount {
+				minCount = count
+			}
+		}
+
+		if minCount < 0 || maxCount-minCount <= constraint.MaxSkew {
+			continue
+		}
+
+		log.Infof("Found replica count skew %v exceeding max skew %v of topology key %v in %v",
+			maxCount-minCount, constraint.MaxSkew, constraint.TopologyKey, domainExtraRs)
+		return 1, domainExtraRs, nil
+	}
+
+	log.Debugf("Balanced, volume replicas are spread across the topology domains")
+	return 0, nil, nil
+}
+
 func (c *VolumeController) getNodeCandidatesForAutoBalanceZone(v *longhorn.Volume, e *longhorn.Engine, rs map[string]*longhorn.Replica, zones []string) (candidateNames []string) {
 	log := getLoggerForVolume(c.logger, v).WithFields(
 		logrus.Fields{
@@ -3752,6 +3880,120 @@ func (c *VolumeController) checkAndFinishVolumeRestore(v *longhorn.Volume, e *lo
 	return nil
 }
 
+// reconcileStandbyVolumeRPO tracks how far the DR volume lags behind the backups of the source volume, and sets the
+// RPOExceeded condition if the last restored backup is older than the standby volume RPO threshold.
+func (c *VolumeController) reconcileStandbyVolumeRPO(v *longhorn.Volume, e *longhorn.Engine) error {
+	if !v.Status.IsStandby {
+		v.Status.LastRestoredBackup = ""
+		v.Status.LastRestoredBackupAt = ""
+		v.Status.LastRestoreCompletedAt = ""
+		v.Status.PendingRestoreBackupCount = 0
+		if types.GetCondition(v.Status.Conditions, longhorn.VolumeConditionTypeRPOExceeded).Status == longhorn.ConditionStatusTrue {
+			v.Status.Conditions = types.SetCondition(v.Status.Conditions,
+				longhorn.VolumeConditionTypeRPOExceeded, longhorn.ConditionStatusFalse, "", "")
+		}
+		return nil
+	}
+
+	if e == nil {
+		return nil
+	}
+
+	if e.Status.LastRestoredBackup != "" && e.Status.LastRestoredBackup != v.Status.LastRestoredBackup {
+		v.Status.LastRestoredBackup = e.Status.LastRestoredBackup
+		v.Status.LastRestoredBackupAt = ""
+		v.Status.LastRestoreCompletedAt = util.Now()
+	}
+	if v.Status.LastRestoredBackup != "" && v.Status.LastRestoredBackupAt == "" {
+		// The backup CR may not be synced from the backup target yet. Retry in the next reconciliation.
+		b, err := c.ds.GetBackupRO(v.Status.LastRestoredBackup)
+		if err != nil && !apierrors.IsNotFound(err) {
+			return err
+		}
+		if b != nil {
+			v.Status.LastRestoredBackupAt = b.Status.BackupCreatedAt
+		}
+	}
+
+	_, bvName, _, err := backupstore.DecodeBackupURL(v.Spec.FromBackup)
+	if err != nil {
+		return errors.Wrapf(err, "failed to get backup volume name from volume %v backup URL %v", v.Name, v.Spec.FromBackup)
+	}
+	backups, err := c.ds.ListBackupsWithBackupTargetAndBackupVolumeRO(v.Spec.BackupTargetName, bvName)
+	if err != nil {
+		return err
+	}
+	v.Status.PendingRestoreBackupCount = countPendingRestoreBackups(backups, v.Status.LastRestoredBackupAt)
+
+	threshold, err := c.ds.GetSettingAsInt(types.SettingNameStandbyVolumeRPOThreshold)
+	if err != nil {
+		return err
+	}
+	exceeded, remaining := isStandbyVolumeRPOExceeded(v.Status.LastRestoredBackupAt, time.Duration(threshold)*time.Minute, time.Now())
+	if exceeded {
+		v.Status.Conditions = types.SetConditionAndRecord(v.Status.Conditions,
+			longhorn.VolumeConditionTypeRPOExceeded, longhorn.ConditionStatusTrue, longhorn.VolumeConditionReasonRPOThresholdExceeded,
+			fmt.Sprintf("Last restored backup %v was created at %v, which is older than the RPO threshold of %v minutes",
+				v.Status.LastRestoredBackup, v.Status.LastRestoredBackupAt, threshold),
+			c.eventRecorder, v, corev1.EventTypeWarning)
+		return nil
+	}
+
+	if types.GetCondition(v.Status.Conditions, longhorn.VolumeConditionTypeRPOExceeded).Status != longhorn.ConditionStatusUnknown {
+		v.Status.Conditions = types.SetCondition(v.Status.Conditions,
+			longhorn.VolumeConditionTypeRPOExceeded, longhorn.ConditionStatusFalse, "", "")
+	}
+	// The recovery point ages without any resource change, so check back once it reaches the threshold
+	if remaining > 0 {
+		c.enqueueVolumeAfter(v, remaining)
+	}
+	return nil
+}
+
+// countPendingRestoreBackups returns the number of completed backups created after the last restored backup.
+func countPendingRestoreBackups(backups map[string]*longhorn.Backup, lastRestoredBackupAt string) int {
+	var restoredAt time.Time
+	if lastRestoredBackupAt != "" {
+		t, err := util.ParseTime(lastRestoredBackupAt)
+		if err != nil {
+			return 0
+		}
+		restoredAt = t
+	}
+
+	count := 0
+	for _, b := range backups {
+		if b.Status.State != longhorn.BackupStateCompleted {
+			continue
+		}
+		createdAt, err := util.ParseTime(b.Status.BackupCreatedAt)
+		if err != nil {
+			continue
+		}
+		if createdAt.After(restoredAt) {
+			count++
+		}
+	}
+	return count
+}
+
+// isStandbyVolumeRPOExceeded checks if the recovery point is older than the threshold. If not, it returns the
+// duration before the recovery point exceeds the threshold. A zero threshold disables the check.
+func isStandbyVolumeRPOExceeded(recoveryPoint string, threshold time.Duration, now time.Time) (bool, time.Duration) {
+	if threshold <= 0 || recoveryPoint == "" {
+		return false, 0
+	}
+	t, err := util.ParseTime(recoveryPoint)
+	if err != nil {
+		return false, 0
+	}
+	age := now.Sub(t)
+	if age > threshold {
+		return true, 0
+	}
+	return false, threshold - age
+}
+
 func (c *VolumeController) checkAllScheduledReplicasIncluded(v *longhorn.Volume, e *longhorn.Engine, rs map[string]*longhorn.Replica) (bool, error) {
 	healthReplicaCount := 0
 	hasReplicaNotIncluded := false
@@ -629,6 +629,7 @@ func (s *TestSuite) TestVolumeLifeCycle(c *C) {
 	tc.volume.Status.RestoreInitiated = true
 	tc.volume.Status.RestoreRequired = true
 	tc.volume.Status.IsStandby = true
+	tc.volume.Status.LastRestoredBackup = TestBackupName
 	tc.volume.Status.LastBackup = TestBackupName
 	tc.volume.Status.Conditions = setVolumeConditionWithoutTimestamp(tc.volume.Status.Conditions,
 		longhorn.VolumeConditionTypeRestore, longhorn.ConditionStatusTrue, longhorn.VolumeConditionReasonRestoreInProgress, "")
@@ -736,6 +737,7 @@ func (s *TestSuite) TestVolumeLifeCycle(c *C) {
 	tc.volume.Status.RestoreRequired = true
 	tc.volume.Status.RestoreInitiated = true
 	tc.volume.Status.IsStandby = true
+	tc.volume.Status.LastRestoredBackup = TestBackupName
 	tc.volume.Status.Conditions = setVolumeConditionWithoutTimestamp(tc.volume.Status.Conditions,
 		longhorn.VolumeConditionTypeRestore, longhorn.ConditionStatusTrue, longhorn.VolumeConditionReasonRestoreInProgress, "")
 	for _, e := range tc.engines {
@@ -1123,6 +1125,44 @@ func (s *TestSuite) TestVolumeLifeCycle(c *C) {
 	s.runTestCases(c, testCases)
 }
 
+func (s *TestSuite) TestStandbyVolumeRPO(c *C) {
+	newBackup := func(state longhorn.BackupState, createdAt string) *longhorn.Backup {
+		return &longhorn.Backup{
+			Status: longhorn.BackupStatus{
+				State:           state,
+				BackupCreatedAt: createdAt,
+			},
+		}
+	}
+	backups := map[string]*longhorn.Backup{
+		"backup-1": newBackup(longhorn.BackupStateCompleted, "2024-05-01T08:00:00Z"),
+		"backup-2": newBackup(longhorn.BackupStateCompleted, "2024-05-01T09:00:00Z"),
+		"backup-3": newBackup(longhorn.BackupStateCompleted, "2024-05-01T10:00:00Z"),
+		"backup-4": newBackup(longhorn.BackupStateInProgress, "2024-05-01T11:00:00Z"),
+	}
+	c.Assert(countPendingRestoreBackups(backups, "2024-05-01T08:00:00Z"), Equals, 2)
+	c.Assert(countPendingRestoreBackups(backups, "2024-05-01T10:00:00Z"), Equals, 0)
+	c.Assert(countPendingRestoreBackups(backups, ""), Equals, 3)
+
+	now, err := util.ParseTime("2024-05-01T10:00:00Z")
+	c.Assert(err, IsNil)
+
+	exceeded, remaining := isStandbyVolumeRPOExceeded("2024-05-01T08:00:00Z", time.Hour, now)
+	c.Assert(exceeded, Equals, true)
+	c.Assert(remaining, Equals, time.Duration(0))
+
+	exceeded, remaining = isStandbyVolumeRPOExceeded("2024-05-01T09:30:00Z", time.Hour, now)
+	c.Assert(exceeded, Equals, false)
+	c.Assert(remaining, Equals, 30*time.Minute)
+
+	// The check is disabled by a zero threshold, and skipped before the first restore completes
+	exceeded, remaining = isStandbyVolumeRPOExceeded("2024-05-01T08:00:00Z", 0, now)
+	c.Assert(exceeded, Equals, false)
+	c.Assert(remaining, Equals, time.Duration(0))
+	exceeded, _ = isStandbyVolumeRPOExceeded("", time.Hour, now)
+	c.Assert(exceeded, Equals, false)
+}
+
 func (s *TestSuite) TestVolumeBackingImageSizeIncompatibleAfterDownload(c *C) {
 	// We have to skip lister check for unit tests
 	// Because the changes written through the API won't be reflected in the listers
@@ -0,0 +1,487 @@
+package controller
+
+import (
+	"fmt"
+	"reflect"
+	"time"
+
+	"github.com/cockroachdb/errors"
+	"github.com/sirupsen/logrus"
+
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/util/wait"
+	"k8s.io/client-go/tools/cache"
+	"k8s.io/client-go/tools/record"
+	"k8s.io/kubernetes/pkg/controller"
+
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	utilruntime "k8s.io/apimachinery/pkg/util/runtime"
+	clientset "k8s.io/client-go/kubernetes"
+	v1core "k8s.io/client-go/kubernetes/typed/core/v1"
+
+	"github.com/longhorn/longhorn-manager/constant"
+	"github.com/longhorn/longhorn-manager/datastore"
+	"github.com/longhorn/longhorn-manager/types"
+	"github.com/longhorn/longhorn-manager/util"
+
+	longhorn "github.com/longhorn/longhorn-manager/k8s/pkg/apis/longhorn/v1beta2"
+)
+
+const (
+	// volumeGroupSnapshotCreationTimeout is the maximum time to wait for the member snapshots to be created
+	// in the engines, while the workloads of the member volumes are quiesced.
+	volumeGroupSnapshotCreationTimeout = 2 * time.Minute
+	// volumeGroupSnapshotPollInterval is the interval to check the member snapshots of an in-progress volume group snapshot
+	volumeGroupSnapshotPollInterval = 2 * time.Second
+)
+
+type VolumeGroupSnapshotController struct {
+	*baseController
+
+	// which namespace controller is running with
+	namespace string
+	// use as the OwnerID of the controller
+	controllerID string
+
+	kubeClient    clientset.Interface
+	eventRecorder record.EventRecorder
+
+	ds *datastore.DataStore
+
+	cacheSyncs []cache.InformerSynced
+
+	hookRunner *snapshotHookRunner
+}
+
+func NewVolumeGroupSnapshotController(
+	logger logrus.FieldLogger,
+	ds *datastore.DataStore,
+	scheme *runtime.Scheme,
+	kubeClient clientset.Interface,
+	namespace string,
+	controllerID string,
+	snapshotHookExecutor SnapshotHookExecutor,
+) (*VolumeGroupSnapshotController, error) {
+	eventBroadcaster := record.NewBroadcaster()
+	eventBroadcaster.StartLogging(logrus.Infof)
+	// TODO: remove the wrapper when every clients have moved to use the clientset.
+	eventBroadcaster.StartRecordingToSink(&v1core.EventSinkImpl{
+		Interface: v1core.New(kubeClient.CoreV1().RESTClient()).Events(""),
+	})
+
+	c := &VolumeGroupSnapshotController{
+		baseController: newBaseController("longhorn-volume-group-snapshot", logger),
+
+		namespace:    namespace,
+		controllerID: controllerID,
+
+		ds: ds,
+
+		kubeClient:    kubeClient,
+		eventRecorder: eventBroadcaster.NewRecorder(scheme, corev1.EventSource{Component: "longhorn-volume-group-snapshot-controller"}),
+	}
+	c.hookRunner = newSnapshotHookRunner(ds, snapshotHookExecutor, c.eventRecorder, c.logger)
+
+	var err error
+	if _, err = ds.VolumeGroupSnapshotInformer.AddEventHandler(cache.ResourceEventHandlerFuncs{
+		AddFunc:    c.enqueueVolumeGroupSnapshot,
+		UpdateFunc: func(old, cur interface{}) { c.enqueueVolumeGroupSnapshot(cur) },
+		DeleteFunc: c.enqueueVolumeGroupSnapshot,
+	}); err != nil {
+		return nil, err
+	}
+	c.cacheSyncs = append(c.cacheSyncs, ds.VolumeGroupSnapshotInformer.HasSynced)
+
+	if _, err = ds.SnapshotInformer.AddEventHandlerWithResyncPeriod(cache.ResourceEventHandlerFuncs{
+		AddFunc:    c.enqueueForSnapshot,
+		UpdateFunc: func(old, cur interface{}) { c.enqueueForSnapshot(cur) },
+		DeleteFunc: c.enqueueForSnapshot,
+	}, 0); err != nil {
+		return nil, err
+	}
+	c.cacheSyncs = append(c.cacheSyncs, ds.SnapshotInformer.HasSynced)
+
+	return c, nil
+}
+
+func (c *VolumeGroupSnapshotController) enqueueVolumeGroupSnapshot(obj interface{}) {
+	key, err := controller.KeyFunc(obj)
+	if err != nil {
+		utilruntime.HandleError(fmt.Errorf("failed to get key for object %#v: %v", obj, err))
+		return
+	}
+
+	c.queue.Add(key)
+}
+
+func (c *VolumeGroupSnapshotController) enqueueForSnapshot(obj interface{}) {
+	snapshot, ok := obj.(*longhorn.Snapshot)
+	if !ok {
+		deletedState, ok := obj.(cache.DeletedFinalStateUnknown)
+		if !ok {
+			utilruntime.HandleError(fmt.Errorf("received unexpected obj: %#v", obj))
+			return
+		}
+		// use the last known state, to enqueue, dependent objects
+		snapshot, ok = deletedState.Obj.(*longhorn.Snapshot)
+		if !ok {
+			utilruntime.HandleError(fmt.Errorf("DeletedFinalStateUnknown contained invalid object: %#v", deletedState.Obj))
+			return
+		}
+	}
+
+	groupSnapshotName := snapshot.Labels[types.GetLonghornLabelKey(types.LonghornLabelVolumeGroupSnapshot)]
+	if groupSnapshotName == "" {
+		return
+	}
+	c.queue.Add(snapshot.Namespace + "/" + groupSnapshotName)
+}
+
+func (c *VolumeGroupSnapshotController) Run(workers int, stopCh <-chan struct{}) {
+	defer utilruntime.HandleCrash()
+	defer c.queue.ShutDown()
+
+	c.logger.Info("Starting Longhorn VolumeGroupSnapshot controller")
+	defer c.logger.Info("Shut down Longhorn VolumeGroupSnapshot controller")
+
+	if !cache.WaitForNamedCacheSync(c.name, stopCh, c.cacheSyncs...) {
+		return
+	}
+	for i := 0; i < workers; i++ {
+		go wait.Until(c.worker, time.Second, stopCh)
+	}
+	<-stopCh
+}
+
+func (c *VolumeGroupSnapshotController) worker() {
+	for c.processNextWorkItem() {
+	}
+}
+
+func (c *VolumeGroupSnapshotController) processNextWorkItem() bool {
+	key, quit := c.queue.Get()
+	if quit {
+		return false
+	}
+	defer c.queue.Done(key)
+	err := c.syncVolumeGroupSnapshot(key.(string))
+	c.handleErr(err, key)
+	return true
+}
+
+func (c *VolumeGroupSnapshotController) handleErr(err error, key interface{}) {
+	if err == nil {
+		c.queue.Forget(key)
+		return
+	}
+
+	log := c.logger.WithField("volumeGroupSnapshot", key)
+	if c.queue.NumRequeues(key) < maxRetries {
+		handleReconcileErrorLogging(log, err, "Failed to sync Longhorn volume group snapshot")
+		c.queue.AddRateLimited(key)
+		return
+	}
+
+	utilruntime.HandleError(err)
+	handleReconcileErrorLogging(log, err, "Dropping Longhorn volume group snapshot out of the queue")
+	c.queue.Forget(key)
+}
+
+func getLoggerForVolumeGroupSnapshot(logger logrus.FieldLogger, groupSnapshot *longhorn.VolumeGroupSnapshot) *logrus.Entry {
+	return logger.WithFields(
+		logrus.Fields{
+			"volumeGroupSnapshot": groupSnapshot.Name,
+			"volumes":             groupSnapshot.Spec.Volumes,
+		},
+	)
+}
+
+func (c *VolumeGroupSnapshotController) isResponsibleFor(groupSnapshot *longhorn.VolumeGroupSnapshot) bool {
+	return isControllerResponsibleFor(c.controllerID, c.ds, groupSnapshot.Name, "", groupSnapshot.Status.OwnerID)
+}
+
+func (c *VolumeGroupSnapshotController) syncVolumeGroupSnapshot(key string) (err error) {
+	defer func() {
+		err = errors.Wrapf(err, "failed to sync volume group snapshot %v", key)
+	}()
+
+	namespace, name, err := cache.SplitMetaNamespaceKey(key)
+	if err != nil {
+		return err
+	}
+	if namespace != c.namespace {
+		return nil
+	}
+	return c.reconcile(name)
+}
+
+func (c *VolumeGroupSnapshotController) reconcile(name string) (err error) {
+	groupSnapshot, err := c.ds.GetVolumeGroupSnapshot(name)
+	if err != nil {
+		if !datastore.ErrorIsNotFound(err) {
+			return err
+		}
+		return nil
+	}
+
+	if !c.isResponsibleFor(groupSnapshot) {
+		return nil
+	}
+
+	log := getLoggerForVolumeGroupSnapshot(c.logger, groupSnapshot)
+
+	if groupSnapshot.Status.OwnerID != c.controllerID {
+		groupSnapshot.Status.OwnerID = c.controllerID
+		groupSnapshot, err = c.ds.UpdateVolumeGroupSnapshotStatus(groupSnapshot)
+		if err != nil {
+			// we don't mind others coming first
+			if datastore.ErrorIsConflict(errors.Cause(err)) {
+				return nil
+			}
+			return err
+		}
+		log.Infof("Volume group snapshot got new owner %v", c.controllerID)
+	}
+
+	if !groupSnapshot.DeletionTimestamp.IsZero() {
+		return c.handleVolumeGroupSnapshotDeletion(groupSnapshot, log)
+	}
+
+	existingGroupSnapshot := groupSnapshot.DeepCopy()
+	defer func() {
+		if err != nil {
+			return
+		}
+		if reflect.DeepEqual(existingGroupSnapshot.Status, groupSnapshot.Status) {
+			return
+		}
+		if _, err = c.ds.UpdateVolumeGroupSnapshotStatus(groupSnapshot); err != nil && datastore.ErrorIsConflict(errors.Cause(err)) {
+			log.WithError(err).Debugf("Requeue %v due to conflict", name)
+			c.enqueueVolumeGroupSnapshot(groupSnapshot)
+			err = nil
+		}
+	}()
+
+	switch groupSnapshot.Status.State {
+	case longhorn.VolumeGroupSnapshotStateNone:
+		return c.startVolumeGroupSnapshot(groupSnapshot, log)
+	case longhorn.VolumeGroupSnapshotStateQuiesced:
+		return c.requestMemberSnapshots(groupSnapshot, log)
+	case longhorn.VolumeGroupSnapshotStateInProgress:
+		return c.checkVolumeGroupSnapshot(groupSnapshot, log)
+	case longhorn.VolumeGroupSnapshotStateReady:
+		return c.syncVolumeGroupSnapshotReadyToUse(groupSnapshot)
+	}
+	return nil
+}
+
+// startVolumeGroupSnapshot quiesces the workloads of the member volumes by the pre snapshot hooks,
+// then requests the member snapshots back-to-back so that they are taken in one coordinated window.
+// The completion of the pre snapshot hooks is recorded before requesting the member snapshots, so that the hooks
+// are not executed again if the reconciliation is retried.
+func (c *VolumeGroupSnapshotController) startVolumeGroupSnapshot(groupSnapshot *longhorn.VolumeGroupSnapshot, log logrus.FieldLogger) error {
+	for _, volumeName := range groupSnapshot.Spec.Volumes {
+		volume, err := c.ds.GetVolumeRO(volumeName)
+		if err != nil {
+			if datastore.ErrorIsNotFound(err) {
+				c.markVolumeGroupSnapshotError(groupSnapshot, fmt.Sprintf("cannot find member volume %v", volumeName))
+				return nil
+			}
+			return err
+		}
+		if !volume.DeletionTimestamp.IsZero() {
+			c.markVolumeGroupSnapshotError(groupSnapshot, fmt.Sprintf("member volume %v is being deleted", volumeName))
+			return nil
+		}
+	}
+
+	log.Info("Quiescing the workloads of the member volumes")
+	if err := c.runHooks(groupSnapshot, longhorn.SnapshotHookTypePre); err != nil {
+		c.resumeWorkloads(groupSnapshot, log)
+		c.markVolumeGroupSnapshotError(groupSnapshot, err.Error())
+		return nil
+	}
+
+	groupSnapshot.Status.State = longhorn.VolumeGroupSnapshotStateQuiesced
+	if err := c.updateVolumeGroupSnapshotStatus(groupSnapshot); err != nil {
+		// Do not leave the workloads quiesced, the pre snapshot hooks are executed again on the retry
+		c.resumeWorkloads(groupSnapshot, log)
+		return errors.Wrap(err, "failed to record the completion of the pre snapshot hooks")
+	}
+
+	return c.requestMemberSnapshots(groupSnapshot, log)
+}
+
+// requestMemberSnapshots creates the member snapshots of the quiesced member volumes
+func (c *VolumeGroupSnapshotController) requestMemberSnapshots(groupSnapshot *longhorn.VolumeGroupSnapshot, log logrus.FieldLogger) error {
+	groupSnapshot.Status.Snapshots = map[string]string{}
+	for _, volumeName := range groupSnapshot.Spec.Volumes {
+		snapshotName, err := c.createMemberSnapshot(groupSnapshot, volumeName)
+		if err != nil {
+			c.resumeWorkloads(groupSnapshot, log)
+			c.markVolumeGroupSnapshotError(groupSnapshot, errors.Wrapf(err, "failed to create snapshot of member volume %v", volumeName).Error())
+			return nil
+		}
+		groupSnapshot.Status.Snapshots[volumeName] = snapshotName
+	}
+
+	log.Info("Requested the member snapshots")
+	groupSnapshot.Status.State = longhorn.VolumeGroupSnapshotStateInProgress
+	groupSnapshot.Status.CreationTime = util.Now()
+	return enqueueAfterDelay(c.queue, groupSnapshot, volumeGroupSnapshotPollInterval)
+}
+
+func (c *VolumeGroupSnapshotController) createMemberSnapshot(groupSnapshot *longhorn.VolumeGroupSnapshot, volumeName string) (string, error) {
+	snapshotName := types.GetVolumeGroupSnapshotMemberName(groupSnapshot.Name, volumeName)
+
+	snapshot, err := c.ds.GetSnapshotRO(snapshotName)
+	if err != nil && !datastore.ErrorIsNotFound(err) {
+		return "", err
+	}
+	if snapshot != nil && err == nil {
+		if snapshot.Spec.Volume != volumeName {
+			return "", fmt.Errorf("snapshot %v already exists for volume %v", snapshotName, snapshot.Spec.Volume)
+		}
+		return snapshotName, nil
+	}
+
+	if _, err := c.ds.CreateSnapshot(&longhorn.Snapshot{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:   snapshotName,
+			Labels: types.GetVolumeGroupSnapshotLabels(groupSnapshot.Name),
+		},
+		Spec: longhorn.SnapshotSpec{
+			Volume:         volumeName,
+			CreateSnapshot: true,
+			Labels:         groupSnapshot.Spec.Labels,
+		},
+	}); err != nil {
+		return "", err
+	}
+	return snapshotName, nil
+}
+
+// checkVolumeGroupSnapshot resumes the workloads of the member volumes once all member snapshots are created,
+// or once the member snapshots cannot be created in time.
+func (c *VolumeGroupSnapshotController) checkVolumeGroupSnapshot(groupSnapshot *longhorn.VolumeGroupSnapshot, log logrus.FieldLogger) error {
+	members, err := c.ds.ListVolumeGroupSnapshotMembersRO(groupSnapshot.Name)
+	if err != nil {
+		return err
+	}
+
+	pendingVolumes := []string{}
+	for volumeName, snapshotName := range groupSnapshot.Status.Snapshots {
+		snapshot, ok := members[snapshotName]
+		if !ok || !snapshot.DeletionTimestamp.IsZero() {
+			c.resumeWorkloads(groupSnapshot, log)
+			c.markVolumeGroupSnapshotError(groupSnapshot, fmt.Sprintf("snapshot %v of member volume %v is deleted before creation", snapshotName, volumeName))
+			return nil
+		}
+		if snapshot.Status.CreationTime == "" {
+			pendingVolumes = append(pendingVolumes, volumeName)
+		}
+	}
+
+	if len(pendingVolumes) == 0 {
+		log.Info("Created the member snapshots, resuming the workloads of the member volumes")
+		if err := c.runHooks(groupSnapshot, longhorn.SnapshotHookTypePost); err != nil {
+			c.markVolumeGroupSnapshotError(groupSnapshot, err.Error())
+			return nil
+		}
+		groupSnapshot.Status.State = longhorn.VolumeGroupSnapshotStateReady
+		groupSnapshot.Status.Error = ""
+		c.eventRecorder.Eventf(groupSnapshot, corev1.EventTypeNormal, constant.EventReasonCreate, "created the snapshots of member volumes %v", groupSnapshot.Spec.Volumes)
+		return c.syncVolumeGroupSnapshotReadyToUse(groupSnapshot)
+	}
+
+	creationTime, err := util.ParseTime(groupSnapshot.Status.CreationTime)
+	if err != nil {
+		return err
+	}
+	if time.Since(creationTime) > volumeGroupSnapshotCreationTimeout {
+		c.resumeWorkloads(groupSnapshot, log)
+		c.markVolumeGroupSnapshotError(groupSnapshot, fmt.Sprintf("timed out waiting for the snapshots of member volumes %v to be created", pendingVolumes))
+		return nil
+	}
+
+	return enqueueAfterDelay(c.queue, groupSnapshot, volumeGroupSnapshotPollInterval)
+}
+
+func (c *VolumeGroupSnapshotController) syncVolumeGroupSnapshotReadyToUse(groupSnapshot *longhorn.VolumeGroupSnapshot) error {
+	members, err := c.ds.ListVolumeGroupSnapshotMembersRO(groupSnapshot.Name)
+	if err != nil {
+		return err
+	}
+
+	readyToUse := len(groupSnapshot.Status.Snapshots) > 0
+	for _, snapshotName := range groupSnapshot.Status.Snapshots {
+		snapshot, ok := members[snapshotName]
+		if !ok || !snapshot.Status.ReadyToUse {
+			readyToUse = false
+			break
+		}
+	}
+	groupSnapshot.Status.ReadyToUse = readyToUse
+	return nil
+}
+
+func (c *VolumeGroupSnapshotController) handleVolumeGroupSnapshotDeletion(groupSnapshot *longhorn.VolumeGroupSnapshot, log logrus.FieldLogger) error {
+	if groupSnapshot.Status.State == longhorn.VolumeGroupSnapshotStateQuiesced ||
+		groupSnapshot.Status.State == longhorn.VolumeGroupSnapshotStateInProgress {
+		// Do not leave the workloads quiesced
+		c.resumeWorkloads(groupSnapshot, log)
+	}
+
+	members, err := c.ds.ListVolumeGroupSnapshotMembersRO(groupSnapshot.Name)
+	if err != nil {
+		return err
+	}
+	for _, snapshot := range members {
+		if !snapshot.DeletionTimestamp.IsZero() {
+			continue
+		}
+		log.Infof("Deleting member snapshot %v of volume %v", snapshot.Name, snapshot.Spec.Volume)
+		if err := c.ds.DeleteSnapshot(snapshot.Name); err != nil && !datastore.ErrorIsNotFound(err) {
+			return errors.Wrapf(err, "failed to delete member snapshot %v", snapshot.Name)
+		}
+	}
+
+	return c.ds.RemoveFinalizerForVolumeGroupSnapshot(groupSnapshot)
+}
+
+// updateVolumeGroupSnapshotStatus persists the status in the middle of the reconciliation, and refreshes the object
+// so that the deferred status update does not conflict
+func (c *VolumeGroupSnapshotController) updateVolumeGroupSnapshotStatus(groupSnapshot *longhorn.VolumeGroupSnapshot) error {
+	updated, err := c.ds.UpdateVolumeGroupSnapshotStatus(groupSnapshot)
+	if err != nil {
+		return err
+	}
+	updated.DeepCopyInto(groupSnapshot)
+	return nil
+}
+
+func (c *VolumeGroupSnapshotController) runHooks(groupSnapshot *longhorn.VolumeGroupSnapshot, hookType longhorn.SnapshotHookType) error {
+	results, err := c.hookRunner.run(groupSnapshot, groupSnapshot.Spec.Volumes, hookType)
+	groupSnapshot.Status.HookResults = append(groupSnapshot.Status.HookResults, results...)
+	return err
+}
+
+// resumeWorkloads runs the post snapshot hooks after a failure, the hook errors are only logged since
+// the volume group snapshot already failed.
+func (c *VolumeGroupSnapshotController) resumeWorkloads(groupSnapshot *longhorn.VolumeGroupSnapshot, log logrus.FieldLogger) {
+	if err := c.runHooks(groupSnapshot, longhorn.SnapshotHookTypePost); err != nil {
+		log.WithError(err).Warn("Failed to resume the workloads of the member volumes")
+	}
+}
+
+func (c *VolumeGroupSnapshotController) markVolumeGroupSnapshotError(groupSnapshot *longhorn.VolumeGroupSnapshot, message string) {
+	groupSnapshot.Status.State = longhorn.VolumeGroupSnapshotStateError
+	groupSnapshot.Status.Error = message
+	c.eventRecorder.Event(groupSnapshot, corev1.EventTypeWarning, constant.EventReasonFailed, message)
+}
+
+// isVolumeGroupSnapshotMember returns true if the snapshot is created as a member of a volume group snapshot
+func isVolumeGroupSnapshotMember(snapshot *longhorn.Snapshot) bool {
+	return snapshot.Labels[types.GetLonghornLabelKey(types.LonghornLabelVolumeGroupSnapshot)] != ""
+}
@@ -9,6 +9,7 @@ import (
 	"os"
 	"reflect"
 	"regexp"
+	"sort"
 	"strconv"
 	"strings"
 	"time"
@@ -54,6 +55,7 @@ const (
 
 type ControllerServer struct {
 	csi.UnimplementedControllerServer
+	csi.UnimplementedGroupControllerServer
 	apiClient   *longhornclient.RancherClient
 	nodeID      string
 	caps        []*csi.ControllerServiceCapability
@@ -91,6 +93,12 @@ func NewControllerServer(apiClient *longhornclient.RancherClient, nodeID string)
 				csi.ControllerServiceCapability_RPC_CLONE_VOLUME,
 				csi.ControllerServiceCapability_RPC_GET_CAPACITY,
 				csi.ControllerServiceCapability_RPC_SINGLE_NODE_MULTI_WRITER,
+				csi.ControllerServiceCapability_RPC_LIST_VOLUMES,
+				csi.ControllerServiceCapability_RPC_LIST_SNAPSHOTS,
+				csi.ControllerServiceCapability_RPC_LIST_VOLUMES_PUBLISHED_NODES,
+				csi.ControllerServiceCapability_RPC_VOLUME_CONDITION,
+				csi.ControllerServiceCapability_RPC_GET_VOLUME,
+				csi.ControllerServiceCapability_RPC_MODIFY_VOLUME,
 			}),
 		accessModes: getVolumeCapabilityAccessModes(
 			[]csi.VolumeCapability_AccessMode_Mode{
@@ -696,8 +704,86 @@ func (cs *ControllerServer) unpublishVolume(volume *longhornclient.Volume, nodeI
 	return &csi.ControllerUnpublishVolumeResponse{}, nil
 }
 
-func (cs *ControllerServer) ListVolumes(context.Context, *csi.ListVolumesRequest) (*csi.ListVolumesResponse, error) {
-	return nil, status.Error(codes.Unimplemented, "")
+func (cs *ControllerServer) ListVolumes(ctx context.Context, req *csi.ListVolumesRequest) (*csi.ListVolumesResponse, error) {
+	log := cs.log.WithFields(logrus.Fields{"function": "ListVolumes"})
+
+	log.Tracef("ListVolumes is called with req %+v", req)
+
+	if req.GetMaxEntries() < 0 {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid max entries %v", req.GetMaxEntries())
+	}
+
+	volumeList, err := cs.apiClient.Volume.List(&longhornclient.ListOpts{})
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	volumes := volumeList.Data
+	sort.Slice(volumes, func(i, j int) bool {
+		return volumes[i].Name < volumes[j].Name
+	})
+
+	start, end, nextToken, err := paginate(len(volumes), req.GetStartingToken(), req.GetMaxEntries())
+	if err != nil {
+		return nil, err
+	}
+
+	entries := make([]*csi.ListVolumesResponse_Entry, 0, end-start)
+	for _, vol := range volumes[start:end] {
+		size, err := util.ConvertSize(vol.Size)
+		if err != nil {
+			log.WithError(err).Warnf("Failed to parse size %v of volume %v", vol.Size, vol.Name)
+		}
+		entries = append(entries, &csi.ListVolumesResponse_Entry{
+			Volume: &csi.Volume{
+				VolumeId:      vol.Name,
+				CapacityBytes: size,
+			},
+			Status: &csi.ListVolumesResponse_VolumeStatus{
+				PublishedNodeIds: getPublishedNodeIDs(&vol),
+				VolumeCondition:  getVolumeCondition(&vol),
+			},
+		})
+	}
+
+	return &csi.ListVolumesResponse{
+		Entries:   entries,
+		NextToken: nextToken,
+	}, nil
+}
+
+// getPublishedNodeIDs returns the nodes the volume has been published to by the CSI attacher
+func getPublishedNodeIDs(vol *longhornclient.Volume) []string {
+	nodeIDs := []string{}
+	for _, attachment := range vol.VolumeAttachment.Attachments {
+		if attachment.AttachmentType != string(longhorn.AttacherTypeCSIAttacher) || !attachment.Satisfied {
+			continue
+		}
+		if attachment.NodeID == "" || util.Contains(nodeIDs, attachment.NodeID) {
+			continue
+		}
+		nodeIDs = append(nodeIDs, attachment.NodeID)
+	}
+	sort.Strings(nodeIDs)
+	return nodeIDs
+}
+
+// paginate returns the range of a sorted list of total entries to be returned for the given starting token and max
+// entries, and the token of the next page. The token is the index of the first entry of the page.
+func paginate(total int, startingToken string, maxEntries int32) (start, end int, nextToken string, err error) {
+	if startingToken != "" {
+		start, err = strconv.Atoi(startingToken)
+		if err != nil || start < 0 || start > total {
+			return 0, 0, "", status.Errorf(codes.Aborted, "invalid starting token %v", startingToken)
+		}
+	}
+
+	end = total
+	if maxEntries > 0 && start+int(maxEntries) < total {
+		end = start + int(maxEntries)
+		nextToken = strconv.Itoa(end)
+	}
+	return start, end, nextToken, nil
 }
 
 func (cs *ControllerServer) GetCapacity(ctx context.Context, req *csi.GetCapacityRequest) (*csi.GetCapacityResponse, error) {
@@ -758,6 +844,10 @@ func (cs *ControllerServer) GetCapacity(ctx context.Context, req *csi.GetCapacit
 	if err != nil {
 		return nil, status.Errorf(codes.Internal, "failed to get setting %v: %v", types.SettingNameAllowEmptyDiskSelectorVolume, err)
 	}
+	diskTiers, err := types.ParseDiskTiers(scParameters[types.OptionDiskTiers])
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid parameter %v: %v", types.OptionDiskTiers, err)
+	}
 	overProvisioningPercentage, err := cs.getSettingAsInt(ctx, types.SettingNameStorageOverProvisioningPercentage)
 	if err != nil {
 		return nil, status.Errorf(codes.Internal, "failed to get setting %v: %v", types.SettingNameStorageOverProvisioningPercentage, err)
@@ -779,6 +869,9 @@ func (cs *ControllerServer) GetCapacity(ctx context.Context, req *csi.GetCapacit
 		if !types.IsSelectorsInTags(diskSpec.Tags, diskSelector, allowEmptyDiskSelectorVolume) {
 			continue
 		}
+		if !types.IsDiskTierInTiers(diskStatus.Tier, diskTiers) {
+			continue
+		}
 
 		overProvisionLimit := ((diskStatus.StorageMaximum - diskSpec.StorageReserved) * overProvisioningPercentage) / 100
 		storageSchedulable := overProvisionLimit - diskStatus.StorageScheduled
@@ -1245,6 +1338,9 @@ func (cs *ControllerServer) DeleteSnapshot(ctx context.Context, req *csi.DeleteS
 			return nil, status.Errorf(codes.NotFound, "volume source snapshot %v is not found", snapshotID)
 		}
 		if err := cs.cleanupBackup(sourceVolumeName, id); err != nil {
+			if _, ok := status.FromError(err); ok {
+				return nil, err
+			}
 			return nil, status.Error(codes.Internal, err.Error())
 		}
 	}
@@ -1292,6 +1388,12 @@ func (cs *ControllerServer) cleanupBackup(sourceVolumeName, id string) error {
 		// The bv is there for backward compatibility.
 		// Any bv will work
 		if bv.Name != "" {
+			// Failing to get the backup probably means it is already deleted, which is handled by BackupDelete
+			backup, err := cs.apiClient.BackupVolume.ActionBackupGet(bv, &longhornclient.BackupInput{Name: backupName})
+			if err == nil && isBackupRetentionLocked(backup) {
+				return status.Errorf(codes.FailedPrecondition, "backup %v is retention locked until %v", backupName, backup.RetentionLockedUntil)
+			}
+
 			_, err = cs.apiClient.BackupVolume.ActionBackupDelete(bv, &longhornclient.BackupInput{
 				Name: backupName,
 			})
@@ -1305,8 +1407,203 @@ func (cs *ControllerServer) cleanupBackup(sourceVolumeName, id string) error {
 	return nil
 }
 
-func (cs *ControllerServer) ListSnapshots(context.Context, *csi.ListSnapshotsRequest) (*csi.ListSnapshotsResponse, error) {
-	return nil, status.Error(codes.Unimplemented, "")
+func (cs *ControllerServer) ListSnapshots(ctx context.Context, req *csi.ListSnapshotsRequest) (*csi.ListSnapshotsResponse, error) {
+	log := cs.log.WithFields(logrus.Fields{"function": "ListSnapshots"})
+
+	log.Tracef("ListSnapshots is called with req %+v", req)
+
+	if req.GetMaxEntries() < 0 {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid max entries %v", req.GetMaxEntries())
+	}
+
+	snapshots, err := cs.listCSISnapshots(req.GetSourceVolumeId(), req.GetSnapshotId())
+	if err != nil {
+		return nil, err
+	}
+	sort.Slice(snapshots, func(i, j int) bool {
+		return snapshots[i].SnapshotId < snapshots[j].SnapshotId
+	})
+
+	start, end, nextToken, err := paginate(len(snapshots), req.GetStartingToken(), req.GetMaxEntries())
+	if err != nil {
+		return nil, err
+	}
+
+	entries := make([]*csi.ListSnapshotsResponse_Entry, 0, end-start)
+	for _, snapshot := range snapshots[start:end] {
+		entries = append(entries, &csi.ListSnapshotsResponse_Entry{Snapshot: snapshot})
+	}
+
+	return &csi.ListSnapshotsResponse{
+		Entries:   entries,
+		NextToken: nextToken,
+	}, nil
+}
+
+// listCSISnapshots collects the Longhorn snapshots, backups and backing images exposed as CSI snapshots. The result is
+// limited to the given source volume and snapshot ID if they are not empty.
+func (cs *ControllerServer) listCSISnapshots(sourceVolumeName, snapshotID string) ([]*csi.Snapshot, error) {
+	listSnapshot, listBackup, listBackingImage := true, true, true
+	if snapshotID != "" {
+		csiSnapshotType, snapshotVolumeName, _ := decodeSnapshotID(snapshotID)
+		if csiSnapshotType == csiSnapshotTypeLonghornBackingImage {
+			snapshotVolumeName = decodeSnapshoBackingImageID(snapshotID)[longhorn.DataSourceTypeExportParameterVolumeName]
+		}
+		if csiSnapshotType == "" || (sourceVolumeName != "" && sourceVolumeName != snapshotVolumeName) {
+			return []*csi.Snapshot{}, nil
+		}
+		sourceVolumeName = snapshotVolumeName
+		listSnapshot = csiSnapshotType == csiSnapshotTypeLonghornSnapshot
+		listBackup = csiSnapshotType == csiSnapshotTypeLonghornBackup
+		listBackingImage = csiSnapshotType == csiSnapshotTypeLonghornBackingImage
+	}
+
+	snapshots := []*csi.Snapshot{}
+	if listSnapshot {
+		list, err := cs.listCSISnapshotsTypeLonghornSnapshot(sourceVolumeName)
+		if err != nil {
+			return nil, err
+		}
+		snapshots = append(snapshots, list...)
+	}
+	if listBackup {
+		list, err := cs.listCSISnapshotsTypeLonghornBackup(sourceVolumeName)
+		if err != nil {
+			return nil, err
+		}
+		snapshots = append(snapshots, list...)
+	}
+	if listBackingImage {
+		list, err := cs.listCSISnapshotsTypeLonghornBackingImage(sourceVolumeName)
+		if err != nil {
+			return nil, err
+		}
+		snapshots = append(snapshots, list...)
+	}
+
+	if snapshotID == "" {
+		return snapshots, nil
+	}
+	for _, snapshot := range snapshots {
+		if snapshot.SnapshotId == snapshotID {
+			return []*csi.Snapshot{snapshot}, nil
+		}
+	}
+	return []*csi.Snapshot{}, nil
+}
+
+func (cs *ControllerServer) listCSISnapshotsTypeLonghornSnapshot(sourceVolumeName string) ([]*csi.Snapshot, error) {
+	volumes := []longhornclient.Volume{}
+	if sourceVolumeName != "" {
+		vol, err := cs.apiClient.Volume.ById(sourceVolumeName)
+		if err != nil {
+			return nil, status.Error(codes.Internal, err.Error())
+		}
+		if vol != nil {
+			volumes = append(volumes, *vol)
+		}
+	} else {
+		volumeList, err := cs.apiClient.Volume.List(&longhornclient.ListOpts{})
+		if err != nil {
+			return nil, status.Error(codes.Internal, err.Error())
+		}
+		volumes = volumeList.Data
+	}
+
+	snapshots := []*csi.Snapshot{}
+	for i := range volumes {
+		snapshotCRs, err := cs.apiClient.Volume.ActionSnapshotCRList(&volumes[i])
+		if err != nil {
+			return nil, status.Error(codes.Internal, err.Error())
+		}
+		for _, snapshotCR := range snapshotCRs.Data {
+			// System snapshots are not created by the CSI snapshotter and cannot be used as a data source.
+			if !snapshotCR.UserCreated {
+				continue
+			}
+			snapshotID := encodeSnapshotID(csiSnapshotTypeLonghornSnapshot, volumes[i].Name, snapshotCR.Name)
+			snapshots = append(snapshots, createSnapshotResponseForSnapshotTypeLonghornSnapshot(volumes[i].Name, snapshotID, &snapshotCR).Snapshot)
+		}
+	}
+	return snapshots, nil
+}
+
+func (cs *ControllerServer) listCSISnapshotsTypeLonghornBackup(sourceVolumeName string) ([]*csi.Snapshot, error) {
+	backupVolumeList, err := cs.apiClient.BackupVolume.List(&longhornclient.ListOpts{})
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	snapshots := []*csi.Snapshot{}
+	listed := map[string]bool{}
+	for i, bv := range backupVolumeList.Data {
+		if sourceVolumeName != "" && bv.VolumeName != sourceVolumeName {
+			continue
+		}
+		backupList, err := cs.apiClient.BackupVolume.ActionBackupList(&backupVolumeList.Data[i])
+		if err != nil {
+			return nil, status.Error(codes.Internal, err.Error())
+		}
+		for _, backup := range backupList.Data {
+			volumeName := backup.VolumeName
+			if volumeName == "" {
+				volumeName = bv.VolumeName
+			}
+			if sourceVolumeName != "" && volumeName != sourceVolumeName {
+				continue
+			}
+			snapshotID := encodeSnapshotID(csiSnapshotTypeLonghornBackup, volumeName, backup.Name)
+			if listed[snapshotID] {
+				continue
+			}
+			listed[snapshotID] = true
+			snapshots = append(snapshots, createSnapshotResponseForSnapshotTypeLonghornBackup(volumeName, snapshotID,
+				backup.SnapshotCreated, backup.VolumeSize, backup.State == string(longhorn.BackupStateCompleted)).Snapshot)
+		}
+	}
+	return snapshots, nil
+}
+
+func (cs *ControllerServer) listCSISnapshotsTypeLonghornBackingImage(sourceVolumeName string) ([]*csi.Snapshot, error) {
+	backingImageList, err := cs.apiClient.BackingImage.List(&longhornclient.ListOpts{})
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	snapshots := []*csi.Snapshot{}
+	for _, bi := range backingImageList.Data {
+		if bi.SourceType != string(longhorn.BackingImageDataSourceTypeExportFromVolume) {
+			continue
+		}
+		volumeName := bi.Parameters[longhorn.DataSourceTypeExportParameterVolumeName]
+		if sourceVolumeName != "" && volumeName != sourceVolumeName {
+			continue
+		}
+		exportType := bi.Parameters[longhorn.DataSourceTypeExportParameterExportType]
+		snapshots = append(snapshots, &csi.Snapshot{
+			SizeBytes:      getBackingImageSnapshotSize(&bi),
+			SnapshotId:     encodeSnapshotBackingImageID(bi.Name, exportType, volumeName),
+			SourceVolumeId: volumeName,
+			ReadyToUse:     isBackingImageReady(&bi),
+		})
+	}
+	return snapshots, nil
+}
+
+func getBackingImageSnapshotSize(bi *longhornclient.BackingImage) int64 {
+	if bi.VirtualSize > 0 {
+		return bi.VirtualSize
+	}
+	return bi.Size
+}
+
+func isBackingImageReady(bi *longhornclient.BackingImage) bool {
+	for _, fileStatus := range bi.DiskFileStatusMap {
+		if fileStatus.State == string(longhorn.BackingImageStateReady) {
+			return true
+		}
+	}
+	return false
 }
 
 func (cs *ControllerServer) ControllerExpandVolume(ctx context.Context, req *csi.ControllerExpandVolumeRequest) (*csi.ControllerExpandVolumeResponse, error) {
@@ -1400,8 +1697,35 @@ func (cs *ControllerServer) ControllerExpandVolume(ctx context.Context, req *csi
 	}, nil
 }
 
-func (cs *ControllerServer) ControllerGetVolume(context.Context, *csi.ControllerGetVolumeRequest) (*csi.ControllerGetVolumeResponse, error) {
-	return nil, status.Error(codes.Unimplemented, "")
+func (cs *ControllerServer) ControllerGetVolume(ctx context.Context, req *csi.ControllerGetVolumeRequest) (*csi.ControllerGetVolumeResponse, error) {
+	volumeID := req.GetVolumeId()
+	if len(volumeID) == 0 {
+		return nil, status.Error(codes.InvalidArgument, "volume id missing in request")
+	}
+
+	vol, err := cs.apiClient.Volume.ById(volumeID)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+	if vol == nil {
+		return nil, status.Errorf(codes.NotFound, "volume %s not found", volumeID)
+	}
+
+	size, err := util.ConvertSize(vol.Size)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to parse size %v of volume %v: %v", vol.Size, volumeID, err)
+	}
+
+	return &csi.ControllerGetVolumeResponse{
+		Volume: &csi.Volume{
+			VolumeId:      vol.Name,
+			CapacityBytes: size,
+		},
+		Status: &csi.ControllerGetVolumeResponse_VolumeStatus{
+			PublishedNodeIds: getPublishedNodeIDs(vol),
+			VolumeCondition:  getVolumeCondition(vol),
+		},
+	}, nil
 }
 
 // isVolumeAvailableOn checks that the volume is attached and that an engine is running on the requested node
@@ -1644,7 +1968,127 @@ func (cs *ControllerServer) ControllerModifyVolume(ctx context.Context, req *csi
 	log := cs.log.WithFields(logrus.Fields{"function": "ControllerModifyVolume"})
 	log.Infof("ControllerModifyVolume: called with args %v", req)
 
-	return nil, status.Error(codes.Unimplemented, "")
+	volumeID := req.GetVolumeId()
+	if len(volumeID) == 0 {
+		return nil, status.Error(codes.InvalidArgument, "volume id missing in request")
+	}
+
+	modification, err := getVolumeModification(req.GetMutableParameters())
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	vol, err := cs.apiClient.Volume.ById(volumeID)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+	if vol == nil {
+		return nil, status.Errorf(codes.NotFound, "volume %s not found", volumeID)
+	}
+
+	if modification.replicaRebuildingBandwidthLimit != nil {
+		if err := types.ValidateReplicaRebuildingBandwidthLimit(longhorn.DataEngineType(vol.DataEngine), *modification.replicaRebuildingBandwidthLimit); err != nil {
+			return nil, status.Error(codes.InvalidArgument, errors.Wrap(err, "invalid parameter replicaRebuildingBandwidthLimit").Error())
+		}
+	}
+
+	if err := cs.modifyVolume(vol, modification); err != nil {
+		log.WithError(err).Errorf("Failed to modify volume %v", volumeID)
+		return nil, err
+	}
+
+	return &csi.ControllerModifyVolumeResponse{}, nil
+}
+
+// modifyVolume applies the requested modification through the volume update actions. Unchanged fields are skipped so
+// that retried requests are idempotent.
+func (cs *ControllerServer) modifyVolume(vol *longhornclient.Volume, modification *volumeModification) error {
+	log := cs.log.WithFields(logrus.Fields{"function": "modifyVolume", "volume": vol.Name})
+
+	doAction := func(action string, update func() (*longhornclient.Volume, error)) error {
+		if _, ok := vol.Actions[action]; !ok {
+			return status.Errorf(codes.Unavailable, "action %v is not available for volume %v in state %v", action, vol.Name, vol.State)
+		}
+		log.Infof("Modifying volume with action %v", action)
+		updated, err := update()
+		if err != nil {
+			return status.Error(codes.Internal, errors.Wrapf(err, "failed to %v for volume %v", action, vol.Name).Error())
+		}
+		vol = updated
+		return nil
+	}
+
+	if modification.numberOfReplicas != nil && *modification.numberOfReplicas != vol.NumberOfReplicas {
+		if err := doAction("updateReplicaCount", func() (*longhornclient.Volume, error) {
+			return cs.apiClient.Volume.ActionUpdateReplicaCount(vol, &longhornclient.UpdateReplicaCountInput{
+				ReplicaCount: *modification.numberOfReplicas,
+			})
+		}); err != nil {
+			return err
+		}
+	}
+
+	if modification.dataLocality != nil && *modification.dataLocality != vol.DataLocality {
+		if err := doAction("updateDataLocality", func() (*longhornclient.Volume, error) {
+			return cs.apiClient.Volume.ActionUpdateDataLocality(vol, &longhornclient.UpdateDataLocalityInput{
+				DataLocality: *modification.dataLocality,
+			})
+		}); err != nil {
+			return err
+		}
+	}
+
+	if modification.replicaAutoBalance != nil && *modification.replicaAutoBalance != vol.ReplicaAutoBalance {
+		if err := doAction("updateReplicaAutoBalance", func() (*longhornclient.Volume, error) {
+			return cs.apiClient.Volume.ActionUpdateReplicaAutoBalance(vol, &longhornclient.UpdateReplicaAutoBalanceInput{
+				ReplicaAutoBalance: *modification.replicaAutoBalance,
+			})
+		}); err != nil {
+			return err
+		}
+	}
+
+	if modification.snapshotMaxCount != nil && *modification.snapshotMaxCount != vol.SnapshotMaxCount {
+		if err := doAction("updateSnapshotMaxCount", func() (*longhornclient.Volume, error) {
+			return cs.apiClient.Volume.ActionUpdateSnapshotMaxCount(vol, &longhornclient.UpdateSnapshotMaxCountInput{
+				SnapshotMaxCount: *modification.snapshotMaxCount,
+			})
+		}); err != nil {
+			return err
+		}
+	}
+
+	if modification.snapshotMaxSize != nil && strconv.FormatInt(*modification.snapshotMaxSize, 10) != vol.SnapshotMaxSize {
+		if err := doAction("updateSnapshotMaxSize", func() (*longhornclient.Volume, error) {
+			return cs.apiClient.Volume.ActionUpdateSnapshotMaxSize(vol, &longhornclient.UpdateSnapshotMaxSizeInput{
+				SnapshotMaxSize: strconv.FormatInt(*modification.snapshotMaxSize, 10),
+			})
+		}); err != nil {
+			return err
+		}
+	}
+
+	if modification.backupTargetName != nil && *modification.backupTargetName != vol.BackupTargetName {
+		if err := doAction("updateBackupTargetName", func() (*longhornclient.Volume, error) {
+			return cs.apiClient.Volume.ActionUpdateBackupTargetName(vol, &longhornclient.UpdateBackupTargetInput{
+				BackupTargetName: *modification.backupTargetName,
+			})
+		}); err != nil {
+			return err
+		}
+	}
+
+	if modification.replicaRebuildingBandwidthLimit != nil && *modification.replicaRebuildingBandwidthLimit != vol.ReplicaRebuildingBandwidthLimit {
+		if err := doAction("updateReplicaRebuildingBandwidthLimit", func() (*longhornclient.Volume, error) {
+			return cs.apiClient.Volume.ActionUpdateReplicaRebuildingBandwidthLimit(vol, &longhornclient.UpdateReplicaRebuildingBandwidthLimitInput{
+				ReplicaRebuildingBandwidthLimit: strconv.FormatInt(*modification.replicaRebuildingBandwidthLimit, 10),
+			})
+		}); err != nil {
+			return err
+		}
+	}
+
+	return nil
 }
 
 // getAccessibleTopologyFromRequirements converts AccessibilityRequirements from CreateVolumeRequest
@@ -15,6 +15,7 @@ import (
 
 	"github.com/longhorn/longhorn-manager/types"
 
+	longhornclient "github.com/longhorn/longhorn-manager/client"
 	longhorn "github.com/longhorn/longhorn-manager/k8s/pkg/apis/longhorn/v1beta2"
 	lhfake "github.com/longhorn/longhorn-manager/k8s/pkg/client/clientset/versioned/fake"
 )
@@ -323,6 +324,86 @@ func TestParseNodeID(t *testing.T) {
 	}
 }
 
+func TestPaginate(t *testing.T) {
+	for _, test := range []struct {
+		name          string
+		total         int
+		startingToken string
+		maxEntries    int32
+		start         int
+		end           int
+		nextToken     string
+		err           error
+	}{
+		{
+			name:  "all entries",
+			total: 5,
+			end:   5,
+		},
+		{
+			name:       "first page",
+			total:      5,
+			maxEntries: 2,
+			end:        2,
+			nextToken:  "2",
+		},
+		{
+			name:          "middle page",
+			total:         5,
+			startingToken: "2",
+			maxEntries:    2,
+			start:         2,
+			end:           4,
+			nextToken:     "4",
+		},
+		{
+			name:          "last page",
+			total:         5,
+			startingToken: "4",
+			maxEntries:    2,
+			start:         4,
+			end:           5,
+		},
+		{
+			name:          "invalid starting token",
+			total:         5,
+			startingToken: "abc",
+			err:           status.Errorf(codes.Aborted, "invalid starting token abc"),
+		},
+		{
+			name:          "starting token out of range",
+			total:         5,
+			startingToken: "6",
+			err:           status.Errorf(codes.Aborted, "invalid starting token 6"),
+		},
+	} {
+		start, end, nextToken, err := paginate(test.total, test.startingToken, test.maxEntries)
+		checkError(t, test.err, err)
+		if start != test.start || end != test.end || nextToken != test.nextToken {
+			t.Errorf("%s: expected start %d, end %d, next token %q, but got start %d, end %d, next token %q",
+				test.name, test.start, test.end, test.nextToken, start, end, nextToken)
+		}
+	}
+}
+
+func TestGetPublishedNodeIDs(t *testing.T) {
+	vol := &longhornclient.Volume{
+		VolumeAttachment: longhornclient.VolumeAttachment{
+			Attachments: map[string]longhornclient.Attachment{
+				"csi-b": {AttachmentType: string(longhorn.AttacherTypeCSIAttacher), NodeID: "node-b", Satisfied: true},
+				"csi-a": {AttachmentType: string(longhorn.AttacherTypeCSIAttacher), NodeID: "node-a", Satisfied: true},
+				"csi-c": {AttachmentType: string(longhorn.AttacherTypeCSIAttacher), NodeID: "node-c", Satisfied: false},
+				"ui":    {AttachmentType: string(longhorn.AttacherTypeLonghornAPI), NodeID: "node-d", Satisfied: true},
+			},
+		},
+	}
+
+	nodeIDs := getPublishedNodeIDs(vol)
+	if strings.Join(nodeIDs, ",") != "node-a,node-b" {
+		t.Errorf("expected published nodes node-a,node-b, but got: %v", nodeIDs)
+	}
+}
+
 func checkError(t *testing.T, expected, actual error) {
 	if expected == nil {
 		if actual != nil {
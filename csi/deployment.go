@@ -196,7 +196,9 @@ func NewResizerDeployment(namespace, serviceAccount, resizerImage, rootDir strin
 			// https://github.com/longhorn/longhorn/issues/10411#issuecomment-2655252262
 			// TODO: Investigate and fix potential cause of the failure if we want
 			// to use this feature.
-			"--feature-gates=RecoverVolumeExpansionFailure=false",
+			// VolumeAttributesClass lets the resizer call ControllerModifyVolume when the
+			// VolumeAttributesClass of a PVC changes.
+			"--feature-gates=RecoverVolumeExpansionFailure=false,VolumeAttributesClass=true",
 		},
 		int32(replicaCount),
 		podAntiAffinityPreset,
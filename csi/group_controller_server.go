@@ -0,0 +1,202 @@
+package csi
+
+import (
+	"context"
+	"fmt"
+	"reflect"
+	"sort"
+	"time"
+
+	"github.com/container-storage-interface/spec/lib/go/csi"
+	"github.com/sirupsen/logrus"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	longhorn "github.com/longhorn/longhorn-manager/k8s/pkg/apis/longhorn/v1beta2"
+)
+
+// The GroupControllerServer creates the CSI volume group snapshots as Longhorn VolumeGroupSnapshots.
+// The group snapshot ID is the name of the VolumeGroupSnapshot, and the member snapshot IDs are
+// the Longhorn snapshot type IDs of the member snapshots.
+
+func (cs *ControllerServer) GroupControllerGetCapabilities(ctx context.Context, req *csi.GroupControllerGetCapabilitiesRequest) (*csi.GroupControllerGetCapabilitiesResponse, error) {
+	return &csi.GroupControllerGetCapabilitiesResponse{
+		Capabilities: []*csi.GroupControllerServiceCapability{
+			{
+				Type: &csi.GroupControllerServiceCapability_Rpc{
+					Rpc: &csi.GroupControllerServiceCapability_RPC{
+						Type: csi.GroupControllerServiceCapability_RPC_CREATE_DELETE_GET_VOLUME_GROUP_SNAPSHOT,
+					},
+				},
+			},
+		},
+	}, nil
+}
+
+func (cs *ControllerServer) CreateVolumeGroupSnapshot(ctx context.Context, req *csi.CreateVolumeGroupSnapshotRequest) (*csi.CreateVolumeGroupSnapshotResponse, error) {
+	log := cs.log.WithFields(logrus.Fields{"function": "CreateVolumeGroupSnapshot"})
+
+	groupSnapshotName := req.GetName()
+	volumeNames := req.GetSourceVolumeIds()
+	if len(groupSnapshotName) == 0 {
+		return nil, status.Error(codes.InvalidArgument, "Group snapshot name must be provided")
+	} else if len(volumeNames) == 0 {
+		return nil, status.Error(codes.InvalidArgument, "Source volume IDs must be provided")
+	}
+
+	groupSnapshot, err := cs.lhClient.LonghornV1beta2().VolumeGroupSnapshots(cs.lhNamespace).Get(ctx, groupSnapshotName, metav1.GetOptions{})
+	if err != nil {
+		if !apierrors.IsNotFound(err) {
+			return nil, status.Error(codes.Internal, err.Error())
+		}
+
+		log.Infof("Creating volume group snapshot %v of volumes %v", groupSnapshotName, volumeNames)
+		groupSnapshot, err = cs.lhClient.LonghornV1beta2().VolumeGroupSnapshots(cs.lhNamespace).Create(ctx, &longhorn.VolumeGroupSnapshot{
+			ObjectMeta: metav1.ObjectMeta{
+				Name: groupSnapshotName,
+			},
+			Spec: longhorn.VolumeGroupSnapshotSpec{
+				Volumes: volumeNames,
+				Labels:  req.GetParameters(),
+			},
+		}, metav1.CreateOptions{})
+		if err != nil {
+			return nil, status.Error(codes.Internal, err.Error())
+		}
+	} else if !isSameVolumeSet(groupSnapshot.Spec.Volumes, volumeNames) {
+		return nil, status.Errorf(codes.AlreadyExists, "volume group snapshot %v already exists with different volumes %v", groupSnapshotName, groupSnapshot.Spec.Volumes)
+	}
+
+	groupSnapshot, err = cs.waitForVolumeGroupSnapshotToBeCreated(groupSnapshotName)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	csiGroupSnapshot, err := cs.getCSIVolumeGroupSnapshot(ctx, groupSnapshot)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+	return &csi.CreateVolumeGroupSnapshotResponse{GroupSnapshot: csiGroupSnapshot}, nil
+}
+
+func (cs *ControllerServer) DeleteVolumeGroupSnapshot(ctx context.Context, req *csi.DeleteVolumeGroupSnapshotRequest) (*csi.DeleteVolumeGroupSnapshotResponse, error) {
+	groupSnapshotName := req.GetGroupSnapshotId()
+	if len(groupSnapshotName) == 0 {
+		return nil, status.Error(codes.InvalidArgument, "missing group snapshot id in request")
+	}
+
+	// The member snapshots are deleted along with the volume group snapshot
+	err := cs.lhClient.LonghornV1beta2().VolumeGroupSnapshots(cs.lhNamespace).Delete(ctx, groupSnapshotName, metav1.DeleteOptions{})
+	if err != nil && !apierrors.IsNotFound(err) {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &csi.DeleteVolumeGroupSnapshotResponse{}, nil
+}
+
+func (cs *ControllerServer) GetVolumeGroupSnapshot(ctx context.Context, req *csi.GetVolumeGroupSnapshotRequest) (*csi.GetVolumeGroupSnapshotResponse, error) {
+	groupSnapshotName := req.GetGroupSnapshotId()
+	if len(groupSnapshotName) == 0 {
+		return nil, status.Error(codes.InvalidArgument, "missing group snapshot id in request")
+	}
+
+	groupSnapshot, err := cs.lhClient.LonghornV1beta2().VolumeGroupSnapshots(cs.lhNamespace).Get(ctx, groupSnapshotName, metav1.GetOptions{})
+	if err != nil {
+		if apierrors.IsNotFound(err) {
+			return nil, status.Errorf(codes.NotFound, "volume group snapshot %v is not found", groupSnapshotName)
+		}
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	csiGroupSnapshot, err := cs.getCSIVolumeGroupSnapshot(ctx, groupSnapshot)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+	return &csi.GetVolumeGroupSnapshotResponse{GroupSnapshot: csiGroupSnapshot}, nil
+}
+
+func (cs *ControllerServer) getCSIVolumeGroupSnapshot(ctx context.Context, groupSnapshot *longhorn.VolumeGroupSnapshot) (*csi.VolumeGroupSnapshot, error) {
+	creationTime, err := toProtoTimestamp(groupSnapshot.Status.CreationTime)
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse creation time %v of volume group snapshot %v: %v", groupSnapshot.Status.CreationTime, groupSnapshot.Name, err)
+	}
+
+	csiGroupSnapshot := &csi.VolumeGroupSnapshot{
+		GroupSnapshotId: groupSnapshot.Name,
+		CreationTime:    creationTime,
+		ReadyToUse:      groupSnapshot.Status.ReadyToUse,
+	}
+
+	volumeNames := make([]string, 0, len(groupSnapshot.Status.Snapshots))
+	for volumeName := range groupSnapshot.Status.Snapshots {
+		volumeNames = append(volumeNames, volumeName)
+	}
+	sort.Strings(volumeNames)
+
+	for _, volumeName := range volumeNames {
+		snapshotName := groupSnapshot.Status.Snapshots[volumeName]
+		snapshot, err := cs.lhClient.LonghornV1beta2().Snapshots(cs.lhNamespace).Get(ctx, snapshotName, metav1.GetOptions{})
+		if err != nil {
+			return nil, fmt.Errorf("failed to get snapshot %v of volume %v in volume group snapshot %v: %v", snapshotName, volumeName, groupSnapshot.Name, err)
+		}
+
+		snapshotCreationTime, err := toProtoTimestamp(snapshot.Status.CreationTime)
+		if err != nil {
+			logrus.WithError(err).Errorf("Failed to parse creation time %v for csi snapshot %v", snapshot.Status.CreationTime, snapshotName)
+		}
+
+		csiGroupSnapshot.Snapshots = append(csiGroupSnapshot.Snapshots, &csi.Snapshot{
+			SizeBytes:       snapshot.Status.RestoreSize,
+			SnapshotId:      encodeSnapshotID(csiSnapshotTypeLonghornSnapshot, volumeName, snapshotName),
+			SourceVolumeId:  volumeName,
+			CreationTime:    snapshotCreationTime,
+			ReadyToUse:      snapshot.Status.ReadyToUse,
+			GroupSnapshotId: groupSnapshot.Name,
+		})
+	}
+
+	return csiGroupSnapshot, nil
+}
+
+func (cs *ControllerServer) waitForVolumeGroupSnapshotToBeCreated(groupSnapshotName string) (*longhorn.VolumeGroupSnapshot, error) {
+	timer := time.NewTimer(timeoutSnapshotCreation)
+	defer timer.Stop()
+	timeout := timer.C
+
+	ticker := time.NewTicker(tickSnapshotCreation)
+	defer ticker.Stop()
+	tick := ticker.C
+
+	for {
+		select {
+		case <-timeout:
+			return nil, fmt.Errorf("waitForVolumeGroupSnapshotToBeCreated: timeout while waiting for volume group snapshot %v to be created", groupSnapshotName)
+		case <-tick:
+			groupSnapshot, err := cs.lhClient.LonghornV1beta2().VolumeGroupSnapshots(cs.lhNamespace).Get(context.TODO(), groupSnapshotName, metav1.GetOptions{})
+			if err != nil {
+				return nil, fmt.Errorf("waitForVolumeGroupSnapshotToBeCreated: error while waiting for volume group snapshot %v to be created: %v", groupSnapshotName, err)
+			}
+			switch groupSnapshot.Status.State {
+			case longhorn.VolumeGroupSnapshotStateReady:
+				return groupSnapshot, nil
+			case longhorn.VolumeGroupSnapshotStateError:
+				return nil, fmt.Errorf("waitForVolumeGroupSnapshotToBeCreated: volume group snapshot %v failed: %v", groupSnapshotName, groupSnapshot.Status.Error)
+			}
+		}
+	}
+}
+
+func isSameVolumeSet(volumeNames, otherVolumeNames []string) bool {
+	set := map[string]struct{}{}
+	for _, volumeName := range volumeNames {
+		set[volumeName] = struct{}{}
+	}
+	otherSet := map[string]struct{}{}
+	for _, volumeName := range otherVolumeNames {
+		otherSet[volumeName] = struct{}{}
+	}
+	return reflect.DeepEqual(set, otherSet)
+}
@@ -0,0 +1,160 @@
+package csi
+
+import (
+	"context"
+	"testing"
+
+	"github.com/container-storage-interface/spec/lib/go/csi"
+	"github.com/sirupsen/logrus"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	longhorn "github.com/longhorn/longhorn-manager/k8s/pkg/apis/longhorn/v1beta2"
+	lhfake "github.com/longhorn/longhorn-manager/k8s/pkg/client/clientset/versioned/fake"
+)
+
+func TestGetVolumeGroupSnapshot(t *testing.T) {
+	cs := &ControllerServer{
+		lhNamespace: "longhorn-system-test",
+		log:         logrus.StandardLogger().WithField("component", "test-get-volume-group-snapshot"),
+	}
+	for _, test := range []struct {
+		testName           string
+		groupSnapshot      *longhorn.VolumeGroupSnapshot
+		snapshots          []*longhorn.Snapshot
+		groupSnapshotID    string
+		expectedCode       codes.Code
+		expectedSnapshots  []string
+		expectedReadyToUse bool
+	}{
+		{
+			testName:        "missing group snapshot id",
+			groupSnapshotID: "",
+			expectedCode:    codes.InvalidArgument,
+		},
+		{
+			testName:        "group snapshot not found",
+			groupSnapshotID: "group-0",
+			expectedCode:    codes.NotFound,
+		},
+		{
+			testName:        "group snapshot with member snapshots",
+			groupSnapshotID: "group-0",
+			groupSnapshot:   newVolumeGroupSnapshot("group-0", map[string]string{"vol-1": "snap-1", "vol-0": "snap-0"}, true),
+			snapshots: []*longhorn.Snapshot{
+				newGroupMemberSnapshot("snap-0", true),
+				newGroupMemberSnapshot("snap-1", true),
+			},
+			expectedCode:       codes.OK,
+			expectedSnapshots:  []string{"snap://vol-0/snap-0", "snap://vol-1/snap-1"},
+			expectedReadyToUse: true,
+		},
+		{
+			testName:        "group snapshot with missing member snapshot",
+			groupSnapshotID: "group-0",
+			groupSnapshot:   newVolumeGroupSnapshot("group-0", map[string]string{"vol-0": "snap-0", "vol-1": "snap-1"}, true),
+			snapshots: []*longhorn.Snapshot{
+				newGroupMemberSnapshot("snap-0", true),
+			},
+			expectedCode: codes.Internal,
+		},
+	} {
+		t.Run(test.testName, func(t *testing.T) {
+			cs.lhClient = lhfake.NewSimpleClientset() // nolint: staticcheck
+			if test.groupSnapshot != nil {
+				if _, err := cs.lhClient.LonghornV1beta2().VolumeGroupSnapshots(cs.lhNamespace).Create(context.TODO(), test.groupSnapshot, metav1.CreateOptions{}); err != nil {
+					t.Fatalf("failed to create volume group snapshot: %v", err)
+				}
+			}
+			for _, snapshot := range test.snapshots {
+				if _, err := cs.lhClient.LonghornV1beta2().Snapshots(cs.lhNamespace).Create(context.TODO(), snapshot, metav1.CreateOptions{}); err != nil {
+					t.Fatalf("failed to create snapshot: %v", err)
+				}
+			}
+
+			res, err := cs.GetVolumeGroupSnapshot(context.TODO(), &csi.GetVolumeGroupSnapshotRequest{
+				GroupSnapshotId: test.groupSnapshotID,
+			})
+			if code := status.Code(err); code != test.expectedCode {
+				t.Fatalf("expected error code: %v, but got: %v (%v)", test.expectedCode, code, err)
+			}
+			if err != nil {
+				return
+			}
+
+			if res.GroupSnapshot.GroupSnapshotId != test.groupSnapshotID {
+				t.Errorf("expected group snapshot id: %v, but got: %v", test.groupSnapshotID, res.GroupSnapshot.GroupSnapshotId)
+			}
+			if res.GroupSnapshot.ReadyToUse != test.expectedReadyToUse {
+				t.Errorf("expected ready to use: %v, but got: %v", test.expectedReadyToUse, res.GroupSnapshot.ReadyToUse)
+			}
+			if len(res.GroupSnapshot.Snapshots) != len(test.expectedSnapshots) {
+				t.Fatalf("expected %v snapshots, but got: %v", len(test.expectedSnapshots), len(res.GroupSnapshot.Snapshots))
+			}
+			for i, snapshot := range res.GroupSnapshot.Snapshots {
+				if snapshot.SnapshotId != test.expectedSnapshots[i] {
+					t.Errorf("expected snapshot id: %v, but got: %v", test.expectedSnapshots[i], snapshot.SnapshotId)
+				}
+				if snapshot.GroupSnapshotId != test.groupSnapshotID {
+					t.Errorf("expected group snapshot id of snapshot %v: %v, but got: %v", snapshot.SnapshotId, test.groupSnapshotID, snapshot.GroupSnapshotId)
+				}
+			}
+		})
+	}
+}
+
+func TestIsSameVolumeSet(t *testing.T) {
+	for _, test := range []struct {
+		testName     string
+		volumeNames  []string
+		otherNames   []string
+		expectedSame bool
+	}{
+		{"same order", []string{"vol-0", "vol-1"}, []string{"vol-0", "vol-1"}, true},
+		{"different order", []string{"vol-0", "vol-1"}, []string{"vol-1", "vol-0"}, true},
+		{"different volumes", []string{"vol-0", "vol-1"}, []string{"vol-0", "vol-2"}, false},
+		{"subset", []string{"vol-0", "vol-1"}, []string{"vol-0"}, false},
+	} {
+		t.Run(test.testName, func(t *testing.T) {
+			if same := isSameVolumeSet(test.volumeNames, test.otherNames); same != test.expectedSame {
+				t.Errorf("expected %v, but got: %v", test.expectedSame, same)
+			}
+		})
+	}
+}
+
+func newVolumeGroupSnapshot(name string, snapshots map[string]string, readyToUse bool) *longhorn.VolumeGroupSnapshot {
+	volumes := []string{}
+	for volumeName := range snapshots {
+		volumes = append(volumes, volumeName)
+	}
+	return &longhorn.VolumeGroupSnapshot{
+		ObjectMeta: metav1.ObjectMeta{
+			Name: name,
+		},
+		Spec: longhorn.VolumeGroupSnapshotSpec{
+			Volumes: volumes,
+		},
+		Status: longhorn.VolumeGroupSnapshotStatus{
+			State:        longhorn.VolumeGroupSnapshotStateReady,
+			Snapshots:    snapshots,
+			CreationTime: "2024-01-01T00:00:00Z",
+			ReadyToUse:   readyToUse,
+		},
+	}
+}
+
+func newGroupMemberSnapshot(name string, readyToUse bool) *longhorn.Snapshot {
+	return &longhorn.Snapshot{
+		ObjectMeta: metav1.ObjectMeta{
+			Name: name,
+		},
+		Status: longhorn.SnapshotStatus{
+			CreationTime: "2024-01-01T00:00:00Z",
+			ReadyToUse:   readyToUse,
+			RestoreSize:  1024,
+		},
+	}
+}
@@ -7,6 +7,7 @@ import (
 	"net/url"
 	"os"
 	"path/filepath"
+	"slices"
 	"strconv"
 	"strings"
 
@@ -94,6 +95,7 @@ func NewNodeServer(apiClient *longhornclient.RancherClient, nodeID string) (*Nod
 				csi.NodeServiceCapability_RPC_STAGE_UNSTAGE_VOLUME,
 				csi.NodeServiceCapability_RPC_EXPAND_VOLUME,
 				csi.NodeServiceCapability_RPC_SINGLE_NODE_MULTI_WRITER,
+				csi.NodeServiceCapability_RPC_VOLUME_CONDITION,
 			}),
 		log:         logrus.StandardLogger().WithField("component", "csi-node-server"),
 		lhNamespace: lhNamespace,
@@ -263,7 +265,7 @@ func (ns *NodeServer) collectWorkloadPodsStatus(volume *longhornclient.Volume, l
 	return podsStatus
 }
 
-func (ns *NodeServer) nodeStageSharedVolume(volumeID, shareEndpoint, targetPath string, mounter mount.Interface, customMountOptions []string) error {
+func (ns *NodeServer) nodeStageSharedVolume(volumeID, shareEndpoint, targetPath string, mounter mount.Interface, customMountOptions []string, nfsMountOptions longhornclient.NfsMountOptions) error {
 	log := ns.log.WithFields(logrus.Fields{"function": "nodeStageSharedVolume"})
 
 	isMnt, err := ensureMountPoint(targetPath, mounter)
@@ -289,8 +291,13 @@ func (ns *NodeServer) nodeStageSharedVolume(volumeID, shareEndpoint, targetPath
 	exportPath := uri.Path
 	export := fmt.Sprintf("%s:%s", server, exportPath)
 
+	nfsVersion := "4.1"
+	if nfsMountOptions.NfsVersion != "" {
+		nfsVersion = nfsMountOptions.NfsVersion
+	}
+
 	defaultMountOptions := []string{
-		"vers=4.1",
+		"vers=" + nfsVersion,
 		"noresvport",
 		//"sync",    // sync mode is prohibitively expensive on the client, so we allow for host defaults
 		//"intr",
@@ -300,9 +307,17 @@ func (ns *NodeServer) nodeStageSharedVolume(volumeID, shareEndpoint, targetPath
 		"retrans=5", // We try the io operation for a total of 5 times, before failing
 	}
 
+	if nfsMountOptions.ReadOnly {
+		defaultMountOptions = append(defaultMountOptions, "ro")
+	}
+
 	mountOptions := append(defaultMountOptions, []string{"softerr"}...)
 	if len(customMountOptions) != 0 {
+		// The custom mount options replace the default ones, but a read-only volume share stays read-only
 		mountOptions = customMountOptions
+		if nfsMountOptions.ReadOnly && !slices.Contains(mountOptions, "ro") {
+			mountOptions = append(mountOptions, "ro")
+		}
 	}
 
 	log.Infof("Mounting shared volume %v on node %v via share endpoint %v with mount options %v", volumeID, ns.nodeID, shareEndpoint, mountOptions)
@@ -490,7 +505,7 @@ func (ns *NodeServer) NodeStageVolume(ctx context.Context, req *csi.NodeStageVol
 			mountOptions = strings.Split(req.VolumeContext["nfsOptions"], ",")
 		}
 
-		if err := ns.nodeStageSharedVolume(volumeID, volume.ShareEndpoint, stagingTargetPath, mounter, mountOptions); err != nil {
+		if err := ns.nodeStageSharedVolume(volumeID, volume.ShareEndpoint, stagingTargetPath, mounter, mountOptions, volume.NfsMountOptions); err != nil {
 			return nil, err
 		}
 
@@ -696,6 +711,11 @@ func (ns *NodeServer) NodeGetVolumeStats(ctx context.Context, req *csi.NodeGetVo
 		if errors.Is(err, unix.ENOENT) {
 			return nil, status.Errorf(codes.NotFound, "volume %v is not mounted on path %v", volumeID, volumePath)
 		}
+		if mount.IsCorruptedMnt(err) {
+			return &csi.NodeGetVolumeStatsResponse{
+				VolumeCondition: getMountPointCondition(volumeID, volumePath, err),
+			}, nil
+		}
 		return nil, status.Errorf(codes.Internal, "failed to check volume mode for volume path %v: %v", volumePath, err)
 	}
 
@@ -711,6 +731,7 @@ func (ns *NodeServer) NodeGetVolumeStats(ctx context.Context, req *csi.NodeGetVo
 					Unit:  csi.VolumeUsage_BYTES,
 				},
 			},
+			VolumeCondition: getVolumeCondition(existVol),
 		}, nil
 	}
 
@@ -721,6 +742,11 @@ func (ns *NodeServer) NodeGetVolumeStats(ctx context.Context, req *csi.NodeGetVo
 		if errors.Is(err, unix.ENOENT) {
 			return nil, status.Errorf(codes.NotFound, "volume %v is not mounted on path %v", volumeID, volumePath)
 		}
+		if mount.IsCorruptedMnt(err) {
+			return &csi.NodeGetVolumeStatsResponse{
+				VolumeCondition: getMountPointCondition(volumeID, volumePath, err),
+			}, nil
+		}
 		return nil, status.Errorf(codes.Internal, "failed to retrieve capacity statistics for volume path %v for volume %v: %v", volumePath, volumeID, err)
 	}
 
@@ -739,6 +765,7 @@ func (ns *NodeServer) NodeGetVolumeStats(ctx context.Context, req *csi.NodeGetVo
 				Unit:      csi.VolumeUsage_INODES,
 			},
 		},
+		VolumeCondition: getVolumeCondition(existVol),
 	}, nil
 }
 
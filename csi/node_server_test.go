@@ -0,0 +1,62 @@
+package csi
+
+import (
+	"path/filepath"
+	"testing"
+
+	"github.com/sirupsen/logrus"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	mount "k8s.io/mount-utils"
+
+	longhornclient "github.com/longhorn/longhorn-manager/client"
+)
+
+func TestNodeStageSharedVolumeMountOptions(t *testing.T) {
+	tests := map[string]struct {
+		customMountOptions   []string
+		nfsMountOptions      longhornclient.NfsMountOptions
+		expectedMountOptions []string
+	}{
+		"default mount options": {
+			expectedMountOptions: []string{"vers=4.1", "noresvport", "timeo=600", "retrans=5", "softerr"},
+		},
+		"read-only mount with nfs version": {
+			nfsMountOptions:      longhornclient.NfsMountOptions{ReadOnly: true, NfsVersion: "4.2"},
+			expectedMountOptions: []string{"vers=4.2", "noresvport", "timeo=600", "retrans=5", "ro", "softerr"},
+		},
+		"custom mount options": {
+			customMountOptions:   []string{"vers=4.2", "hard"},
+			nfsMountOptions:      longhornclient.NfsMountOptions{NfsVersion: "4.1"},
+			expectedMountOptions: []string{"vers=4.2", "hard"},
+		},
+		"read-only mount with custom mount options": {
+			customMountOptions:   []string{"vers=4.2", "hard"},
+			nfsMountOptions:      longhornclient.NfsMountOptions{ReadOnly: true},
+			expectedMountOptions: []string{"vers=4.2", "hard", "ro"},
+		},
+		"read-only mount with custom read-only mount options": {
+			customMountOptions:   []string{"vers=4.2", "ro"},
+			nfsMountOptions:      longhornclient.NfsMountOptions{ReadOnly: true},
+			expectedMountOptions: []string{"vers=4.2", "ro"},
+		},
+	}
+
+	ns := &NodeServer{nodeID: "node-1", log: logrus.StandardLogger().WithField("component", "test")}
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			mounter := mount.NewFakeMounter(nil)
+			targetPath := filepath.Join(t.TempDir(), "staging")
+
+			err := ns.nodeStageSharedVolume("vol-1", "nfs://10.0.0.1/vol-1", targetPath, mounter, tc.customMountOptions, tc.nfsMountOptions)
+			require.NoError(t, err)
+
+			mountPoints, err := mounter.List()
+			require.NoError(t, err)
+			require.Len(t, mountPoints, 1)
+			assert.Equal(t, "10.0.0.1:/vol-1", mountPoints[0].Device)
+			assert.Equal(t, tc.expectedMountOptions, mountPoints[0].Opts)
+		})
+	}
+}
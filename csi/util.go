@@ -8,6 +8,7 @@ import (
 	"os"
 	"path"
 	"path/filepath"
+	"sort"
 	"strconv"
 	"strings"
 	"time"
@@ -20,6 +21,7 @@ import (
 	"k8s.io/mount-utils"
 
 	utilexec "k8s.io/utils/exec"
+	"k8s.io/utils/ptr"
 
 	"github.com/longhorn/longhorn-manager/types"
 	"github.com/longhorn/longhorn-manager/util"
@@ -238,6 +240,31 @@ func getVolumeOptions(volumeID string, volOptions map[string]string) (*longhornc
 		vol.ReplicaDiskSoftAntiAffinity = replicaDiskSoftAntiAffinity
 	}
 
+	if replicaSchedulingPolicy, ok := volOptions["replicaSchedulingPolicy"]; ok {
+		if err := types.ValidateReplicaSchedulingPolicy(longhorn.ReplicaSchedulingPolicy(replicaSchedulingPolicy)); err != nil {
+			return nil, errors.Wrap(err, "invalid parameter replicaSchedulingPolicy")
+		}
+		vol.ReplicaSchedulingPolicy = replicaSchedulingPolicy
+	}
+
+	nfsMountOptions := longhorn.NFSMountOptions{
+		NFSVersion: volOptions["nfsMountVersion"],
+	}
+	if readOnly, ok := volOptions["nfsMountReadOnly"]; ok {
+		isReadOnly, err := strconv.ParseBool(readOnly)
+		if err != nil {
+			return nil, errors.Wrap(err, "invalid parameter nfsMountReadOnly")
+		}
+		nfsMountOptions.ReadOnly = isReadOnly
+	}
+	if err := types.ValidateNFSMountOptions(nfsMountOptions); err != nil {
+		return nil, errors.Wrap(err, "invalid NFS mount parameters")
+	}
+	vol.NfsMountOptions = longhornclient.NfsMountOptions{
+		ReadOnly:   nfsMountOptions.ReadOnly,
+		NfsVersion: nfsMountOptions.NFSVersion,
+	}
+
 	if fromBackup, ok := volOptions["fromBackup"]; ok {
 		vol.FromBackup = fromBackup
 	}
@@ -280,6 +307,30 @@ func getVolumeOptions(volumeID string, volOptions map[string]string) (*longhornc
 		vol.NodeSelector = strings.Split(nodeSelector, ",")
 	}
 
+	if diskTiers, ok := volOptions[types.OptionDiskTiers]; ok {
+		tiers, err := types.ParseDiskTiers(diskTiers)
+		if err != nil {
+			return nil, errors.Wrap(err, "invalid parameter diskTiers")
+		}
+		for _, tier := range tiers {
+			vol.DiskTiers = append(vol.DiskTiers, string(tier))
+		}
+	}
+
+	if replicaTopologySpread, ok := volOptions[types.OptionReplicaTopologySpread]; ok {
+		constraints, err := types.ParseReplicaTopologySpread(replicaTopologySpread)
+		if err != nil {
+			return nil, errors.Wrap(err, "invalid parameter replicaTopologySpread")
+		}
+		for _, constraint := range constraints {
+			vol.ReplicaTopologySpread = append(vol.ReplicaTopologySpread, longhornclient.ReplicaTopologySpreadConstraint{
+				TopologyKey: constraint.TopologyKey,
+				Mode:        string(constraint.Mode),
+				MaxSkew:     int64(constraint.MaxSkew),
+			})
+		}
+	}
+
 	vol.DataEngine = string(longhorn.DataEngineTypeV1)
 	if driver, ok := volOptions["dataEngine"]; ok {
 		vol.DataEngine = driver
@@ -558,6 +609,137 @@ func getFilesystemStatistics(volumePath string) (*volumeFilesystemStatistics, er
 	return volStats, nil
 }
 
+// volumeModification holds the parsed mutable parameters of a ControllerModifyVolume request. A nil field means the
+// parameter is not requested to be changed.
+type volumeModification struct {
+	numberOfReplicas                *int64
+	dataLocality                    *string
+	replicaAutoBalance              *string
+	snapshotMaxCount                *int64
+	snapshotMaxSize                 *int64
+	backupTargetName                *string
+	replicaRebuildingBandwidthLimit *int64
+}
+
+// mutableVolumeParameters are the volume parameters that can be changed by ControllerModifyVolume
+var mutableVolumeParameters = []string{
+	"backupTargetName",
+	"dataLocality",
+	"numberOfReplicas",
+	"replicaAutoBalance",
+	"replicaRebuildingBandwidthLimit",
+	"snapshotMaxCount",
+	"snapshotMaxSize",
+}
+
+func getVolumeModification(params map[string]string) (*volumeModification, error) {
+	modification := &volumeModification{}
+	for key, value := range params {
+		switch key {
+		case "numberOfReplicas":
+			count, err := strconv.Atoi(value)
+			if err != nil {
+				return nil, fmt.Errorf("invalid parameter numberOfReplicas %v", value)
+			}
+			if err := types.ValidateReplicaCount(count); err != nil {
+				return nil, errors.Wrap(err, "invalid parameter numberOfReplicas")
+			}
+			modification.numberOfReplicas = ptr.To(int64(count))
+		case "dataLocality":
+			if err := types.ValidateDataLocality(longhorn.DataLocality(value)); err != nil {
+				return nil, errors.Wrap(err, "invalid parameter dataLocality")
+			}
+			modification.dataLocality = ptr.To(value)
+		case "replicaAutoBalance":
+			if err := types.ValidateReplicaAutoBalance(longhorn.ReplicaAutoBalance(value)); err != nil {
+				return nil, errors.Wrap(err, "invalid parameter replicaAutoBalance")
+			}
+			modification.replicaAutoBalance = ptr.To(value)
+		case "snapshotMaxCount":
+			count, err := strconv.Atoi(value)
+			if err != nil {
+				return nil, fmt.Errorf("invalid parameter snapshotMaxCount %v", value)
+			}
+			if count < 2 || count > types.MaxSnapshotNum {
+				return nil, fmt.Errorf("invalid parameter snapshotMaxCount %v: should be between 2 and %v", value, types.MaxSnapshotNum)
+			}
+			modification.snapshotMaxCount = ptr.To(int64(count))
+		case "snapshotMaxSize":
+			size, err := util.ConvertSize(value)
+			if err != nil {
+				return nil, errors.Wrap(err, "invalid parameter snapshotMaxSize")
+			}
+			modification.snapshotMaxSize = ptr.To(size)
+		case "backupTargetName":
+			if value == "" {
+				return nil, fmt.Errorf("invalid parameter backupTargetName: cannot be empty")
+			}
+			modification.backupTargetName = ptr.To(value)
+		case "replicaRebuildingBandwidthLimit":
+			limit, err := util.ConvertSize(value)
+			if err != nil || limit < 0 {
+				return nil, fmt.Errorf("invalid parameter replicaRebuildingBandwidthLimit %v", value)
+			}
+			modification.replicaRebuildingBandwidthLimit = ptr.To(limit)
+		default:
+			return nil, fmt.Errorf("parameter %v cannot be modified, mutable parameters are %v", key, strings.Join(mutableVolumeParameters, ", "))
+		}
+	}
+	return modification, nil
+}
+
+// isBackupRetentionLocked returns true if the backup cannot be deleted from the backup target yet.
+func isBackupRetentionLocked(backup *longhornclient.Backup) bool {
+	if backup == nil || backup.RetentionLockedUntil == "" {
+		return false
+	}
+	lockedUntil, err := util.ParseTime(backup.RetentionLockedUntil)
+	if err != nil {
+		logrus.WithError(err).Warnf("Failed to parse the retention lock time %v of backup %v", backup.RetentionLockedUntil, backup.Name)
+		return false
+	}
+	return time.Now().Before(lockedUntil)
+}
+
+// getVolumeCondition reports the volume as abnormal if it is degraded or faulted, or if any of its replicas failed.
+func getVolumeCondition(vol *longhornclient.Volume) *csi.VolumeCondition {
+	reasons := []string{}
+	switch longhorn.VolumeRobustness(vol.Robustness) {
+	case longhorn.VolumeRobustnessDegraded, longhorn.VolumeRobustnessFaulted:
+		reasons = append(reasons, fmt.Sprintf("volume is %v", vol.Robustness))
+	}
+
+	failedReplicas := []string{}
+	for _, r := range vol.Replicas {
+		if r.FailedAt != "" {
+			failedReplicas = append(failedReplicas, r.Name)
+		}
+	}
+	if len(failedReplicas) > 0 {
+		sort.Strings(failedReplicas)
+		reasons = append(reasons, fmt.Sprintf("replicas %v failed", strings.Join(failedReplicas, ", ")))
+	}
+
+	if len(reasons) == 0 {
+		return &csi.VolumeCondition{
+			Abnormal: false,
+			Message:  fmt.Sprintf("volume %v is healthy", vol.Name),
+		}
+	}
+	return &csi.VolumeCondition{
+		Abnormal: true,
+		Message:  fmt.Sprintf("volume %v is abnormal: %v", vol.Name, strings.Join(reasons, "; ")),
+	}
+}
+
+// getMountPointCondition reports the volume as abnormal since the mount point at volumePath is corrupted.
+func getMountPointCondition(volumeID, volumePath string, err error) *csi.VolumeCondition {
+	return &csi.VolumeCondition{
+		Abnormal: true,
+		Message:  fmt.Sprintf("mount point %v of volume %v is corrupted: %v", volumePath, volumeID, err),
+	}
+}
+
 // makeFile creates an empty regular file.
 // If pathname already exists, whether a file or directory, no error is returned.
 func makeFile(pathname string) error {
@@ -7,6 +7,8 @@ import (
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 
+	"k8s.io/utils/ptr"
+
 	longhornclient "github.com/longhorn/longhorn-manager/client"
 	longhorn "github.com/longhorn/longhorn-manager/k8s/pkg/apis/longhorn/v1beta2"
 )
@@ -102,6 +104,32 @@ func TestGetVolumeOptions(t *testing.T) {
 				RevisionCounterDisabled: true,
 			},
 		},
+		"nfs mount options": {
+			volumeID: "test-vol-nfs-mount",
+			volumeOptions: map[string]string{
+				"share":            "true",
+				"nfsMountReadOnly": "true",
+				"nfsMountVersion":  "4.2",
+			},
+			expectedVolume: &longhornclient.Volume{
+				StaleReplicaTimeout:     defaultStaleReplicaTimeout,
+				AccessMode:              string(longhorn.AccessModeReadWriteMany),
+				DataEngine:              string(longhorn.DataEngineTypeV1),
+				RevisionCounterDisabled: true,
+				NfsMountOptions: longhornclient.NfsMountOptions{
+					ReadOnly:   true,
+					NfsVersion: "4.2",
+				},
+			},
+		},
+		"invalid nfs mount version": {
+			volumeID: "test-vol-nfs-mount-invalid",
+			volumeOptions: map[string]string{
+				"share":           "true",
+				"nfsMountVersion": "3",
+			},
+			expectedError: true,
+		},
 	}
 
 	for name, tc := range tests {
@@ -247,3 +275,125 @@ func TestRequiresSharedAccess(t *testing.T) {
 		})
 	}
 }
+
+func TestGetVolumeCondition(t *testing.T) {
+	tests := map[string]struct {
+		volume           *longhornclient.Volume
+		expectedAbnormal bool
+		expectedMessage  string
+	}{
+		"healthy": {
+			volume: &longhornclient.Volume{
+				Name:       "test-vol",
+				Robustness: string(longhorn.VolumeRobustnessHealthy),
+				Replicas:   []longhornclient.Replica{{Name: "r-1"}},
+			},
+			expectedMessage: "volume test-vol is healthy",
+		},
+		"detached": {
+			volume: &longhornclient.Volume{
+				Name:       "test-vol",
+				Robustness: string(longhorn.VolumeRobustnessUnknown),
+			},
+			expectedMessage: "volume test-vol is healthy",
+		},
+		"degraded with failed replicas": {
+			volume: &longhornclient.Volume{
+				Name:       "test-vol",
+				Robustness: string(longhorn.VolumeRobustnessDegraded),
+				Replicas: []longhornclient.Replica{
+					{Name: "r-2", FailedAt: "2024-01-01T00:00:00Z"},
+					{Name: "r-1", FailedAt: "2024-01-01T00:00:00Z"},
+					{Name: "r-3"},
+				},
+			},
+			expectedAbnormal: true,
+			expectedMessage:  "volume test-vol is abnormal: volume is degraded; replicas r-1, r-2 failed",
+		},
+		"faulted": {
+			volume: &longhornclient.Volume{
+				Name:       "test-vol",
+				Robustness: string(longhorn.VolumeRobustnessFaulted),
+			},
+			expectedAbnormal: true,
+			expectedMessage:  "volume test-vol is abnormal: volume is faulted",
+		},
+	}
+
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			condition := getVolumeCondition(tc.volume)
+			assert.Equal(t, tc.expectedAbnormal, condition.Abnormal)
+			assert.Equal(t, tc.expectedMessage, condition.Message)
+		})
+	}
+}
+
+func TestGetVolumeModification(t *testing.T) {
+	tests := map[string]struct {
+		params               map[string]string
+		expectedModification *volumeModification
+		expectedError        string
+	}{
+		"mutable parameters": {
+			params: map[string]string{
+				"numberOfReplicas":                "2",
+				"dataLocality":                    string(longhorn.DataLocalityBestEffort),
+				"replicaAutoBalance":              string(longhorn.ReplicaAutoBalanceLeastEffort),
+				"snapshotMaxCount":                "100",
+				"snapshotMaxSize":                 "10Gi",
+				"backupTargetName":                "default",
+				"replicaRebuildingBandwidthLimit": "100",
+			},
+			expectedModification: &volumeModification{
+				numberOfReplicas:                ptr.To(int64(2)),
+				dataLocality:                    ptr.To(string(longhorn.DataLocalityBestEffort)),
+				replicaAutoBalance:              ptr.To(string(longhorn.ReplicaAutoBalanceLeastEffort)),
+				snapshotMaxCount:                ptr.To(int64(100)),
+				snapshotMaxSize:                 ptr.To(int64(10 * 1024 * 1024 * 1024)),
+				backupTargetName:                ptr.To("default"),
+				replicaRebuildingBandwidthLimit: ptr.To(int64(100)),
+			},
+		},
+		"no parameters": {
+			params:               map[string]string{},
+			expectedModification: &volumeModification{},
+		},
+		"invalid data locality": {
+			params: map[string]string{
+				"dataLocality": "invalid",
+			},
+			expectedError: "invalid parameter dataLocality: invalid data locality mode: invalid",
+		},
+		"invalid snapshot max count": {
+			params: map[string]string{
+				"snapshotMaxCount": "abc",
+			},
+			expectedError: "invalid parameter snapshotMaxCount abc",
+		},
+		"snapshot max count out of range": {
+			params: map[string]string{
+				"snapshotMaxCount": "251",
+			},
+			expectedError: "invalid parameter snapshotMaxCount 251: should be between 2 and 250",
+		},
+		"immutable parameter": {
+			params: map[string]string{
+				"encrypted": "true",
+			},
+			expectedError: "parameter encrypted cannot be modified, mutable parameters are backupTargetName, dataLocality, numberOfReplicas, replicaAutoBalance, replicaRebuildingBandwidthLimit, snapshotMaxCount, snapshotMaxSize",
+		},
+	}
+
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			modification, err := getVolumeModification(tc.params)
+			if tc.expectedError != "" {
+				require.EqualError(t, err, tc.expectedError)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tc.expectedModification, modification)
+		})
+	}
+}
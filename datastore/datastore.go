@@ -92,6 +92,10 @@ type DataStore struct {
 	SystemRestoreInformer          cache.SharedInformer
 	lhVolumeAttachmentLister       lhlisters.VolumeAttachmentLister
 	LHVolumeAttachmentInformer     cache.SharedInformer
+	volumeGroupSnapshotLister      lhlisters.VolumeGroupSnapshotLister
+	VolumeGroupSnapshotInformer    cache.SharedInformer
+	drGroupLister                  lhlisters.DRGroupLister
+	DRGroupInformer                cache.SharedInformer
 
 	kubeClient                    clientset.Interface
 	podLister                     corelisters.PodLister
@@ -181,6 +185,10 @@ func NewDataStore(namespace string, lhClient lhclientset.Interface, kubeClient c
 	cacheSyncs = append(cacheSyncs, systemRestoreInformer.Informer().HasSynced)
 	lhVolumeAttachmentInformer := informerFactories.LhInformerFactory.Longhorn().V1beta2().VolumeAttachments()
 	cacheSyncs = append(cacheSyncs, lhVolumeAttachmentInformer.Informer().HasSynced)
+	volumeGroupSnapshotInformer := informerFactories.LhInformerFactory.Longhorn().V1beta2().VolumeGroupSnapshots()
+	cacheSyncs = append(cacheSyncs, volumeGroupSnapshotInformer.Informer().HasSynced)
+	drGroupInformer := informerFactories.LhInformerFactory.Longhorn().V1beta2().DRGroups()
+	cacheSyncs = append(cacheSyncs, drGroupInformer.Informer().HasSynced)
 
 	// Kube Informers
 	podInformer := informerFactories.KubeInformerFactory.Core().V1().Pods()
@@ -270,6 +278,10 @@ func NewDataStore(namespace string, lhClient lhclientset.Interface, kubeClient c
 		SystemRestoreInformer:          systemRestoreInformer.Informer(),
 		lhVolumeAttachmentLister:       lhVolumeAttachmentInformer.Lister(),
 		LHVolumeAttachmentInformer:     lhVolumeAttachmentInformer.Informer(),
+		volumeGroupSnapshotLister:      volumeGroupSnapshotInformer.Lister(),
+		VolumeGroupSnapshotInformer:    volumeGroupSnapshotInformer.Informer(),
+		drGroupLister:                  drGroupInformer.Lister(),
+		DRGroupInformer:                drGroupInformer.Informer(),
 
 		kubeClient:                    kubeClient,
 		podLister:                     podInformer.Lister(),
@@ -1092,9 +1092,14 @@ func (s *DataStore) GetKubernetesEndpointRO(name string) (*corev1.Endpoints, err
 func NewPVManifestForVolume(v *longhorn.Volume, pvName, storageClassName, fsType string) *corev1.PersistentVolume {
 	diskSelector := strings.Join(v.Spec.DiskSelector, ",")
 	nodeSelector := strings.Join(v.Spec.NodeSelector, ",")
+	diskTiers := []string{}
+	for _, tier := range v.Spec.DiskTiers {
+		diskTiers = append(diskTiers, string(tier))
+	}
 
 	volAttributes := map[string]string{
 		"diskSelector":        diskSelector,
+		"diskTiers":           strings.Join(diskTiers, ","),
 		"nodeSelector":        nodeSelector,
 		"numberOfReplicas":    strconv.Itoa(v.Spec.NumberOfReplicas),
 		"staleReplicaTimeout": strconv.Itoa(v.Spec.StaleReplicaTimeout),
@@ -1104,6 +1109,14 @@ func NewPVManifestForVolume(v *longhorn.Volume, pvName, storageClassName, fsType
 		volAttributes["encrypted"] = strconv.FormatBool(v.Spec.Encrypted)
 	}
 
+	if len(v.Spec.ReplicaTopologySpread) > 0 {
+		replicaTopologySpread := []string{}
+		for _, constraint := range v.Spec.ReplicaTopologySpread {
+			replicaTopologySpread = append(replicaTopologySpread, fmt.Sprintf("%v:%v:%v", constraint.TopologyKey, constraint.Mode, constraint.MaxSkew))
+		}
+		volAttributes[types.OptionReplicaTopologySpread] = strings.Join(replicaTopologySpread, ",")
+	}
+
 	accessMode := corev1.ReadWriteOnce
 	switch v.Spec.AccessMode {
 	case longhorn.AccessModeReadWriteMany:
@@ -9,6 +9,7 @@ import (
 	"net/url"
 	"reflect"
 	"regexp"
+	"sort"
 	"strconv"
 	"strings"
 	"time"
@@ -1091,6 +1092,32 @@ func (s *DataStore) GetAutoBalancedReplicasSetting(volume *longhorn.Volume, logg
 	return setting
 }
 
+// GetReplicaSchedulingPolicySetting returns the replica scheduling policy of the volume.
+// The global setting is used if the volume does not specify one.
+func (s *DataStore) GetReplicaSchedulingPolicySetting(volume *longhorn.Volume, logger *logrus.Entry) longhorn.ReplicaSchedulingPolicy {
+	var setting longhorn.ReplicaSchedulingPolicy
+
+	volumeSetting := volume.Spec.ReplicaSchedulingPolicy
+	if volumeSetting != longhorn.ReplicaSchedulingPolicyDefault {
+		setting = volumeSetting
+	}
+
+	if setting == "" {
+		globalSetting, _ := s.GetSettingValueExisted(types.SettingNameReplicaSchedulingPolicy)
+		setting = longhorn.ReplicaSchedulingPolicy(globalSetting)
+	}
+
+	if setting == "" || setting == longhorn.ReplicaSchedulingPolicyDefault {
+		return longhorn.ReplicaSchedulingPolicyBalanced
+	}
+
+	if err := types.ValidateReplicaSchedulingPolicy(setting); err != nil {
+		logger.WithError(err).Warnf("Falling back to replica scheduling policy %v", longhorn.ReplicaSchedulingPolicyBalanced)
+		return longhorn.ReplicaSchedulingPolicyBalanced
+	}
+	return setting
+}
+
 func (s *DataStore) GetVolumeSnapshotDataIntegrity(volumeName string) (longhorn.SnapshotDataIntegrity, error) {
 	volume, err := s.GetVolumeRO(volumeName)
 	if err != nil {
@@ -4834,6 +4861,10 @@ func (s *DataStore) syncDefaultBackupTargetResourceWithCustomizedParameters(cust
 	if !pollInterExists {
 		backupTarget.Spec.PollInterval = existingBackupTarget.Spec.PollInterval
 	}
+	// The retention lock period, replication target and backup window are not configurable in the ConfigMap.
+	backupTarget.Spec.RetentionLockPeriod = existingBackupTarget.Spec.RetentionLockPeriod
+	backupTarget.Spec.ReplicationTargetName = existingBackupTarget.Spec.ReplicationTargetName
+	backupTarget.Spec.BackupWindow = existingBackupTarget.Spec.BackupWindow
 	syncTime := metav1.Time{Time: time.Now().UTC()}
 	backupTarget.Spec.SyncRequestedAt = syncTime
 	existingBackupTarget.Spec.SyncRequestedAt = syncTime
@@ -5329,6 +5360,26 @@ func (s *DataStore) ListBackupsWithBackupTargetAndBackupVolumeRO(backupTargetNam
 	return itemMap, nil
 }
 
+// ListBackupsWithBackupTargetNameRO returns an object contains all read-only backups in the cluster Backups CR
+// of the given backup target name
+func (s *DataStore) ListBackupsWithBackupTargetNameRO(backupTargetName string) (map[string]*longhorn.Backup, error) {
+	selector, err := getBackupTargetSelector(backupTargetName)
+	if err != nil {
+		return nil, err
+	}
+
+	list, err := s.backupLister.Backups(s.namespace).List(selector)
+	if err != nil {
+		return nil, err
+	}
+
+	itemMap := map[string]*longhorn.Backup{}
+	for _, itemRO := range list {
+		itemMap[itemRO.Name] = itemRO
+	}
+	return itemMap, nil
+}
+
 // ListBackupsWithVolumeNameAndBackupTarget returns an object contains all backups in the cluster Backups CR
 // of the given volume name and backup target name
 func (s *DataStore) ListBackupsWithBackupVolumeName(backupTargetName, volumeName string) (map[string]*longhorn.Backup, error) {
@@ -5368,6 +5419,96 @@ func (s *DataStore) GetBackupRO(backupName string) (*longhorn.Backup, error) {
 	return s.backupLister.Backups(s.namespace).Get(backupName)
 }
 
+// GetReachableBackupRO returns the given backup if its backup target is available. Otherwise, it returns a
+// completed copy of the same backup on another available backup target, which is either the backup it was
+// replicated from or a replica of it, so that a volume can be restored from whichever backup target is reachable.
+// If there is no such copy, the given backup is returned.
+func (s *DataStore) GetReachableBackupRO(backup *longhorn.Backup) (*longhorn.Backup, error) {
+	available, err := s.isBackupTargetAvailable(backup.Status.BackupTargetName)
+	if err != nil || available {
+		return backup, err
+	}
+
+	origin := GetReplicatedBackupOrigin(backup)
+	backups, err := s.ListBackupsRO()
+	if err != nil {
+		return nil, err
+	}
+	sort.Slice(backups, func(i, j int) bool { return backups[i].Name < backups[j].Name })
+	for _, b := range backups {
+		if b.Name == backup.Name || b.Status.State != longhorn.BackupStateCompleted || b.Status.URL == "" {
+			continue
+		}
+		if GetReplicatedBackupOrigin(b) != origin {
+			continue
+		}
+		available, err := s.isBackupTargetAvailable(b.Status.BackupTargetName)
+		if err != nil {
+			return nil, err
+		}
+		if available {
+			return b, nil
+		}
+	}
+	return backup, nil
+}
+
+// GetReachableBackupBackingImageRO returns the given backup backing image if its backup target is available.
+// Otherwise, it returns a completed backup backing image of the same backing image and checksum on another available
+// backup target. If there is no such backup backing image, the given one is returned.
+func (s *DataStore) GetReachableBackupBackingImageRO(bbi *longhorn.BackupBackingImage) (*longhorn.BackupBackingImage, error) {
+	available, err := s.isBackupTargetAvailable(bbi.Spec.BackupTargetName)
+	if err != nil || available {
+		return bbi, err
+	}
+
+	bbis, err := s.ListBackupBackingImagesRO()
+	if err != nil {
+		return nil, err
+	}
+	sort.Slice(bbis, func(i, j int) bool { return bbis[i].Name < bbis[j].Name })
+	for _, other := range bbis {
+		if other.Name == bbi.Name || other.Spec.BackingImage != bbi.Spec.BackingImage || other.Status.State != longhorn.BackupStateCompleted {
+			continue
+		}
+		if bbi.Status.Checksum != "" && other.Status.Checksum != bbi.Status.Checksum {
+			continue
+		}
+		available, err := s.isBackupTargetAvailable(other.Spec.BackupTargetName)
+		if err != nil {
+			return nil, err
+		}
+		if available {
+			return other, nil
+		}
+	}
+	return bbi, nil
+}
+
+func (s *DataStore) isBackupTargetAvailable(backupTargetName string) (bool, error) {
+	backupTarget, err := s.GetBackupTargetRO(backupTargetName)
+	if err != nil {
+		if ErrorIsNotFound(err) {
+			return false, nil
+		}
+		return false, err
+	}
+	return backupTarget.Status.Available, nil
+}
+
+// GetReplicatedBackupOrigin returns the name of the backup the given backup was replicated from. A backup that is
+// not a replica is its own origin.
+func GetReplicatedBackupOrigin(backup *longhorn.Backup) string {
+	key := types.GetLonghornLabelKey(types.LonghornLabelReplicatedFromBackup)
+	if origin := backup.Status.Labels[key]; origin != "" {
+		return origin
+	}
+	if origin := backup.Spec.Labels[key]; origin != "" {
+		return origin
+	}
+	return backup.Name
+}
+
 // GetBackup returns a copy of Backup with the given backup name in the cluster
 func (s *DataStore) GetBackup(name string) (*longhorn.Backup, error) {
 	resultRO, err := s.GetBackupRO(name)
@@ -5407,6 +5548,44 @@ func (s *DataStore) DeleteBackup(backupName string) error {
 	return s.lhClient.LonghornV1beta2().Backups(s.namespace).Delete(context.TODO(), backupName, metav1.DeleteOptions{})
 }
 
+// IsBackupRetentionLocked returns true if the backup cannot be deleted from the backup target since its retention lock has not expired
+func IsBackupRetentionLocked(backup *longhorn.Backup) bool {
+	return time.Now().Before(backup.Status.RetentionLockedUntil.Time)
+}
+
+// GetBackupRetentionLockedUntil returns the later one of the retention lock time recorded in the backup labels and
+// the backup creation time plus the retention lock period of the backup target
+func GetBackupRetentionLockedUntil(backup *longhorn.Backup, backupTarget *longhorn.BackupTarget) time.Time {
+	lockedUntil := time.Time{}
+
+	labelKey := types.GetLonghornLabelKey(types.LonghornLabelBackupRetentionLockedUntil)
+	for _, backupLabels := range []map[string]string{backup.Spec.Labels, backup.Status.Labels} {
+		value, ok := backupLabels[labelKey]
+		if !ok {
+			continue
+		}
+		t, err := util.ParseTime(value)
+		if err != nil {
+			logrus.WithError(err).Warnf("Failed to parse label %v of backup %v", labelKey, backup.Name)
+			continue
+		}
+		if t.After(lockedUntil) {
+			lockedUntil = t
+		}
+	}
+
+	if backupTarget != nil && backupTarget.Spec.RetentionLockPeriod.Duration > 0 && backup.Status.BackupCreatedAt != "" {
+		createdAt, err := util.ParseTime(backup.Status.BackupCreatedAt)
+		if err != nil {
+			logrus.WithError(err).Warnf("Failed to parse creation time %v of backup %v", backup.Status.BackupCreatedAt, backup.Name)
+		} else if t := createdAt.Add(backupTarget.Spec.RetentionLockPeriod.Duration); t.After(lockedUntil) {
+			lockedUntil = t
+		}
+	}
+
+	return lockedUntil
+}
+
 // DeleteAllBackupsForBackupVolumeWithBackupTarget won't result in immediately deletion since finalizer was set by default
 func (s *DataStore) DeleteAllBackupsForBackupVolumeWithBackupTarget(backuptargetName, volumeName string) error {
 	return s.lhClient.LonghornV1beta2().Backups(s.namespace).DeleteCollection(context.TODO(), metav1.DeleteOptions{}, metav1.ListOptions{
@@ -5683,17 +5862,67 @@ func ValidateRecurringJob(job longhorn.RecurringJobSpec) error {
 			return err
 		}
 	}
+	if job.RetentionPolicy != nil {
+		if err := ValidateRecurringJobRetentionPolicy(job.Task, job.RetentionPolicy); err != nil {
+			return err
+		}
+	}
 	return nil
 }
 
+func ValidateRecurringJobRetentionPolicy(task longhorn.RecurringJobType, policy *longhorn.RecurringJobRetentionPolicy) error {
+	buckets := []struct {
+		name  string
+		count int
+	}{
+		{"hourly", policy.Hourly},
+		{"daily", policy.Daily},
+		{"weekly", policy.Weekly},
+		{"monthly", policy.Monthly},
+		{"yearly", policy.Yearly},
+	}
+	for _, bucket := range buckets {
+		if bucket.count < 0 {
+			return fmt.Errorf("invalid %v retention count %v: must not be negative", bucket.name, bucket.count)
+		}
+	}
+
+	if GetRecurringJobRetentionPolicyCount(policy) == 0 {
+		return nil
+	}
+
+	switch task {
+	case longhorn.RecurringJobTypeSnapshot, longhorn.RecurringJobTypeSnapshotForceCreate,
+		longhorn.RecurringJobTypeBackup, longhorn.RecurringJobTypeBackupForceCreate,
+		longhorn.RecurringJobTypeVolumeGroupSnapshot, longhorn.RecurringJobTypeVolumeGroupBackup:
+		return nil
+	default:
+		return fmt.Errorf("recurring job task %v does not support retention policy", task)
+	}
+}
+
+// GetRecurringJobRetentionPolicyCount returns the maximum number of snapshots/backups the retention policy can keep
+func GetRecurringJobRetentionPolicyCount(policy *longhorn.RecurringJobRetentionPolicy) int {
+	if policy == nil {
+		return 0
+	}
+	return policy.Hourly + policy.Daily + policy.Weekly + policy.Monthly + policy.Yearly
+}
+
 func ValidateRecurringJobParameters(task longhorn.RecurringJobType, parameters map[string]string) (err error) {
 	switch task {
-	case longhorn.RecurringJobTypeBackup, longhorn.RecurringJobTypeBackupForceCreate:
+	case longhorn.RecurringJobTypeBackup, longhorn.RecurringJobTypeBackupForceCreate, longhorn.RecurringJobTypeVolumeGroupBackup:
 		for key, value := range parameters {
 			if err := validateRecurringJobBackupParameter(key, value); err != nil {
 				return errors.Wrapf(err, "failed to validate recurring job backup task parameters")
 			}
 		}
+	case longhorn.RecurringJobTypeBackupVerify:
+		for key, value := range parameters {
+			if err := validateRecurringJobBackupVerifyParameter(key, value); err != nil {
+				return errors.Wrapf(err, "failed to validate recurring job backup-verify task parameters")
+			}
+		}
 	// we don't support any parameters for other tasks currently
 	default:
 		return nil
@@ -5726,15 +5955,46 @@ func validateRecurringJobBackupParameter(key, value string) error {
 	return nil
 }
 
+func validateRecurringJobBackupVerifyParameter(key, value string) error {
+	switch key {
+	case types.RecurringJobParameterBackupVerifyPolicy:
+		validValues := []string{
+			types.BackupVerifyPolicyLatest,
+			types.BackupVerifyPolicyRandom,
+			types.BackupVerifyPolicyOldest,
+		}
+		if !lhutils.Contains(validValues, value) {
+			return fmt.Errorf("%v:%v is not a valid value: supported values: %v", key, value, validValues)
+		}
+	case types.RecurringJobParameterBackupVerifyNode:
+		if value == "" {
+			return fmt.Errorf("%v cannot be empty", key)
+		}
+	case types.RecurringJobParameterBackupVerifyFilesystemCheck:
+		if _, err := strconv.ParseBool(value); err != nil {
+			return errors.Wrapf(err, "%v:%v is not a boolean", key, value)
+		}
+
+	default:
+		return fmt.Errorf("%v:%v is not a valid parameter", key, value)
+	}
+
+	return nil
+}
+
 func isValidRecurringJobTask(task longhorn.RecurringJobType) bool {
 	return task == longhorn.RecurringJobTypeBackup ||
 		task == longhorn.RecurringJobTypeBackupForceCreate ||
+		task == longhorn.RecurringJobTypeBackupVerify ||
 		task == longhorn.RecurringJobTypeFilesystemTrim ||
+		task == longhorn.RecurringJobTypeSnapshotChecksumCheck ||
 		task == longhorn.RecurringJobTypeSnapshot ||
 		task == longhorn.RecurringJobTypeSnapshotForceCreate ||
 		task == longhorn.RecurringJobTypeSnapshotCleanup ||
 		task == longhorn.RecurringJobTypeSnapshotDelete ||
-		task == longhorn.RecurringJobTypeSystemBackup
+		task == longhorn.RecurringJobTypeSystemBackup ||
+		task == longhorn.RecurringJobTypeVolumeGroupSnapshot ||
+		task == longhorn.RecurringJobTypeVolumeGroupBackup
 }
 
 // ValidateRecurringJobs validates data and formats for recurring jobs
@@ -5748,7 +6008,7 @@ func (s *DataStore) ValidateRecurringJobs(jobs []longhorn.RecurringJobSpec) erro
 		if err := ValidateRecurringJob(job); err != nil {
 			return err
 		}
-		totalJobRetainCount += job.Retain
+		totalJobRetainCount += job.Retain + GetRecurringJobRetentionPolicyCount(job.RetentionPolicy)
 	}
 
 	maxRecurringJobRetain, err := s.GetSettingAsInt(types.SettingNameRecurringJobMaxRetention)
@@ -5911,6 +6171,239 @@ func (s *DataStore) DeleteOrphan(orphanName string) error {
 	return s.lhClient.LonghornV1beta2().Orphans(s.namespace).Delete(context.TODO(), orphanName, metav1.DeleteOptions{})
 }
 
+// CreateVolumeGroupSnapshot creates a Longhorn VolumeGroupSnapshot resource and verifies creation
+func (s *DataStore) CreateVolumeGroupSnapshot(groupSnapshot *longhorn.VolumeGroupSnapshot) (*longhorn.VolumeGroupSnapshot, error) {
+	ret, err := s.lhClient.LonghornV1beta2().VolumeGroupSnapshots(s.namespace).Create(context.TODO(), groupSnapshot, metav1.CreateOptions{})
+	if err != nil {
+		return nil, err
+	}
+	if SkipListerCheck {
+		return ret, nil
+	}
+
+	obj, err := verifyCreation(ret.Name, "volume group snapshot", func(name string) (k8sruntime.Object, error) {
+		return s.GetVolumeGroupSnapshotRO(name)
+	})
+	if err != nil {
+		return nil, err
+	}
+	ret, ok := obj.(*longhorn.VolumeGroupSnapshot)
+	if !ok {
+		return nil, fmt.Errorf("BUG: datastore: verifyCreation returned wrong type for VolumeGroupSnapshot")
+	}
+
+	return ret.DeepCopy(), nil
+}
+
+// GetVolumeGroupSnapshotRO returns the VolumeGroupSnapshot with the given name in the cluster
+func (s *DataStore) GetVolumeGroupSnapshotRO(name string) (*longhorn.VolumeGroupSnapshot, error) {
+	return s.volumeGroupSnapshotLister.VolumeGroupSnapshots(s.namespace).Get(name)
+}
+
+// GetVolumeGroupSnapshot returns a copy of VolumeGroupSnapshot with the given name in the cluster
+func (s *DataStore) GetVolumeGroupSnapshot(name string) (*longhorn.VolumeGroupSnapshot, error) {
+	resultRO, err := s.GetVolumeGroupSnapshotRO(name)
+	if err != nil {
+		return nil, err
+	}
+	// Cannot use cached object from lister
+	return resultRO.DeepCopy(), nil
+}
+
+// UpdateVolumeGroupSnapshotStatus updates the given Longhorn VolumeGroupSnapshot status and verifies update
+func (s *DataStore) UpdateVolumeGroupSnapshotStatus(groupSnapshot *longhorn.VolumeGroupSnapshot) (*longhorn.VolumeGroupSnapshot, error) {
+	obj, err := s.lhClient.LonghornV1beta2().VolumeGroupSnapshots(s.namespace).UpdateStatus(context.TODO(), groupSnapshot, metav1.UpdateOptions{})
+	if err != nil {
+		return nil, err
+	}
+	verifyUpdate(groupSnapshot.Name, obj, func(name string) (k8sruntime.Object, error) {
+		return s.GetVolumeGroupSnapshotRO(name)
+	})
+	return obj, nil
+}
+
+// RemoveFinalizerForVolumeGroupSnapshot will result in deletion if DeletionTimestamp was set
+func (s *DataStore) RemoveFinalizerForVolumeGroupSnapshot(groupSnapshot *longhorn.VolumeGroupSnapshot) error {
+	if !util.FinalizerExists(longhornFinalizerKey, groupSnapshot) {
+		// finalizer already removed
+		return nil
+	}
+	if err := util.RemoveFinalizer(longhornFinalizerKey, groupSnapshot); err != nil {
+		return err
+	}
+	_, err := s.lhClient.LonghornV1beta2().VolumeGroupSnapshots(s.namespace).Update(context.TODO(), groupSnapshot, metav1.UpdateOptions{})
+	if err != nil {
+		// workaround `StorageError: invalid object, Code: 4` due to empty object
+		if groupSnapshot.DeletionTimestamp != nil {
+			return nil
+		}
+		return errors.Wrapf(err, "unable to remove finalizer for volume group snapshot %s", groupSnapshot.Name)
+	}
+	return nil
+}
+
+// ListVolumeGroupSnapshots returns a copy of all VolumeGroupSnapshots for the given namespace
+func (s *DataStore) ListVolumeGroupSnapshots() (map[string]*longhorn.VolumeGroupSnapshot, error) {
+	list, err := s.volumeGroupSnapshotLister.VolumeGroupSnapshots(s.namespace).List(labels.Everything())
+	if err != nil {
+		return nil, err
+	}
+
+	itemMap := map[string]*longhorn.VolumeGroupSnapshot{}
+	for _, itemRO := range list {
+		// Cannot use cached object from lister
+		itemMap[itemRO.Name] = itemRO.DeepCopy()
+	}
+	return itemMap, nil
+}
+
+// ListVolumeGroupSnapshotMembersRO returns the member snapshots of the VolumeGroupSnapshot with the given name,
+// the map contains direct references to the internal cache objects and should not be mutated.
+func (s *DataStore) ListVolumeGroupSnapshotMembersRO(groupSnapshotName string) (map[string]*longhorn.Snapshot, error) {
+	selector, err := metav1.LabelSelectorAsSelector(&metav1.LabelSelector{
+		MatchLabels: types.GetVolumeGroupSnapshotLabels(groupSnapshotName),
+	})
+	if err != nil {
+		return nil, err
+	}
+	return s.ListSnapshotsRO(selector)
+}
+
+// DeleteVolumeGroupSnapshot won't result in immediately deletion since finalizer was set by default
+func (s *DataStore) DeleteVolumeGroupSnapshot(name string) error {
+	return s.lhClient.LonghornV1beta2().VolumeGroupSnapshots(s.namespace).Delete(context.TODO(), name, metav1.DeleteOptions{})
+}
+
+// CreateDRGroup creates a Longhorn DRGroup resource and verifies creation
+func (s *DataStore) CreateDRGroup(drGroup *longhorn.DRGroup) (*longhorn.DRGroup, error) {
+	ret, err := s.lhClient.LonghornV1beta2().DRGroups(s.namespace).Create(context.TODO(), drGroup, metav1.CreateOptions{})
+	if err != nil {
+		return nil, err
+	}
+	if SkipListerCheck {
+		return ret, nil
+	}
+
+	obj, err := verifyCreation(ret.Name, "DR group", func(name string) (k8sruntime.Object, error) {
+		return s.GetDRGroupRO(name)
+	})
+	if err != nil {
+		return nil, err
+	}
+	ret, ok := obj.(*longhorn.DRGroup)
+	if !ok {
+		return nil, fmt.Errorf("BUG: datastore: verifyCreation returned wrong type for DRGroup")
+	}
+
+	return ret.DeepCopy(), nil
+}
+
+// GetDRGroupRO returns the DRGroup with the given name in the cluster
+func (s *DataStore) GetDRGroupRO(name string) (*longhorn.DRGroup, error) {
+	return s.drGroupLister.DRGroups(s.namespace).Get(name)
+}
+
+// GetDRGroup returns a copy of DRGroup with the given name in the cluster
+func (s *DataStore) GetDRGroup(name string) (*longhorn.DRGroup, error) {
+	resultRO, err := s.GetDRGroupRO(name)
+	if err != nil {
+		return nil, err
+	}
+	// Cannot use cached object from lister
+	return resultRO.DeepCopy(), nil
+}
+
+// UpdateDRGroup updates the given Longhorn DRGroup and verifies update
+func (s *DataStore) UpdateDRGroup(drGroup *longhorn.DRGroup) (*longhorn.DRGroup, error) {
+	obj, err := s.lhClient.LonghornV1beta2().DRGroups(s.namespace).Update(context.TODO(), drGroup, metav1.UpdateOptions{})
+	if err != nil {
+		return nil, err
+	}
+	verifyUpdate(drGroup.Name, obj, func(name string) (k8sruntime.Object, error) {
+		return s.GetDRGroupRO(name)
+	})
+	return obj, nil
+}
+
+// UpdateDRGroupStatus updates the given Longhorn DRGroup status and verifies update
+func (s *DataStore) UpdateDRGroupStatus(drGroup *longhorn.DRGroup) (*longhorn.DRGroup, error) {
+	obj, err := s.lhClient.LonghornV1beta2().DRGroups(s.namespace).UpdateStatus(context.TODO(), drGroup, metav1.UpdateOptions{})
+	if err != nil {
+		return nil, err
+	}
+	verifyUpdate(drGroup.Name, obj, func(name string) (k8sruntime.Object, error) {
+		return s.GetDRGroupRO(name)
+	})
+	return obj, nil
+}
+
+// RemoveFinalizerForDRGroup will result in deletion if DeletionTimestamp was set
+func (s *DataStore) RemoveFinalizerForDRGroup(drGroup *longhorn.DRGroup) error {
+	if !util.FinalizerExists(longhornFinalizerKey, drGroup) {
+		// finalizer already removed
+		return nil
+	}
+	if err := util.RemoveFinalizer(longhornFinalizerKey, drGroup); err != nil {
+		return err
+	}
+	_, err := s.lhClient.LonghornV1beta2().DRGroups(s.namespace).Update(context.TODO(), drGroup, metav1.UpdateOptions{})
+	if err != nil {
+		// workaround `StorageError: invalid object, Code: 4` due to empty object
+		if drGroup.DeletionTimestamp != nil {
+			return nil
+		}
+		return errors.Wrapf(err, "unable to remove finalizer for DR group %s", drGroup.Name)
+	}
+	return nil
+}
+
+// ListDRGroups returns a copy of all DRGroups for the given namespace
+func (s *DataStore) ListDRGroups() (map[string]*longhorn.DRGroup, error) {
+	list, err := s.drGroupLister.DRGroups(s.namespace).List(labels.Everything())
+	if err != nil {
+		return nil, err
+	}
+
+	itemMap := map[string]*longhorn.DRGroup{}
+	for _, itemRO := range list {
+		// Cannot use cached object from lister
+		itemMap[itemRO.Name] = itemRO.DeepCopy()
+	}
+	return itemMap, nil
+}
+
+// ListDRGroupsRO returns all DRGroups for the given namespace,
+// the list contains direct references to the internal cache objects and should not be mutated.
+func (s *DataStore) ListDRGroupsRO() ([]*longhorn.DRGroup, error) {
+	return s.drGroupLister.DRGroups(s.namespace).List(labels.Everything())
+}
+
+// ListDRGroupMemberVolumesRO returns the volumes labelled with the DR group of the given name,
+// the map contains direct references to the internal cache objects and should not be mutated.
+func (s *DataStore) ListDRGroupMemberVolumesRO(drGroupName string) (map[string]*longhorn.Volume, error) {
+	selector, err := metav1.LabelSelectorAsSelector(&metav1.LabelSelector{
+		MatchLabels: types.GetDRGroupLabels(drGroupName),
+	})
+	if err != nil {
+		return nil, err
+	}
+	list, err := s.ListVolumesBySelectorRO(selector)
+	if err != nil {
+		return nil, err
+	}
+
+	itemMap := map[string]*longhorn.Volume{}
+	for _, itemRO := range list {
+		itemMap[itemRO.Name] = itemRO
+	}
+	return itemMap, nil
+}
+
+// DeleteDRGroup won't result in immediately deletion since finalizer was set by default
+func (s *DataStore) DeleteDRGroup(name string) error {
+	return s.lhClient.LonghornV1beta2().DRGroups(s.namespace).Delete(context.TODO(), name, metav1.DeleteOptions{})
+}
+
 // GetOwnerReferencesForSupportBundle returns a list contains single OwnerReference for the
 // given SupportBundle object
 func GetOwnerReferencesForSupportBundle(supportBundle *longhorn.SupportBundle) []metav1.OwnerReference {
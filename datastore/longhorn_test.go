@@ -4,6 +4,7 @@ import (
 	"context"
 	"fmt"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
@@ -13,6 +14,8 @@ import (
 
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 
+	"github.com/longhorn/longhorn-manager/types"
+
 	longhorn "github.com/longhorn/longhorn-manager/k8s/pkg/apis/longhorn/v1beta2"
 	lhfake "github.com/longhorn/longhorn-manager/k8s/pkg/client/clientset/versioned/fake"
 	lhinformerfactory "github.com/longhorn/longhorn-manager/k8s/pkg/client/informers/externalversions"
@@ -204,3 +207,67 @@ func TestCreateReplica(t *testing.T) {
 		})
 	}
 }
+
+func TestGetBackupRetentionLockedUntil(t *testing.T) {
+	createdAt := time.Date(2024, time.January, 1, 0, 0, 0, 0, time.UTC)
+	labelKey := types.GetLonghornLabelKey(types.LonghornLabelBackupRetentionLockedUntil)
+
+	newBackupTarget := func(retentionLockPeriod time.Duration) *longhorn.BackupTarget {
+		return &longhorn.BackupTarget{
+			Spec: longhorn.BackupTargetSpec{
+				RetentionLockPeriod: metav1.Duration{Duration: retentionLockPeriod},
+			},
+		}
+	}
+
+	type testCase struct {
+		specLabels   map[string]string
+		statusLabels map[string]string
+		backupTarget *longhorn.BackupTarget
+
+		expectedLockedUntil time.Time
+	}
+
+	for name, tc := range map[string]testCase{
+		"no retention lock": {
+			backupTarget:        newBackupTarget(0),
+			expectedLockedUntil: time.Time{},
+		},
+		"retention lock period of backup target": {
+			backupTarget:        newBackupTarget(24 * time.Hour),
+			expectedLockedUntil: createdAt.Add(24 * time.Hour),
+		},
+		"retention lock label of backup in backup target": {
+			statusLabels:        map[string]string{labelKey: "2024-02-01T00:00:00Z"},
+			backupTarget:        newBackupTarget(24 * time.Hour),
+			expectedLockedUntil: time.Date(2024, time.February, 1, 0, 0, 0, 0, time.UTC),
+		},
+		"retention lock label shorter than retention lock period": {
+			specLabels:          map[string]string{labelKey: "2024-01-01T12:00:00Z"},
+			backupTarget:        newBackupTarget(24 * time.Hour),
+			expectedLockedUntil: createdAt.Add(24 * time.Hour),
+		},
+		"invalid retention lock label": {
+			specLabels:          map[string]string{labelKey: "invalid"},
+			expectedLockedUntil: time.Time{},
+		},
+	} {
+		t.Run(name, func(t *testing.T) {
+			backup := &longhorn.Backup{
+				Spec: longhorn.BackupSpec{
+					Labels: tc.specLabels,
+				},
+				Status: longhorn.BackupStatus{
+					BackupCreatedAt: createdAt.Format(time.RFC3339),
+					Labels:          tc.statusLabels,
+				},
+			}
+
+			lockedUntil := GetBackupRetentionLockedUntil(backup, tc.backupTarget)
+			assert.True(t, tc.expectedLockedUntil.Equal(lockedUntil), "expected %v, got %v", tc.expectedLockedUntil, lockedUntil)
+
+			backup.Status.RetentionLockedUntil = metav1.Time{Time: lockedUntil}
+			assert.Equal(t, time.Now().Before(lockedUntil), IsBackupRetentionLocked(backup))
+		})
+	}
+}
@@ -0,0 +1,308 @@
+package engineapi
+
+import (
+	"bytes"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"sync"
+
+	"github.com/cockroachdb/errors"
+	"github.com/sirupsen/logrus"
+
+	"github.com/longhorn/backupstore"
+
+	bsutil "github.com/longhorn/backupstore/util"
+
+	"github.com/longhorn/longhorn-manager/util"
+
+	// Register the backup store drivers used to copy the backups between the backup targets
+	_ "github.com/longhorn/backupstore/azblob"
+	_ "github.com/longhorn/backupstore/cifs"
+	_ "github.com/longhorn/backupstore/nfs"
+	_ "github.com/longhorn/backupstore/s3"
+)
+
+// backupStoreDriverLock serializes the initialization of the backup store drivers, since the drivers read the
+// credential of the backup target from the process environment.
+var backupStoreDriverLock sync.Mutex
+
+// NewBackupStoreDriver initializes the backup store driver of the backup target URL with the credential of the
+// backup target. The credential is only exposed in the process environment while the driver is initialized.
+func NewBackupStoreDriver(backupTargetURL string, credential map[string]string) (backupstore.BackupStoreDriver, error) {
+	envs, err := getBackupCredentialEnv(backupTargetURL, credential)
+	if err != nil {
+		return nil, err
+	}
+
+	backupStoreDriverLock.Lock()
+	defer backupStoreDriverLock.Unlock()
+
+	restoreEnvs := map[string]*string{}
+	defer func() {
+		for key, value := range restoreEnvs {
+			if value == nil {
+				_ = os.Unsetenv(key)
+				continue
+			}
+			_ = os.Setenv(key, *value)
+		}
+	}()
+	for _, env := range envs {
+		key, value, _ := strings.Cut(env, "=")
+		if _, saved := restoreEnvs[key]; !saved {
+			if existing, ok := os.LookupEnv(key); ok {
+				restoreEnvs[key] = &existing
+			} else {
+				restoreEnvs[key] = nil
+			}
+		}
+		if err := os.Setenv(key, value); err != nil {
+			return nil, errors.Wrapf(err, "failed to set environment variable %v", key)
+		}
+	}
+
+	driver, err := backupstore.GetBackupStoreDriver(backupTargetURL)
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to initialize backup store driver for %v", backupTargetURL)
+	}
+	return driver, nil
+}
+
+// CopyBackup copies the backup of the backup volume from the source backup store to the destination backup store
+// as the backup copyName with the given labels. The blocks missing on the destination are copied first, then the
+// backup config is written and verified against the source one, and finally the volume config on the destination
+// is updated. The backup only shows up on the destination once all its blocks are there, so an interrupted copy
+// leaves nothing but the blocks, which are reused by the next attempt.
+func CopyBackup(src, dst backupstore.BackupStoreDriver, volumeName, backupName, copyName string, labels map[string]string, log logrus.FieldLogger) error {
+	srcVolume := &backupstore.Volume{}
+	if err := backupstore.LoadConfigInBackupStore(src, getBackupStoreVolumeConfigPath(volumeName), srcVolume); err != nil {
+		return errors.Wrapf(err, "failed to load config of backup volume %v", volumeName)
+	}
+	backup := &backupstore.Backup{}
+	if err := backupstore.LoadConfigInBackupStore(src, getBackupStoreBackupConfigPath(volumeName, backupName), backup); err != nil {
+		return errors.Wrapf(err, "failed to load config of backup %v", backupName)
+	}
+	if backup.CreatedTime == "" {
+		return fmt.Errorf("backup %v is still in progress", backupName)
+	}
+	if backup.SingleFile.FilePath != "" {
+		return fmt.Errorf("cannot copy single file backup %v", backupName)
+	}
+
+	srcLock, err := backupstore.New(src, volumeName, backupstore.RESTORE_LOCK)
+	if err != nil {
+		return err
+	}
+	if err := srcLock.Lock(); err != nil {
+		return errors.Wrapf(err, "failed to lock backup volume %v on %v", volumeName, src.GetURL())
+	}
+	defer func() {
+		if err := srcLock.Unlock(); err != nil {
+			log.WithError(err).Warnf("Failed to unlock backup volume %v on %v", volumeName, src.GetURL())
+		}
+	}()
+	dstLock, err := backupstore.New(dst, volumeName, backupstore.BACKUP_LOCK)
+	if err != nil {
+		return err
+	}
+	if err := dstLock.Lock(); err != nil {
+		return errors.Wrapf(err, "failed to lock backup volume %v on %v", volumeName, dst.GetURL())
+	}
+	defer func() {
+		if err := dstLock.Unlock(); err != nil {
+			log.WithError(err).Warnf("Failed to unlock backup volume %v on %v", volumeName, dst.GetURL())
+		}
+	}()
+
+	copiedBlocks, copiedSize := int64(0), int64(0)
+	checksums := map[string]struct{}{}
+	for _, block := range backup.Blocks {
+		if _, copied := checksums[block.BlockChecksum]; copied {
+			continue
+		}
+		checksums[block.BlockChecksum] = struct{}{}
+
+		blockPath := getBackupStoreBlockPath(volumeName, block.BlockChecksum)
+		if dst.FileExists(blockPath) {
+			continue
+		}
+		size, err := copyBackupStoreFile(src, dst, blockPath)
+		if err != nil {
+			return errors.Wrapf(err, "failed to copy block %v of backup %v", block.BlockChecksum, backupName)
+		}
+		copiedBlocks++
+		copiedSize += size
+	}
+
+	srcBlocks := backup.Blocks
+	backup.Name = copyName
+	backup.Labels = labels
+	backup.NewlyUploadedDataSize = copiedSize
+	backup.ReUploadedDataSize = 0
+	copyConfigPath := getBackupStoreBackupConfigPath(volumeName, copyName)
+	if err := backupstore.SaveConfigInBackupStore(dst, copyConfigPath, backup); err != nil {
+		return errors.Wrapf(err, "failed to save config of backup %v", copyName)
+	}
+
+	backupCopy := &backupstore.Backup{}
+	if err := backupstore.LoadConfigInBackupStore(dst, copyConfigPath, backupCopy); err != nil {
+		return errors.Wrapf(err, "failed to load config of backup %v", copyName)
+	}
+	if err := VerifyBackupBlocks(srcBlocks, backupCopy.Blocks); err != nil {
+		if removeErr := dst.Remove(copyConfigPath); removeErr != nil {
+			log.WithError(removeErr).Warnf("Failed to remove config of mismatching backup %v", copyName)
+		}
+		return errors.Wrapf(err, "backup %v mismatches backup %v", copyName, backupName)
+	}
+
+	dstVolume := &backupstore.Volume{}
+	volumeConfigPath := getBackupStoreVolumeConfigPath(volumeName)
+	if dst.FileExists(volumeConfigPath) {
+		if err := backupstore.LoadConfigInBackupStore(dst, volumeConfigPath, dstVolume); err != nil {
+			return errors.Wrapf(err, "failed to load config of backup volume %v on %v", volumeName, dst.GetURL())
+		}
+	} else {
+		dstVolume = &backupstore.Volume{
+			Name:                 srcVolume.Name,
+			Size:                 srcVolume.Size,
+			CreatedTime:          util.Now(),
+			BackingImageName:     srcVolume.BackingImageName,
+			BackingImageChecksum: srcVolume.BackingImageChecksum,
+			CompressionMethod:    srcVolume.CompressionMethod,
+			StorageClassName:     srcVolume.StorageClassName,
+			DataEngine:           srcVolume.DataEngine,
+		}
+	}
+	dstVolume.BlockCount += copiedBlocks
+	if isNewerBackupStoreTime(backup.SnapshotCreatedAt, dstVolume.LastBackupAt) {
+		dstVolume.LastBackupName = copyName
+		dstVolume.LastBackupAt = backup.SnapshotCreatedAt
+		dstVolume.Labels = labels
+		dstVolume.Size = backup.Size
+	}
+	if err := backupstore.SaveConfigInBackupStore(dst, volumeConfigPath, dstVolume); err != nil {
+		return errors.Wrapf(err, "failed to save config of backup volume %v on %v", volumeName, dst.GetURL())
+	}
+	return nil
+}
+
+// VerifyBackupBlocks checks the two backup configs consist of the same blocks at the same offsets. The blocks are
+// addressed by their checksums in the backup store, so the backups contain the same data if the lists match.
+func VerifyBackupBlocks(blocks, copyBlocks []backupstore.BlockMapping) error {
+	if len(blocks) != len(copyBlocks) {
+		return fmt.Errorf("backup has %v blocks instead of %v", len(copyBlocks), len(blocks))
+	}
+	sorted := sortBackupBlocks(blocks)
+	sortedCopy := sortBackupBlocks(copyBlocks)
+	for i := range sorted {
+		if sorted[i] != sortedCopy[i] {
+			return fmt.Errorf("backup has block %v at offset %v instead of block %v at offset %v",
+				sortedCopy[i].BlockChecksum, sortedCopy[i].Offset, sorted[i].BlockChecksum, sorted[i].Offset)
+		}
+	}
+	return nil
+}
+
+// CheckBackupBlocksOnDevice reads the blocks of the backup from the block device the backup was restored into and
+// compares their checksums with the block checksums in the backup config. The backup config lists all the blocks of
+// the snapshot including the ones uploaded by the previous backups, so incremental backups are fully checked too.
+func CheckBackupBlocksOnDevice(driver backupstore.BackupStoreDriver, volumeName, backupName, devicePath string) (int, error) {
+	backup := &backupstore.Backup{}
+	if err := backupstore.LoadConfigInBackupStore(driver, getBackupStoreBackupConfigPath(volumeName, backupName), backup); err != nil {
+		return 0, errors.Wrapf(err, "failed to load config of backup %v", backupName)
+	}
+	if backup.SingleFile.FilePath != "" {
+		return 0, fmt.Errorf("cannot check blocks of single file backup %v", backupName)
+	}
+	blockSize, err := backup.GetBlockSize()
+	if err != nil {
+		return 0, errors.Wrapf(err, "failed to get block size of backup %v", backupName)
+	}
+
+	device, err := os.Open(devicePath)
+	if err != nil {
+		return 0, errors.Wrapf(err, "failed to open device %v", devicePath)
+	}
+	defer device.Close()
+
+	data := make([]byte, blockSize)
+	for _, block := range backup.Blocks {
+		n, err := device.ReadAt(data, block.Offset)
+		if err != nil && !(errors.Is(err, io.EOF) && n > 0) {
+			return 0, errors.Wrapf(err, "failed to read block at offset %v of device %v", block.Offset, devicePath)
+		}
+		if checksum := bsutil.GetChecksum(data[:n]); checksum != block.BlockChecksum {
+			return 0, fmt.Errorf("block at offset %v has checksum %v instead of %v", block.Offset, checksum, block.BlockChecksum)
+		}
+	}
+	return len(backup.Blocks), nil
+}
+
+func sortBackupBlocks(blocks []backupstore.BlockMapping) []backupstore.BlockMapping {
+	sorted := append([]backupstore.BlockMapping{}, blocks...)
+	sort.Slice(sorted, func(i, j int) bool {
+		if sorted[i].Offset != sorted[j].Offset {
+			return sorted[i].Offset < sorted[j].Offset
+		}
+		return sorted[i].BlockChecksum < sorted[j].BlockChecksum
+	})
+	return sorted
+}
+
+func copyBackupStoreFile(src, dst backupstore.BackupStoreDriver, filePath string) (int64, error) {
+	rc, err := src.Read(filePath)
+	if err != nil {
+		return 0, err
+	}
+	defer rc.Close()
+
+	data, err := io.ReadAll(rc)
+	if err != nil {
+		return 0, err
+	}
+	if err := dst.Write(filePath, bytes.NewReader(data)); err != nil {
+		return 0, err
+	}
+	return int64(len(data)), nil
+}
+
+func isNewerBackupStoreTime(t, than string) bool {
+	if than == "" {
+		return true
+	}
+	parsed, err := util.ParseTime(t)
+	if err != nil {
+		return false
+	}
+	parsedThan, err := util.ParseTime(than)
+	if err != nil {
+		return true
+	}
+	return parsed.After(parsedThan)
+}
+
+// getBackupStoreVolumePath follows the layout of the backup volumes in the backup store
+func getBackupStoreVolumePath(volumeName string) string {
+	checksum := bsutil.GetChecksum([]byte(volumeName))
+	return filepath.Join(backupstore.GetBackupstoreBase(), backupstore.VOLUME_DIRECTORY,
+		checksum[0:backupstore.VOLUME_SEPARATE_LAYER1], checksum[backupstore.VOLUME_SEPARATE_LAYER1:backupstore.VOLUME_SEPARATE_LAYER2], volumeName)
+}
+
+func getBackupStoreVolumeConfigPath(volumeName string) string {
+	return filepath.Join(getBackupStoreVolumePath(volumeName), backupstore.VOLUME_CONFIG_FILE)
+}
+
+func getBackupStoreBackupConfigPath(volumeName, backupName string) string {
+	return filepath.Join(getBackupStoreVolumePath(volumeName), backupstore.BACKUP_DIRECTORY,
+		backupstore.BACKUP_CONFIG_PREFIX+backupName+backupstore.CFG_SUFFIX)
+}
+
+func getBackupStoreBlockPath(volumeName, checksum string) string {
+	return filepath.Join(getBackupStoreVolumePath(volumeName), backupstore.BLOCKS_DIRECTORY,
+		checksum[0:backupstore.BLOCK_SEPARATE_LAYER1], checksum[backupstore.BLOCK_SEPARATE_LAYER1:backupstore.BLOCK_SEPARATE_LAYER2],
+		checksum+backupstore.BLK_SUFFIX)
+}
@@ -0,0 +1,233 @@
+package engineapi
+
+import (
+	"bytes"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/sirupsen/logrus"
+	"github.com/stretchr/testify/require"
+
+	"github.com/longhorn/backupstore"
+
+	bsutil "github.com/longhorn/backupstore/util"
+	lhbackup "github.com/longhorn/go-common-libs/backup"
+)
+
+// memoryBackupStoreDriver keeps the files of a backup store in memory
+type memoryBackupStoreDriver struct {
+	lock  sync.Mutex
+	url   string
+	files map[string][]byte
+}
+
+func newMemoryBackupStoreDriver(url string) *memoryBackupStoreDriver {
+	return &memoryBackupStoreDriver{url: url, files: map[string][]byte{}}
+}
+
+func (d *memoryBackupStoreDriver) Kind() string   { return "memory" }
+func (d *memoryBackupStoreDriver) GetURL() string { return d.url }
+
+func (d *memoryBackupStoreDriver) FileExists(filePath string) bool {
+	d.lock.Lock()
+	defer d.lock.Unlock()
+	_, ok := d.files[filePath]
+	return ok
+}
+
+func (d *memoryBackupStoreDriver) FileSize(filePath string) int64 {
+	d.lock.Lock()
+	defer d.lock.Unlock()
+	return int64(len(d.files[filePath]))
+}
+
+func (d *memoryBackupStoreDriver) FileTime(filePath string) time.Time {
+	return time.Now().UTC()
+}
+
+func (d *memoryBackupStoreDriver) Remove(path string) error {
+	d.lock.Lock()
+	defer d.lock.Unlock()
+	for name := range d.files {
+		if name == path || strings.HasPrefix(name, strings.TrimSuffix(path, "/")+"/") {
+			delete(d.files, name)
+		}
+	}
+	return nil
+}
+
+func (d *memoryBackupStoreDriver) Read(src string) (io.ReadCloser, error) {
+	d.lock.Lock()
+	defer d.lock.Unlock()
+	data, ok := d.files[src]
+	if !ok {
+		return nil, fmt.Errorf("cannot find %v", src)
+	}
+	return io.NopCloser(bytes.NewReader(data)), nil
+}
+
+func (d *memoryBackupStoreDriver) Write(dst string, rs io.ReadSeeker) error {
+	data, err := io.ReadAll(rs)
+	if err != nil {
+		return err
+	}
+	d.lock.Lock()
+	defer d.lock.Unlock()
+	d.files[dst] = data
+	return nil
+}
+
+func (d *memoryBackupStoreDriver) List(path string) ([]string, error) {
+	d.lock.Lock()
+	defer d.lock.Unlock()
+	prefix := strings.TrimSuffix(path, "/") + "/"
+	names := map[string]struct{}{}
+	for name := range d.files {
+		if strings.HasPrefix(name, prefix) {
+			names[strings.Split(strings.TrimPrefix(name, prefix), "/")[0]] = struct{}{}
+		}
+	}
+	result := []string{}
+	for name := range names {
+		result = append(result, name)
+	}
+	return result, nil
+}
+
+func (d *memoryBackupStoreDriver) Upload(src, dst string) error   { return fmt.Errorf("not supported") }
+func (d *memoryBackupStoreDriver) Download(src, dst string) error { return fmt.Errorf("not supported") }
+
+func TestVerifyBackupBlocks(t *testing.T) {
+	assert := require.New(t)
+
+	blocks := []backupstore.BlockMapping{
+		{Offset: 0, BlockChecksum: "aaaa"},
+		{Offset: 2097152, BlockChecksum: "bbbb"},
+	}
+	reordered := []backupstore.BlockMapping{blocks[1], blocks[0]}
+	assert.NoError(VerifyBackupBlocks(blocks, reordered))
+
+	assert.Error(VerifyBackupBlocks(blocks, blocks[:1]))
+	assert.Error(VerifyBackupBlocks(blocks, []backupstore.BlockMapping{
+		{Offset: 0, BlockChecksum: "aaaa"},
+		{Offset: 2097152, BlockChecksum: "cccc"},
+	}))
+	assert.Error(VerifyBackupBlocks(blocks, []backupstore.BlockMapping{
+		{Offset: 0, BlockChecksum: "aaaa"},
+		{Offset: 4194304, BlockChecksum: "bbbb"},
+	}))
+}
+
+func TestCopyBackup(t *testing.T) {
+	assert := require.New(t)
+
+	volumeName := "pvc-1"
+	src := newMemoryBackupStoreDriver("s3://primary@us-east-1/")
+	dst := newMemoryBackupStoreDriver("s3://secondary@us-east-1/")
+
+	blocks := []backupstore.BlockMapping{
+		{Offset: 0, BlockChecksum: "aaaa0000"},
+		{Offset: 2097152, BlockChecksum: "bbbb0000"},
+		{Offset: 4194304, BlockChecksum: "aaaa0000"},
+	}
+	for _, block := range blocks {
+		src.files[getBackupStoreBlockPath(volumeName, block.BlockChecksum)] = []byte(block.BlockChecksum)
+	}
+	assert.NoError(backupstore.SaveConfigInBackupStore(src, getBackupStoreVolumeConfigPath(volumeName), &backupstore.Volume{
+		Name:              volumeName,
+		Size:              1073741824,
+		LastBackupName:    "backup-1",
+		LastBackupAt:      "2024-05-01T10:00:00Z",
+		BlockCount:        2,
+		CompressionMethod: "lz4",
+	}))
+	assert.NoError(backupstore.SaveConfigInBackupStore(src, getBackupStoreBackupConfigPath(volumeName, "backup-1"), &backupstore.Backup{
+		Name:              "backup-1",
+		VolumeName:        volumeName,
+		SnapshotName:      "snap-1",
+		SnapshotCreatedAt: "2024-05-01T10:00:00Z",
+		CreatedTime:       "2024-05-01T10:01:00Z",
+		Size:              4194304,
+		Blocks:            blocks,
+	}))
+	// A block already on the destination is not copied again
+	dst.files[getBackupStoreBlockPath(volumeName, "bbbb0000")] = []byte("bbbb0000")
+
+	labels := map[string]string{"longhorn.io/replicated-from-backup": "backup-1"}
+	assert.NoError(CopyBackup(src, dst, volumeName, "backup-1", "backup-copy", labels, logrus.StandardLogger()))
+
+	assert.Equal([]byte("aaaa0000"), dst.files[getBackupStoreBlockPath(volumeName, "aaaa0000")])
+
+	backupCopy := &backupstore.Backup{}
+	assert.NoError(backupstore.LoadConfigInBackupStore(dst, getBackupStoreBackupConfigPath(volumeName, "backup-copy"), backupCopy))
+	assert.Equal("backup-copy", backupCopy.Name)
+	assert.Equal("snap-1", backupCopy.SnapshotName)
+	assert.Equal(labels, backupCopy.Labels)
+	assert.Equal(blocks, backupCopy.Blocks)
+	assert.Equal(int64(len("aaaa0000")), backupCopy.NewlyUploadedDataSize)
+
+	volume := &backupstore.Volume{}
+	assert.NoError(backupstore.LoadConfigInBackupStore(dst, getBackupStoreVolumeConfigPath(volumeName), volume))
+	assert.Equal("backup-copy", volume.LastBackupName)
+	assert.Equal("2024-05-01T10:00:00Z", volume.LastBackupAt)
+	assert.Equal(int64(1), volume.BlockCount)
+	assert.Equal("lz4", volume.CompressionMethod)
+
+	// The locks are released after the copy
+	for name := range dst.files {
+		assert.False(strings.HasPrefix(name, filepath.Join(getBackupStoreVolumePath(volumeName), backupstore.LOCKS_DIRECTORY)), name)
+	}
+
+	// A backup with a missing block is not published on the destination
+	delete(src.files, getBackupStoreBlockPath(volumeName, "aaaa0000"))
+	delete(dst.files, getBackupStoreBlockPath(volumeName, "aaaa0000"))
+	assert.Error(CopyBackup(src, dst, volumeName, "backup-1", "backup-copy-2", labels, logrus.StandardLogger()))
+	assert.False(dst.FileExists(getBackupStoreBackupConfigPath(volumeName, "backup-copy-2")))
+}
+
+func TestCheckBackupBlocksOnDevice(t *testing.T) {
+	assert := require.New(t)
+
+	volumeName := "pvc-1"
+	blockSize := int64(4096)
+	driver := newMemoryBackupStoreDriver("s3://primary@us-east-1/")
+
+	blockA := bytes.Repeat([]byte("a"), int(blockSize))
+	blockB := bytes.Repeat([]byte("b"), int(blockSize))
+	device := make([]byte, 4*blockSize)
+	copy(device[0:], blockA)
+	copy(device[2*blockSize:], blockB)
+	copy(device[3*blockSize:], blockA)
+	devicePath := filepath.Join(t.TempDir(), volumeName)
+	assert.NoError(os.WriteFile(devicePath, device, 0600))
+
+	backup := &backupstore.Backup{
+		Name:        "backup-1",
+		VolumeName:  volumeName,
+		CreatedTime: "2024-05-01T10:01:00Z",
+		Parameters:  map[string]string{lhbackup.LonghornBackupParameterBackupBlockSize: strconv.FormatInt(blockSize, 10)},
+		Blocks: []backupstore.BlockMapping{
+			{Offset: 0, BlockChecksum: bsutil.GetChecksum(blockA)},
+			{Offset: 2 * blockSize, BlockChecksum: bsutil.GetChecksum(blockB)},
+			{Offset: 3 * blockSize, BlockChecksum: bsutil.GetChecksum(blockA)},
+		},
+	}
+	assert.NoError(backupstore.SaveConfigInBackupStore(driver, getBackupStoreBackupConfigPath(volumeName, "backup-1"), backup))
+
+	blocks, err := CheckBackupBlocksOnDevice(driver, volumeName, "backup-1", devicePath)
+	assert.NoError(err)
+	assert.Equal(3, blocks)
+
+	// A block restored with different data is detected
+	copy(device[2*blockSize:], blockA)
+	assert.NoError(os.WriteFile(devicePath, device, 0600))
+	_, err = CheckBackupBlocksOnDevice(driver, volumeName, "backup-1", devicePath)
+	assert.Error(err)
+}
@@ -89,6 +89,17 @@ func NewBackupMonitor(logger logrus.FieldLogger, ds *datastore.DataStore, backup
 		if volumeRecurringJobInfo != "" {
 			backup.Spec.Labels[types.VolumeRecurringJobInfoLabel] = volumeRecurringJobInfo
 		}
+		// put the DR group of the volume into backup labels so that the secondary cluster can find the member volumes
+		drGroupLabelKey := types.GetLonghornLabelKey(types.LonghornLabelDRGroup)
+		if drGroupName := volume.Labels[drGroupLabelKey]; drGroupName != "" {
+			if backup.Spec.Labels == nil {
+				backup.Spec.Labels = map[string]string{}
+			}
+			backup.Spec.Labels[drGroupLabelKey] = drGroupName
+			if err := addPVBackupLabels(ds, volume, backup.Spec.Labels); err != nil {
+				return nil, err
+			}
+		}
 		_, replicaAddress, err := engineClientProxy.SnapshotBackup(engine, backup.Spec.SnapshotName, backup.Name,
 			backupTargetClient.URL, volume.Spec.BackingImage, biChecksum, string(compressionMethod), concurrentLimit, storageClassName,
 			backup.Spec.Labels, backupTargetClient.Credential, backupParameters)
@@ -188,6 +199,33 @@ func addVolumeRecurringJobInfosFromGroup(jobName, groupName string, jobSpec *lon
 	return volumeRecurringJobInfo, nil
 }
 
+// addPVBackupLabels puts the filesystem type and the CSI secret of the PV of the volume into the backup labels
+func addPVBackupLabels(ds *datastore.DataStore, volume *longhorn.Volume, labels map[string]string) error {
+	pvName := volume.Status.KubernetesStatus.PVName
+	if pvName == "" {
+		return nil
+	}
+	pv, err := ds.GetPersistentVolumeRO(pvName)
+	if err != nil {
+		if datastore.ErrorIsNotFound(err) {
+			return nil
+		}
+		return errors.Wrapf(err, "failed to get PV %v of volume %v", pvName, volume.Name)
+	}
+	if pv.Spec.CSI == nil {
+		return nil
+	}
+
+	if pv.Spec.CSI.FSType != "" {
+		labels[types.PVFSTypeLabel] = pv.Spec.CSI.FSType
+	}
+	if secretRef := pv.Spec.CSI.NodeStageSecretRef; secretRef != nil {
+		labels[types.PVSecretNameLabel] = secretRef.Name
+		labels[types.PVSecretNamespaceLabel] = secretRef.Namespace
+	}
+	return nil
+}
+
 func (m *BackupMonitor) monitorBackups() {
 	// If backup.status.state = Pending, use exponential backoff timer to monitor engine/replica backup status
 	// Otherwise, use liner timer to monitor engine/replica backup status
@@ -0,0 +1,82 @@
+package engineapi
+
+import (
+	"github.com/cockroachdb/errors"
+	"github.com/sirupsen/logrus"
+
+	imutil "github.com/longhorn/longhorn-instance-manager/pkg/util"
+	spdkclient "github.com/longhorn/longhorn-spdk-engine/pkg/client"
+
+	"github.com/longhorn/longhorn-manager/util"
+
+	longhorn "github.com/longhorn/longhorn-manager/k8s/pkg/apis/longhorn/v1beta2"
+)
+
+const (
+	// SPDKLvolClusterSize is the cluster size of the lvstores of the v2 data engine disks, which is the unit of the
+	// range checksums of the v2 replica snapshots
+	SPDKLvolClusterSize = 1 * util.MiB
+	// SPDKSnapshotRangeHashMaxClusterCount is the maximum number of clusters of a single range checksums request
+	SPDKSnapshotRangeHashMaxClusterCount = 256
+)
+
+func NewSPDKReplicaService(im *longhorn.InstanceManager, logger logrus.FieldLogger) (s *SPDKReplicaService, err error) {
+	defer func() {
+		err = errors.Wrap(err, "failed to get SPDK replica service client")
+	}()
+
+	if im.Spec.DataEngine != longhorn.DataEngineTypeV2 {
+		return nil, errors.Errorf("%v instance manager is not a %v data engine instance manager", im.Name, longhorn.DataEngineTypeV2)
+	}
+
+	isInstanceManagerRunning := im.Status.CurrentState == longhorn.InstanceManagerStateRunning
+	if !isInstanceManagerRunning {
+		return nil, errors.Errorf("%v instance manager is in %v state, not running state", im.Name, im.Status.CurrentState)
+	}
+
+	hasIP := im.Status.IP != ""
+	if !hasIP {
+		return nil, errors.Errorf("%v instance manager status IP is missing", im.Name)
+	}
+
+	client, err := spdkclient.NewSPDKClient(imutil.GetURL(im.Status.IP, InstanceManagerSpdkServiceDefaultPort))
+	if err != nil {
+		return nil, err
+	}
+
+	return &SPDKReplicaService{
+		logger:              logger,
+		grpcClient:          client,
+		instanceManagerName: im.Name,
+	}, nil
+}
+
+// SPDKReplicaService talks to the SPDK service of a v2 data engine instance manager for the replica operations that
+// are not proxied by the engine
+type SPDKReplicaService struct {
+	logger              logrus.FieldLogger
+	grpcClient          *spdkclient.SPDKClient
+	instanceManagerName string
+}
+
+func (s *SPDKReplicaService) Close() {
+	if s.grpcClient == nil {
+		s.logger.WithError(errors.New("gRPC client not exist")).Warn("Failed to close SPDK replica service client")
+		return
+	}
+
+	if err := s.grpcClient.Close(); err != nil {
+		s.logger.WithError(err).Warn("Failed to close SPDK replica service client")
+	}
+}
+
+// SnapshotRangeHashGet returns the checksums of the clusters [clusterStartIndex, clusterStartIndex+clusterCount) of the
+// replica snapshot by the cluster index
+func (s *SPDKReplicaService) SnapshotRangeHashGet(replicaName, snapshotName string, clusterStartIndex, clusterCount uint64) (map[uint64]uint64, error) {
+	resp, err := s.grpcClient.ReplicaSnapshotRangeHashGet(replicaName, snapshotName, clusterStartIndex, clusterCount)
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to get the range [%d, %d) checksums of snapshot %v of replica %v from instance manager %v",
+			clusterStartIndex, clusterStartIndex+clusterCount, snapshotName, replicaName, s.instanceManagerName)
+	}
+	return resp.RangeHashMap, nil
+}
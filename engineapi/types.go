@@ -155,12 +155,17 @@ type Volume struct {
 }
 
 type BackupTarget struct {
-	Name             string `json:"name"`
-	BackupTargetURL  string `json:"backupTargetURL"`
-	CredentialSecret string `json:"credentialSecret"`
-	PollInterval     string `json:"pollInterval"`
-	Available        bool   `json:"available"`
-	Message          string `json:"message"`
+	Name                  string `json:"name"`
+	BackupTargetURL       string `json:"backupTargetURL"`
+	CredentialSecret      string `json:"credentialSecret"`
+	PollInterval          string `json:"pollInterval"`
+	RetentionLockPeriod   string `json:"retentionLockPeriod"`
+	ReplicationTargetName string `json:"replicationTargetName"`
+	BackupWindow          string `json:"backupWindow"`
+	Available             bool   `json:"available"`
+	Message               string `json:"message"`
+
+	ReplicationStatus map[string]longhorn.BackupTargetReplicationStatus `json:"replicationStatus"`
 }
 
 type BackupVolume struct {
@@ -34,6 +34,13 @@ const (
 	BackupModeIncremental = BackupMode("incremental")
 )
 
+type BackupVerificationResult string
+
+const (
+	BackupVerificationResultPassed = BackupVerificationResult("Passed")
+	BackupVerificationResultFailed = BackupVerificationResult("Failed")
+)
+
 // BackupSpec defines the desired state of the Longhorn backup
 type BackupSpec struct {
 	// The time to request run sync the remote backup.
@@ -126,6 +133,21 @@ type BackupStatus struct {
 	// The backup target name.
 	// +optional
 	BackupTargetName string `json:"backupTargetName"`
+	// The time until which the backup cannot be deleted from the backup target.
+	// +optional
+	// +nullable
+	RetentionLockedUntil metav1.Time `json:"retentionLockedUntil"`
+	// The last time that the backup was verified by restoring it into a throwaway volume.
+	// +optional
+	// +nullable
+	LastVerifiedAt metav1.Time `json:"lastVerifiedAt"`
+	// The result of the last verification of the backup.
+	// Can be "", "Passed" or "Failed".
+	// +optional
+	VerificationResult BackupVerificationResult `json:"verificationResult"`
+	// The message of the last verification of the backup.
+	// +optional
+	VerificationMessage string `json:"verificationMessage"`
 }
 
 // +genclient
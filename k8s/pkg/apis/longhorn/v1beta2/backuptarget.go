@@ -8,6 +8,15 @@ const (
 	BackupTargetConditionReasonUnavailable = "Unavailable"
 )
 
+type BackupTargetReplicationState string
+
+const (
+	BackupTargetReplicationStateReplicating = BackupTargetReplicationState("Replicating")
+	BackupTargetReplicationStateSynced      = BackupTargetReplicationState("Synced")
+	BackupTargetReplicationStateUnavailable = BackupTargetReplicationState("Unavailable")
+	BackupTargetReplicationStateError       = BackupTargetReplicationState("Error")
+)
+
 // BackupTargetSpec defines the desired state of the Longhorn backup target
 type BackupTargetSpec struct {
 	// The backup target URL.
@@ -23,6 +32,51 @@ type BackupTargetSpec struct {
 	// +optional
 	// +nullable
 	SyncRequestedAt metav1.Time `json:"syncRequestedAt"`
+	// The minimum age of the backups in the backup target before they can be deleted.
+	// Backups are not deleted from the backup target until their retention lock expires. Zero means no retention lock.
+	// +optional
+	RetentionLockPeriod metav1.Duration `json:"retentionLockPeriod"`
+	// The name of the secondary backup target that the backups of this backup target are replicated to.
+	// The backup volumes, backups, backing image backups and system backups are copied asynchronously.
+	// +optional
+	ReplicationTargetName string `json:"replicationTargetName"`
+	// The time window in UTC in the format "HH:MM-HH:MM", for example "22:00-06:00", in which backups can start.
+	// The backups created outside the window are pending until the window opens. Empty means no window.
+	// +optional
+	BackupWindow string `json:"backupWindow"`
+}
+
+// BackupTargetReplicationStatus defines the observed state of the replication to a secondary backup target
+type BackupTargetReplicationStatus struct {
+	// The replication state.
+	// +optional
+	State BackupTargetReplicationState `json:"state"`
+	// The last time that all the backups were replicated and verified on the secondary backup target.
+	// +optional
+	LastReplicatedAt string `json:"lastReplicatedAt"`
+	// The number of backups replicated and verified on the secondary backup target.
+	// +optional
+	ReplicatedBackups int `json:"replicatedBackups"`
+	// The number of backups not yet replicated to the secondary backup target.
+	// +optional
+	PendingBackups int `json:"pendingBackups"`
+	// The number of backups that failed to be replicated or verified and will be retried.
+	// +optional
+	FailedBackups int `json:"failedBackups"`
+	// The number of backing image backups replicated and verified on the secondary backup target.
+	// +optional
+	ReplicatedBackingImageBackups int `json:"replicatedBackingImageBackups"`
+	// The number of backing image backups not yet replicated to the secondary backup target.
+	// +optional
+	PendingBackingImageBackups int `json:"pendingBackingImageBackups"`
+	// The number of system backups replicated and verified on the secondary backup target.
+	// +optional
+	ReplicatedSystemBackups int `json:"replicatedSystemBackups"`
+	// The number of system backups not yet replicated to the secondary backup target.
+	// +optional
+	PendingSystemBackups int `json:"pendingSystemBackups"`
+	// +optional
+	Message string `json:"message"`
 }
 
 // BackupTargetStatus defines the observed state of the Longhorn backup target
@@ -41,6 +95,10 @@ type BackupTargetStatus struct {
 	// +optional
 	// +nullable
 	LastSyncedAt metav1.Time `json:"lastSyncedAt"`
+	// The status of the replication to the secondary backup targets, keyed by the backup target names.
+	// +optional
+	// +nullable
+	ReplicationStatus map[string]*BackupTargetReplicationStatus `json:"replicationStatus"`
 }
 
 // +genclient
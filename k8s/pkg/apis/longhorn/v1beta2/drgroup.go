@@ -0,0 +1,132 @@
+package v1beta2
+
+import metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+// +kubebuilder:validation:Enum=primary;secondary
+type DRGroupRole string
+
+const (
+	// DRGroupRolePrimary means the cluster runs the source volumes of the DR group and backs them up to the backup target
+	DRGroupRolePrimary = DRGroupRole("primary")
+	// DRGroupRoleSecondary means the cluster keeps the standby volumes of the DR group restored from the backup target
+	DRGroupRoleSecondary = DRGroupRole("secondary")
+)
+
+type DRGroupState string
+
+const (
+	// DRGroupStateActive means the cluster is the primary of the DR group
+	DRGroupStateActive = DRGroupState("Active")
+	// DRGroupStateSyncing means some standby volumes of the DR group are being created or are not restored yet
+	DRGroupStateSyncing = DRGroupState("Syncing")
+	// DRGroupStateStandby means all standby volumes of the DR group are restored from the latest backups
+	DRGroupStateStandby = DRGroupState("Standby")
+	// DRGroupStateFailingOver means the standby volumes of the DR group are being activated
+	DRGroupStateFailingOver = DRGroupState("FailingOver")
+	// DRGroupStateFailingBack means the stale source volumes of the DR group are being replaced by standby volumes
+	DRGroupStateFailingBack = DRGroupState("FailingBack")
+	// DRGroupStateError means the DR group cannot be reconciled
+	DRGroupStateError = DRGroupState("Error")
+)
+
+// DRGroupSpec defines the desired state of the Longhorn DR group
+type DRGroupSpec struct {
+	// The role of the cluster in the DR group.
+	// Changing the role from secondary to primary fails over the group, and changing it from primary to secondary
+	// fails back the group by seeding the cluster from the backups of the new primary. The stale source volumes are
+	// copied and replaced only once the new primary has completed a backup newer than them.
+	// +optional
+	Role DRGroupRole `json:"role"`
+	// The label selector of the source volumes on the primary cluster.
+	// The selected volumes are labelled with the DR group so that their backups carry the group to the secondary cluster.
+	// +optional
+	// +nullable
+	VolumeSelector map[string]string `json:"volumeSelector"`
+	// The backup target shared by the primary and the secondary clusters.
+	// +optional
+	BackupTargetName string `json:"backupTargetName"`
+	// The number of replicas of the standby volumes. The default replica count setting is used if it is 0.
+	// +optional
+	NumberOfReplicas int `json:"numberOfReplicas"`
+}
+
+// DRGroupVolumeStatus is the observed state of a member volume of the DR group
+type DRGroupVolumeStatus struct {
+	// The backup volume of the member volume on the backup target.
+	// +optional
+	BackupVolumeName string `json:"backupVolumeName"`
+	// The latest backup of the member volume on the backup target.
+	// +optional
+	LastBackupName string `json:"lastBackupName"`
+	// The time of the latest backup of the member volume on the backup target.
+	// +optional
+	LastBackupAt string `json:"lastBackupAt"`
+	// The latest backup restored to the standby volume.
+	// +optional
+	LastRestoredBackupName string `json:"lastRestoredBackupName"`
+	// The time of the latest backup restored to the standby volume.
+	// +optional
+	LastRestoredBackupAt string `json:"lastRestoredBackupAt"`
+	// Indicates if the member volume is a standby volume in the cluster.
+	// +optional
+	Standby bool `json:"standby"`
+	// +optional
+	Message string `json:"message,omitempty"`
+}
+
+// DRGroupStatus defines the observed state of the Longhorn DR group
+type DRGroupStatus struct {
+	// +optional
+	OwnerID string `json:"ownerID"`
+	// The role that the cluster has taken over in the DR group.
+	// +optional
+	Role DRGroupRole `json:"role"`
+	// The DR group state.
+	// +optional
+	State DRGroupState `json:"state"`
+	// The member volumes of the DR group, keyed by the source volume names.
+	// +optional
+	// +nullable
+	Volumes map[string]*DRGroupVolumeStatus `json:"volumes"`
+	// The recovery point of the DR group, which is the oldest data among the member volumes.
+	// On the primary cluster it is the oldest latest backup, and on the secondary cluster it is the oldest
+	// latest restored backup.
+	// +optional
+	RecoveryPoint string `json:"recoveryPoint"`
+	// The time that the group was last failed over to the cluster.
+	// +optional
+	LastFailoverAt string `json:"lastFailoverAt"`
+	// The time that the group was last failed back to the cluster.
+	// +optional
+	LastFailbackAt string `json:"lastFailbackAt"`
+	// +optional
+	Error string `json:"error,omitempty"`
+}
+
+// +genclient
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+// +kubebuilder:resource:shortName=lhdrg
+// +kubebuilder:subresource:status
+// +kubebuilder:storageversion
+// +kubebuilder:printcolumn:name="Role",type=string,JSONPath=`.status.role`,description="The role of the cluster in the DR group"
+// +kubebuilder:printcolumn:name="State",type=string,JSONPath=`.status.state`,description="The state of the DR group"
+// +kubebuilder:printcolumn:name="BackupTarget",type=string,JSONPath=`.spec.backupTargetName`,description="The backup target of the DR group"
+// +kubebuilder:printcolumn:name="RecoveryPoint",type=string,JSONPath=`.status.recoveryPoint`,description="The oldest data among the member volumes"
+// +kubebuilder:printcolumn:name="Age",type=date,JSONPath=`.metadata.creationTimestamp`
+// DRGroup is where Longhorn stores the disaster recovery group object.
+type DRGroup struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   DRGroupSpec   `json:"spec,omitempty"`
+	Status DRGroupStatus `json:"status,omitempty"`
+}
+
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+
+// DRGroupList is a list of DRGroups.
+type DRGroupList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []DRGroup `json:"items"`
+}
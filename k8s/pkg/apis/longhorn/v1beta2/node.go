@@ -32,12 +32,14 @@ const (
 	NodeConditionReasonKubernetesNodeCordoned    = "KubernetesNodeCordoned"
 	NodeConditionReasonHugePagesNotConfigured    = "HugePagesNotConfigured"
 	NodeConditionReasonInsufficientHugePages     = "InsufficientHugePages"
+	NodeConditionReasonNodeMaintenance           = "NodeMaintenance"
 )
 
 const (
 	DiskConditionTypeSchedulable = "Schedulable"
 	DiskConditionTypeReady       = "Ready"
 	DiskConditionTypeError       = "Error"
+	DiskConditionTypeFillingUp   = "FillingUp"
 )
 
 const (
@@ -47,6 +49,7 @@ const (
 	DiskConditionReasonDiskNotReady           = "DiskNotReady"
 	DiskConditionReasonDiskServiceUnreachable = "DiskServiceUnreachable"
 	DiskConditionReasonNodeNotReady           = "NodeNotReady"
+	DiskConditionReasonProjectedFull          = "ProjectedFull"
 )
 
 const (
@@ -54,6 +57,8 @@ const (
 	ErrorReplicaScheduleDiskNotFound                      = "disk not found"
 	ErrorReplicaScheduleDiskUnavailable                   = "disks are unavailable"
 	ErrorReplicaScheduleTagsNotFulfilled                  = "tags not fulfilled"
+	ErrorReplicaScheduleDiskTierNotFulfilled              = "disk tier not fulfilled"
+	ErrorReplicaScheduleTopologySpreadNotSatisfied        = "replica topology spread cannot be satisfied"
 	ErrorReplicaScheduleNodeNotFound                      = "node not found"
 	ErrorReplicaScheduleNodeUnavailable                   = "nodes are unavailable"
 	ErrorReplicaScheduleEngineImageNotReady               = "none of the node candidates contains a ready engine image"
@@ -84,11 +89,66 @@ const (
 	DiskDriverNvme = DiskDriver("nvme")
 )
 
+// DiskTier is the media class of the physical device backing the disk
+type DiskTier string
+
+const (
+	// DiskTierUnknown means the media class of the disk cannot be detected
+	DiskTierUnknown = DiskTier("")
+	DiskTierNvme    = DiskTier("nvme")
+	DiskTierSSD     = DiskTier("ssd")
+	DiskTierHDD     = DiskTier("hdd")
+)
+
 type SnapshotCheckStatus struct {
 	// +optional
 	LastPeriodicCheckedAt metav1.Time `json:"lastPeriodicCheckedAt"`
 }
 
+type NodeMaintenanceState string
+
+const (
+	NodeMaintenanceStateNone = NodeMaintenanceState("")
+	// NodeMaintenanceStateEvicting means the replicas that would leave their volumes without a healthy copy
+	// on the other nodes are being evicted from the node
+	NodeMaintenanceStateEvicting = NodeMaintenanceState("evicting")
+	// NodeMaintenanceStateWaitingForHealthyVolumes means the eviction is done and the volumes with replicas
+	// on the node are being rebuilt to become healthy
+	NodeMaintenanceStateWaitingForHealthyVolumes = NodeMaintenanceState("waiting-for-healthy-volumes")
+	// NodeMaintenanceStateSafeForReboot means the node can be drained and rebooted without losing the last
+	// healthy copy of any volume
+	NodeMaintenanceStateSafeForReboot = NodeMaintenanceState("safe-for-reboot")
+)
+
+type NodeMaintenanceStatus struct {
+	// +optional
+	// +kubebuilder:validation:Enum="";evicting;waiting-for-healthy-volumes;safe-for-reboot
+	State NodeMaintenanceState `json:"state"`
+	// +optional
+	StartedAt string `json:"startedAt"`
+	// +optional
+	CompletedAt string `json:"completedAt"`
+	// The number of replicas on the node still being evicted.
+	// +optional
+	EvictingReplicas int `json:"evictingReplicas"`
+	// The volumes with replicas on the node that are not healthy yet.
+	// +optional
+	// +nullable
+	UnhealthyVolumes []string `json:"unhealthyVolumes"`
+	// The largest number of pending replica evictions and unhealthy volumes observed during the maintenance,
+	// which the progress is calculated against.
+	// +optional
+	TotalTasks int `json:"totalTasks"`
+	// The percentage of the finished replica evictions and volume rebuildings.
+	// +optional
+	Progress int `json:"progress"`
+	// The estimated time the node becomes safe for reboot, based on the progress so far.
+	// +optional
+	EstimatedCompletionAt string `json:"estimatedCompletionAt"`
+	// +optional
+	Message string `json:"message"`
+}
+
 type HealthDataSource string
 
 const (
@@ -198,10 +258,27 @@ type DiskStatus struct {
 	FSType string `json:"filesystemType"`
 	// +optional
 	InstanceManagerName string `json:"instanceManagerName"`
+	// The media class of the physical device backing the disk, detected by the disk monitor.
+	// +optional
+	// +kubebuilder:validation:Enum="";nvme;ssd;hdd
+	Tier DiskTier `json:"tier"`
 	// +optional
 	HealthData map[string]HealthData `json:"healthData,omitempty"`
 	// +optional
 	HealthDataLastCollectedAt metav1.Time `json:"healthDataLastCollectedAt,omitempty"`
+	// The growth rate of the used storage of the disk in bytes per hour, calculated from the recent usage history.
+	// +optional
+	StorageUsageGrowthRate int64 `json:"storageUsageGrowthRate"`
+	// The sum of the actual sizes of the replicas on the disk.
+	// +optional
+	ReplicaActualSize int64 `json:"replicaActualSize"`
+	// The growth rate of the actual sizes of the replicas on the disk in bytes per hour.
+	// +optional
+	ReplicaActualSizeGrowthRate int64 `json:"replicaActualSizeGrowthRate"`
+	// The projected time the available storage of the disk drops below the storage minimal available percentage.
+	// It is empty if the used storage is not growing or there is not enough usage history.
+	// +optional
+	ProjectedFullAt string `json:"projectedFullAt"`
 }
 
 // NodeSpec defines the desired state of the Longhorn node
@@ -218,6 +295,10 @@ type NodeSpec struct {
 	Tags []string `json:"tags"`
 	// +optional
 	InstanceManagerCPURequest int `json:"instanceManagerCPURequest"`
+	// Maintenance requests the node to be prepared for a reboot. Longhorn stops scheduling replicas to the node,
+	// evicts the replicas that are the last healthy copy of their volumes and waits for the volumes to be healthy.
+	// +optional
+	Maintenance bool `json:"maintenance"`
 }
 
 // NodeStatus defines the observed state of the Longhorn node
@@ -236,6 +317,8 @@ type NodeStatus struct {
 	SnapshotCheckStatus SnapshotCheckStatus `json:"snapshotCheckStatus"`
 	// +optional
 	AutoEvicting bool `json:"autoEvicting"`
+	// +optional
+	Maintenance NodeMaintenanceStatus `json:"maintenance"`
 }
 
 // +genclient
@@ -2,18 +2,22 @@ package v1beta2
 
 import metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 
-// +kubebuilder:validation:Enum=snapshot;snapshot-force-create;snapshot-cleanup;snapshot-delete;backup;backup-force-create;filesystem-trim;system-backup
+// +kubebuilder:validation:Enum=snapshot;snapshot-force-create;snapshot-cleanup;snapshot-delete;backup;backup-force-create;filesystem-trim;system-backup;volume-group-snapshot;volume-group-backup;snapshot-checksum-check;backup-verify
 type RecurringJobType string
 
 const (
-	RecurringJobTypeSnapshot            = RecurringJobType("snapshot")              // periodically create snapshots except for old snapshots cleanup failed before creating new snapshots
-	RecurringJobTypeSnapshotForceCreate = RecurringJobType("snapshot-force-create") // periodically create snapshots even if old snapshots cleanup failed
-	RecurringJobTypeSnapshotCleanup     = RecurringJobType("snapshot-cleanup")      // periodically purge removable snapshots and system snapshots
-	RecurringJobTypeSnapshotDelete      = RecurringJobType("snapshot-delete")       // periodically remove and purge all kinds of snapshots that exceed the retention count
-	RecurringJobTypeBackup              = RecurringJobType("backup")                // periodically create snapshots then do backups
-	RecurringJobTypeBackupForceCreate   = RecurringJobType("backup-force-create")   // periodically create snapshots then do backups even if old snapshots cleanup failed
-	RecurringJobTypeFilesystemTrim      = RecurringJobType("filesystem-trim")       // periodically trim filesystem to reclaim disk space
-	RecurringJobTypeSystemBackup        = RecurringJobType("system-backup")         // periodically create system backups
+	RecurringJobTypeSnapshot              = RecurringJobType("snapshot")                // periodically create snapshots except for old snapshots cleanup failed before creating new snapshots
+	RecurringJobTypeSnapshotForceCreate   = RecurringJobType("snapshot-force-create")   // periodically create snapshots even if old snapshots cleanup failed
+	RecurringJobTypeSnapshotCleanup       = RecurringJobType("snapshot-cleanup")        // periodically purge removable snapshots and system snapshots
+	RecurringJobTypeSnapshotDelete        = RecurringJobType("snapshot-delete")         // periodically remove and purge all kinds of snapshots that exceed the retention count
+	RecurringJobTypeBackup                = RecurringJobType("backup")                  // periodically create snapshots then do backups
+	RecurringJobTypeBackupForceCreate     = RecurringJobType("backup-force-create")     // periodically create snapshots then do backups even if old snapshots cleanup failed
+	RecurringJobTypeFilesystemTrim        = RecurringJobType("filesystem-trim")         // periodically trim filesystem to reclaim disk space
+	RecurringJobTypeSystemBackup          = RecurringJobType("system-backup")           // periodically create system backups
+	RecurringJobTypeVolumeGroupSnapshot   = RecurringJobType("volume-group-snapshot")   // periodically create snapshots of all volumes of the job at once, quiesced by the pre snapshot hooks
+	RecurringJobTypeVolumeGroupBackup     = RecurringJobType("volume-group-backup")     // periodically create snapshots of all volumes of the job at once then do backups
+	RecurringJobTypeSnapshotChecksumCheck = RecurringJobType("snapshot-checksum-check") // periodically compare the snapshot checksums of the replicas and rebuild the diverged replicas
+	RecurringJobTypeBackupVerify          = RecurringJobType("backup-verify")           // periodically restore backups into throwaway volumes to verify that they are restorable
 
 	RecurringJobGroupDefault = "default"
 )
@@ -39,7 +43,7 @@ type RecurringJobSpec struct {
 	// +optional
 	Groups []string `json:"groups,omitempty"`
 	// The recurring job task.
-	// Can be "snapshot", "snapshot-force-create", "snapshot-cleanup", "snapshot-delete", "backup", "backup-force-create", "filesystem-trim" or "system-backup".
+	// Can be "snapshot", "snapshot-force-create", "snapshot-cleanup", "snapshot-delete", "backup", "backup-force-create", "filesystem-trim", "system-backup", "volume-group-snapshot", "volume-group-backup", "snapshot-checksum-check" or "backup-verify".
 	// +optional
 	Task RecurringJobType `json:"task"`
 	// The cron setting.
@@ -48,6 +52,11 @@ type RecurringJobSpec struct {
 	// The retain count of the snapshot/backup.
 	// +optional
 	Retain int `json:"retain"`
+	// The GFS (grandfather-father-son) retention policy of the snapshot/backup.
+	// The snapshots/backups kept by the policy are retained in addition to the latest ones kept by Retain.
+	// Only supported by the "snapshot", "snapshot-force-create", "backup" and "backup-force-create" tasks.
+	// +optional
+	RetentionPolicy *RecurringJobRetentionPolicy `json:"retentionPolicy,omitempty"`
 	// The concurrency of taking the snapshot/backup.
 	// +optional
 	Concurrency int `json:"concurrency"`
@@ -55,11 +64,37 @@ type RecurringJobSpec struct {
 	// +optional
 	Labels map[string]string `json:"labels,omitempty"`
 	// The parameters of the snapshot/backup.
-	// Support parameters: "full-backup-interval", "volume-backup-policy".
+	// Support parameters: "full-backup-interval", "volume-backup-policy", "backup-verify-policy", "backup-verify-node",
+	// "backup-verify-filesystem-check".
 	// +optional
 	Parameters map[string]string `json:"parameters,omitempty"`
 }
 
+// RecurringJobRetentionPolicy keeps the newest snapshot/backup of each of the latest hourly, daily, weekly, monthly
+// and yearly periods that contain a snapshot/backup. The periods are based on UTC, and weeks follow ISO 8601.
+type RecurringJobRetentionPolicy struct {
+	// The number of hourly snapshots/backups to keep.
+	// +kubebuilder:validation:Minimum=0
+	// +optional
+	Hourly int `json:"hourly"`
+	// The number of daily snapshots/backups to keep.
+	// +kubebuilder:validation:Minimum=0
+	// +optional
+	Daily int `json:"daily"`
+	// The number of weekly snapshots/backups to keep.
+	// +kubebuilder:validation:Minimum=0
+	// +optional
+	Weekly int `json:"weekly"`
+	// The number of monthly snapshots/backups to keep.
+	// +kubebuilder:validation:Minimum=0
+	// +optional
+	Monthly int `json:"monthly"`
+	// The number of yearly snapshots/backups to keep.
+	// +kubebuilder:validation:Minimum=0
+	// +optional
+	Yearly int `json:"yearly"`
+}
+
 // RecurringJobStatus defines the observed state of the Longhorn recurring job
 type RecurringJobStatus struct {
 	// The owner ID which is responsible to reconcile this recurring job CR.
@@ -76,7 +111,7 @@ type RecurringJobStatus struct {
 // +kubebuilder:subresource:status
 // +kubebuilder:storageversion
 // +kubebuilder:printcolumn:name="Groups",type=string,JSONPath=`.spec.groups`,description="Sets groupings to the jobs. When set to \"default\" group will be added to the volume label when no other job label exist in volume"
-// +kubebuilder:printcolumn:name="Task",type=string,JSONPath=`.spec.task`,description="Should be one of \"snapshot\", \"snapshot-force-create\", \"snapshot-cleanup\", \"snapshot-delete\", \"backup\", \"backup-force-create\", \"filesystem-trim\" or \"system-backup\""
+// +kubebuilder:printcolumn:name="Task",type=string,JSONPath=`.spec.task`,description="Should be one of \"snapshot\", \"snapshot-force-create\", \"snapshot-cleanup\", \"snapshot-delete\", \"backup\", \"backup-force-create\", \"filesystem-trim\", \"system-backup\", \"volume-group-snapshot\", \"volume-group-backup\", \"snapshot-checksum-check\" or \"backup-verify\""
 // +kubebuilder:printcolumn:name="Cron",type=string,JSONPath=`.spec.cron`,description="The cron expression represents recurring job scheduling"
 // +kubebuilder:printcolumn:name="Retain",type=integer,JSONPath=`.spec.retain`,description="The number of snapshots/backups to keep for the volume"
 // +kubebuilder:printcolumn:name="Concurrency",type=integer,JSONPath=`.spec.concurrency`,description="The concurrent job to run by each cron job"
@@ -35,6 +35,8 @@ func addKnownTypes(scheme *runtime.Scheme) error {
 		&BackupTargetList{},
 		&BackupVolume{},
 		&BackupVolumeList{},
+		&DRGroup{},
+		&DRGroupList{},
 		&Engine{},
 		&EngineList{},
 		&EngineImage{},
@@ -65,6 +67,8 @@ func addKnownTypes(scheme *runtime.Scheme) error {
 		&VolumeList{},
 		&VolumeAttachment{},
 		&VolumeAttachmentList{},
+		&VolumeGroupSnapshot{},
+		&VolumeGroupSnapshotList{},
 	)
 	metav1.AddToGroupVersion(scheme, SchemeGroupVersion)
 	return nil
@@ -89,6 +89,18 @@ type ReplicaSpec struct {
 // ReplicaStatus defines the observed state of the Longhorn replica
 type ReplicaStatus struct {
 	InstanceStatus `json:""`
+	// +optional
+	// LastSnapshotChecksumCheckedAt is the SnapshotChecksumCheckRequestedAt of the volume for the most recent snapshot checksum check covering the replica.
+	LastSnapshotChecksumCheckedAt string `json:"lastSnapshotChecksumCheckedAt"`
+	// +optional
+	// MismatchedSnapshotChecksums is the number of snapshots of the replica whose checksum diverged from the majority
+	// of the replicas in the most recent snapshot checksum check, including the snapshot taken of the volume head.
+	MismatchedSnapshotChecksums int64 `json:"mismatchedSnapshotChecksums"`
+	// +optional
+	// MismatchedBlocks is the number of data blocks of the diverged snapshots of the replica that mismatch a healthy
+	// replica in the most recent snapshot checksum check. The blocks are the 1 MiB clusters of the v2 data engine.
+	// The v1 data engine does not locate the blocks, so it is always 0 for the v1 replicas.
+	MismatchedBlocks int64 `json:"mismatchedBlocks"`
 }
 
 // +genclient
@@ -13,6 +13,43 @@ const (
 	SnapshotHashStatusError      = SnapshotHashStatus("error")
 )
 
+type SnapshotHookType string
+
+const (
+	SnapshotHookTypePre  = SnapshotHookType("pre")
+	SnapshotHookTypePost = SnapshotHookType("post")
+)
+
+// SnapshotHookResult records the result of a pre/post snapshot hook executed in a workload pod of the volume
+type SnapshotHookResult struct {
+	// The hook type.
+	// Can be "pre" or "post".
+	// +optional
+	Type SnapshotHookType `json:"type"`
+	// The namespace of the workload pod.
+	// +optional
+	PodNamespace string `json:"podNamespace"`
+	// The name of the workload pod.
+	// +optional
+	PodName string `json:"podName"`
+	// The container in which the hook command is executed.
+	// +optional
+	Container string `json:"container"`
+	// Indicates if the hook command succeeded.
+	// +optional
+	Succeeded bool `json:"succeeded"`
+	// The error message if the hook command failed.
+	// +optional
+	Error string `json:"error,omitempty"`
+	// The on-error policy of the hook.
+	// Can be "Fail" or "Continue".
+	// +optional
+	OnError string `json:"onError"`
+	// The time that the hook command was executed.
+	// +optional
+	ExecutedAt string `json:"executedAt"`
+}
+
 // SnapshotSpec defines the desired state of Longhorn Snapshot
 type SnapshotSpec struct {
 	// the volume that this snapshot belongs to.
@@ -59,6 +96,10 @@ type SnapshotStatus struct {
 	// ChecksumCalculatedAt is the RFC3339 timestamp indicating when the checksum
 	// for this snapshot was last calculated or updated.
 	ChecksumCalculatedAt string `json:"checksumCalculatedAt,omitempty"`
+	// The results of the pre/post snapshot hooks declared on the workload pods of the volume.
+	// +optional
+	// +nullable
+	HookResults []SnapshotHookResult `json:"hookResults,omitempty"`
 }
 
 // +genclient
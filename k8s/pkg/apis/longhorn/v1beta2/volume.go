@@ -99,6 +99,20 @@ const (
 	VolumeOfflineRebuildingIgnored  = VolumeOfflineRebuilding("ignored")
 )
 
+// NFSMountOptions are the NFS client options that the nodes use to mount the share of a RWX volume.
+// The share manager exports the volume with its own export options, which are not configurable.
+// The empty values keep the default mount options.
+type NFSMountOptions struct {
+	// ReadOnly mounts the share as read-only, also when the storage class sets custom nfsOptions.
+	// +optional
+	ReadOnly bool `json:"readOnly"`
+	// NFSVersion is the NFS protocol version used to mount the share. The custom nfsOptions of the storage class
+	// take precedence over it.
+	// +kubebuilder:validation:Enum="";"4.1";"4.2"
+	// +optional
+	NFSVersion string `json:"nfsVersion"`
+}
+
 type VolumeCloneState string
 
 const (
@@ -129,6 +143,7 @@ const (
 	VolumeConditionTypeWaitForBackingImage      = "WaitForBackingImage"
 	VolumeConditionTypeBackingImageIncompatible = "BackingImageIncompatible"
 	VolumeConditionTypeOfflineRebuilding        = "OfflineRebuilding"
+	VolumeConditionTypeRPOExceeded              = "RPOExceeded"
 )
 
 const (
@@ -141,6 +156,7 @@ const (
 	VolumeConditionReasonWaitForBackingImageWaiting      = "Waiting"
 	VolumeConditionReasonBackingImageVirtualSizeTooLarge = "BackingImageVirtualSizeTooLarge"
 	VolumeConditionReasonOfflineRebuildingInProgress     = "OfflineRebuildingInProgress"
+	VolumeConditionReasonRPOThresholdExceeded            = "RPOThresholdExceeded"
 )
 
 type SnapshotDataIntegrity string
@@ -188,6 +204,42 @@ const (
 	ReplicaDiskSoftAntiAffinityDisabled = ReplicaDiskSoftAntiAffinity("disabled")
 )
 
+// +kubebuilder:validation:Enum=soft;hard
+type ReplicaTopologySpreadMode string
+
+const (
+	// ReplicaTopologySpreadModeSoft prefers the topology domains keeping the skew within the max skew,
+	// and falls back to the other domains if none of them can host the replica
+	ReplicaTopologySpreadModeSoft = ReplicaTopologySpreadMode("soft")
+	// ReplicaTopologySpreadModeHard never schedules a replica to a topology domain exceeding the max skew
+	ReplicaTopologySpreadModeHard = ReplicaTopologySpreadMode("hard")
+)
+
+// ReplicaTopologySpreadConstraint spreads the replicas of the volume across the topology domains of the nodes,
+// which are the values of the node label of the topology key.
+type ReplicaTopologySpreadConstraint struct {
+	// The node label key of the topology domains, e.g. rack.
+	TopologyKey string `json:"topologyKey"`
+	// +optional
+	Mode ReplicaTopologySpreadMode `json:"mode"`
+	// The max difference of the replica counts between two topology domains.
+	// +optional
+	// +kubebuilder:validation:Minimum=1
+	MaxSkew int `json:"maxSkew"`
+}
+
+// +kubebuilder:validation:Enum=ignored;balanced;least-allocated;most-allocated;spread-by-replica-count;prefer-fast-disk-tag
+type ReplicaSchedulingPolicy string
+
+const (
+	ReplicaSchedulingPolicyDefault              = ReplicaSchedulingPolicy("ignored")
+	ReplicaSchedulingPolicyBalanced             = ReplicaSchedulingPolicy("balanced")
+	ReplicaSchedulingPolicyLeastAllocated       = ReplicaSchedulingPolicy("least-allocated")
+	ReplicaSchedulingPolicyMostAllocated        = ReplicaSchedulingPolicy("most-allocated")
+	ReplicaSchedulingPolicySpreadByReplicaCount = ReplicaSchedulingPolicy("spread-by-replica-count")
+	ReplicaSchedulingPolicyPreferFastDiskTag    = ReplicaSchedulingPolicy("prefer-fast-disk-tag")
+)
+
 // +kubebuilder:validation:Enum=ignored;enabled;disabled
 type FreezeFilesystemForSnapshot string
 
@@ -274,6 +326,11 @@ type VolumeSpec struct {
 	Standby bool `json:"Standby"`
 	// +optional
 	DiskSelector []string `json:"diskSelector"`
+	// The disk tiers the replicas can be scheduled to, in the order of preference.
+	// The replicas are scheduled to the disks of the first tier that has candidates, and fall back to the next tier otherwise.
+	// Empty means the replicas can be scheduled to the disks of any tier.
+	// +optional
+	DiskTiers []DiskTier `json:"diskTiers"`
 	// +optional
 	NodeSelector []string `json:"nodeSelector"`
 	// +optional
@@ -291,10 +348,22 @@ type VolumeSpec struct {
 	// Replica disk soft anti affinity of the volume. Set enabled to allow replicas to be scheduled in the same disk.
 	// +optional
 	ReplicaDiskSoftAntiAffinity ReplicaDiskSoftAntiAffinity `json:"replicaDiskSoftAntiAffinity"`
+	// Replica topology spread of the volume. The constraints are evaluated in order, after the node and zone
+	// anti affinity, to spread the replicas across the topology domains labelled on the nodes.
+	// +optional
+	ReplicaTopologySpread []ReplicaTopologySpreadConstraint `json:"replicaTopologySpread"`
+	// Replica scheduling policy of the volume. It decides how a disk is picked among the disks that can host a replica.
+	// Set ignored to follow the global setting.
+	// +optional
+	ReplicaSchedulingPolicy ReplicaSchedulingPolicy `json:"replicaSchedulingPolicy"`
 	// +optional
 	LastAttachedBy string `json:"lastAttachedBy"`
 	// +optional
 	AccessMode AccessMode `json:"accessMode"`
+	// NFSMountOptions are the mount options of the share of the RWX volume. The changes take effect when the
+	// volume is staged on a node again.
+	// +optional
+	NFSMountOptions NFSMountOptions `json:"nfsMountOptions"`
 	// +optional
 	Migratable bool `json:"migratable"`
 	// +optional
@@ -356,6 +425,15 @@ type VolumeSpec struct {
 	// If SnapshotHashingRequestedAt differs from LastOnDemandSnapshotHashingCompleteAt, it indicates that a hashing request
 	// is still in progress, and a new request will be rejected.
 	SnapshotHashingRequestedAt string `json:"snapshotHashingRequestedAt,omitempty"` // +optional
+	// +optional
+	// SnapshotChecksumCheckRequestedAt is the RFC3339 timestamp (e.g., "2026-03-16T10:30:00Z") when a snapshot checksum check is requested.
+	// The check takes a snapshot of the volume head, hashes it and the user snapshots on all the replicas, records the number
+	// of snapshots of each replica whose checksum diverges from the majority and fails the diverged replicas, so they are rebuilt.
+	// For the v2 data engine, the mismatched blocks of the diverged snapshots are located as well.
+	//
+	// If SnapshotChecksumCheckRequestedAt differs from LastSnapshotChecksumCheckCompleteAt, it indicates that a check is still in progress, and a new
+	// request will be rejected.
+	SnapshotChecksumCheckRequestedAt string `json:"snapshotChecksumCheckRequestedAt,omitempty"`
 }
 
 // VolumeStatus defines the observed state of the Longhorn volume
@@ -409,6 +487,22 @@ type VolumeStatus struct {
 	// most recent on-demand snapshot checksum calculation completed.
 	// When this value matches SnapshotHashingRequestedAt, the requested on-demand checksum calculation is considered complete.
 	LastOnDemandSnapshotHashingCompleteAt string `json:"lastOnDemandSnapshotHashingCompleteAt,omitempty"`
+	// +optional
+	// LastSnapshotChecksumCheckCompleteAt is the SnapshotChecksumCheckRequestedAt of the most recent completed snapshot checksum check.
+	// When this value matches SnapshotChecksumCheckRequestedAt, the requested check is considered complete.
+	LastSnapshotChecksumCheckCompleteAt string `json:"lastSnapshotChecksumCheckCompleteAt,omitempty"`
+	// +optional
+	// LastRestoredBackup is the latest backup restored to the DR volume.
+	LastRestoredBackup string `json:"lastRestoredBackup,omitempty"`
+	// +optional
+	// LastRestoredBackupAt is the creation time of LastRestoredBackup, which is the recovery point of the DR volume.
+	LastRestoredBackupAt string `json:"lastRestoredBackupAt,omitempty"`
+	// +optional
+	// LastRestoreCompletedAt is the RFC3339 timestamp when the DR volume last finished an incremental restore.
+	LastRestoreCompletedAt string `json:"lastRestoreCompletedAt,omitempty"`
+	// +optional
+	// PendingRestoreBackupCount is the number of backups newer than LastRestoredBackup that the DR volume has not restored yet.
+	PendingRestoreBackupCount int `json:"pendingRestoreBackupCount,omitempty"`
 }
 
 // +genclient
@@ -0,0 +1,83 @@
+package v1beta2
+
+import metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+type VolumeGroupSnapshotState string
+
+const (
+	// VolumeGroupSnapshotStateNone means the volume group snapshot is not started yet
+	VolumeGroupSnapshotStateNone = VolumeGroupSnapshotState("")
+	// VolumeGroupSnapshotStateQuiesced means the pre snapshot hooks are executed and the member snapshots are not requested yet
+	VolumeGroupSnapshotStateQuiesced = VolumeGroupSnapshotState("Quiesced")
+	// VolumeGroupSnapshotStateInProgress means the member volumes are quiesced and the member snapshots are being created
+	VolumeGroupSnapshotStateInProgress = VolumeGroupSnapshotState("InProgress")
+	// VolumeGroupSnapshotStateReady means all member snapshots are created
+	VolumeGroupSnapshotStateReady = VolumeGroupSnapshotState("Ready")
+	// VolumeGroupSnapshotStateError means the volume group snapshot failed
+	VolumeGroupSnapshotStateError = VolumeGroupSnapshotState("Error")
+)
+
+// VolumeGroupSnapshotSpec defines the desired state of the Longhorn volume group snapshot
+type VolumeGroupSnapshotSpec struct {
+	// The member volumes of the group.
+	// The snapshots of the member volumes are created in one coordinated window. They are consistent across the
+	// volumes only if the workloads are quiesced by the pre snapshot hooks, since the IO is not frozen otherwise.
+	// +optional
+	Volumes []string `json:"volumes"`
+	// The labels of the member snapshots.
+	// +optional
+	// +nullable
+	Labels map[string]string `json:"labels"`
+}
+
+// VolumeGroupSnapshotStatus defines the observed state of the Longhorn volume group snapshot
+type VolumeGroupSnapshotStatus struct {
+	// +optional
+	OwnerID string `json:"ownerID"`
+	// The volume group snapshot state.
+	// +optional
+	State VolumeGroupSnapshotState `json:"state"`
+	// The member snapshot names of the group, keyed by the member volume names.
+	// +optional
+	// +nullable
+	Snapshots map[string]string `json:"snapshots"`
+	// The time that the member snapshots were requested, after quiescing the workloads of the member volumes.
+	// +optional
+	CreationTime string `json:"creationTime"`
+	// Indicates if all member snapshots are ready to use.
+	// +optional
+	ReadyToUse bool `json:"readyToUse"`
+	// The results of the pre/post snapshot hooks declared on the workload pods of the member volumes.
+	// +optional
+	// +nullable
+	HookResults []SnapshotHookResult `json:"hookResults,omitempty"`
+	// +optional
+	Error string `json:"error,omitempty"`
+}
+
+// +genclient
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+// +kubebuilder:resource:shortName=lhvgs
+// +kubebuilder:subresource:status
+// +kubebuilder:storageversion
+// +kubebuilder:printcolumn:name="State",type=string,JSONPath=`.status.state`,description="The state of the volume group snapshot"
+// +kubebuilder:printcolumn:name="ReadyToUse",type=boolean,JSONPath=`.status.readyToUse`,description="Indicates if all member snapshots are ready to use"
+// +kubebuilder:printcolumn:name="CreationTime",type=string,JSONPath=`.status.creationTime`,description="The time that the member snapshots were requested"
+// +kubebuilder:printcolumn:name="Age",type=date,JSONPath=`.metadata.creationTimestamp`
+// VolumeGroupSnapshot is where Longhorn stores the volume group snapshot object.
+type VolumeGroupSnapshot struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   VolumeGroupSnapshotSpec   `json:"spec,omitempty"`
+	Status VolumeGroupSnapshotStatus `json:"status,omitempty"`
+}
+
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+
+// VolumeGroupSnapshotList is a list of VolumeGroupSnapshots.
+type VolumeGroupSnapshotList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []VolumeGroupSnapshot `json:"items"`
+}
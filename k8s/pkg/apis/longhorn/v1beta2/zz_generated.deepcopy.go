@@ -720,6 +720,8 @@ func (in *BackupStatus) DeepCopyInto(out *BackupStatus) {
 		}
 	}
 	in.LastSyncedAt.DeepCopyInto(&out.LastSyncedAt)
+	in.RetentionLockedUntil.DeepCopyInto(&out.RetentionLockedUntil)
+	in.LastVerifiedAt.DeepCopyInto(&out.LastVerifiedAt)
 	return
 }
 
@@ -794,11 +796,28 @@ func (in *BackupTargetList) DeepCopyObject() runtime.Object {
 	return nil
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *BackupTargetReplicationStatus) DeepCopyInto(out *BackupTargetReplicationStatus) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new BackupTargetReplicationStatus.
+func (in *BackupTargetReplicationStatus) DeepCopy() *BackupTargetReplicationStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(BackupTargetReplicationStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *BackupTargetSpec) DeepCopyInto(out *BackupTargetSpec) {
 	*out = *in
 	out.PollInterval = in.PollInterval
 	in.SyncRequestedAt.DeepCopyInto(&out.SyncRequestedAt)
+	out.RetentionLockPeriod = in.RetentionLockPeriod
 	return
 }
 
@@ -821,6 +840,21 @@ func (in *BackupTargetStatus) DeepCopyInto(out *BackupTargetStatus) {
 		copy(*out, *in)
 	}
 	in.LastSyncedAt.DeepCopyInto(&out.LastSyncedAt)
+	if in.ReplicationStatus != nil {
+		in, out := &in.ReplicationStatus, &out.ReplicationStatus
+		*out = make(map[string]*BackupTargetReplicationStatus, len(*in))
+		for key, val := range *in {
+			var outVal *BackupTargetReplicationStatus
+			if val == nil {
+				(*out)[key] = nil
+			} else {
+				in, out := &val, &outVal
+				*out = new(BackupTargetReplicationStatus)
+				**out = **in
+			}
+			(*out)[key] = outVal
+		}
+	}
 	return
 }
 
@@ -960,6 +994,137 @@ func (in *Condition) DeepCopy() *Condition {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *DRGroup) DeepCopyInto(out *DRGroup) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DRGroup.
+func (in *DRGroup) DeepCopy() *DRGroup {
+	if in == nil {
+		return nil
+	}
+	out := new(DRGroup)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *DRGroup) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *DRGroupList) DeepCopyInto(out *DRGroupList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]DRGroup, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DRGroupList.
+func (in *DRGroupList) DeepCopy() *DRGroupList {
+	if in == nil {
+		return nil
+	}
+	out := new(DRGroupList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *DRGroupList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *DRGroupSpec) DeepCopyInto(out *DRGroupSpec) {
+	*out = *in
+	if in.VolumeSelector != nil {
+		in, out := &in.VolumeSelector, &out.VolumeSelector
+		*out = make(map[string]string, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DRGroupSpec.
+func (in *DRGroupSpec) DeepCopy() *DRGroupSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(DRGroupSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *DRGroupStatus) DeepCopyInto(out *DRGroupStatus) {
+	*out = *in
+	if in.Volumes != nil {
+		in, out := &in.Volumes, &out.Volumes
+		*out = make(map[string]*DRGroupVolumeStatus, len(*in))
+		for key, val := range *in {
+			var outVal *DRGroupVolumeStatus
+			if val == nil {
+				(*out)[key] = nil
+			} else {
+				in, out := &val, &outVal
+				*out = new(DRGroupVolumeStatus)
+				**out = **in
+			}
+			(*out)[key] = outVal
+		}
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DRGroupStatus.
+func (in *DRGroupStatus) DeepCopy() *DRGroupStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(DRGroupStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *DRGroupVolumeStatus) DeepCopyInto(out *DRGroupVolumeStatus) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DRGroupVolumeStatus.
+func (in *DRGroupVolumeStatus) DeepCopy() *DRGroupVolumeStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(DRGroupVolumeStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *DataEngineSpec) DeepCopyInto(out *DataEngineSpec) {
 	*out = *in
@@ -1711,6 +1876,22 @@ func (in *KubernetesStatus) DeepCopy() *KubernetesStatus {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *NFSMountOptions) DeepCopyInto(out *NFSMountOptions) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NFSMountOptions.
+func (in *NFSMountOptions) DeepCopy() *NFSMountOptions {
+	if in == nil {
+		return nil
+	}
+	out := new(NFSMountOptions)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *Node) DeepCopyInto(out *Node) {
 	*out = *in
@@ -1772,6 +1953,27 @@ func (in *NodeList) DeepCopyObject() runtime.Object {
 	return nil
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *NodeMaintenanceStatus) DeepCopyInto(out *NodeMaintenanceStatus) {
+	*out = *in
+	if in.UnhealthyVolumes != nil {
+		in, out := &in.UnhealthyVolumes, &out.UnhealthyVolumes
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NodeMaintenanceStatus.
+func (in *NodeMaintenanceStatus) DeepCopy() *NodeMaintenanceStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(NodeMaintenanceStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *NodeSpec) DeepCopyInto(out *NodeSpec) {
 	*out = *in
@@ -1824,6 +2026,7 @@ func (in *NodeStatus) DeepCopyInto(out *NodeStatus) {
 		}
 	}
 	in.SnapshotCheckStatus.DeepCopyInto(&out.SnapshotCheckStatus)
+	in.Maintenance.DeepCopyInto(&out.Maintenance)
 	return
 }
 
@@ -2040,6 +2243,22 @@ func (in *RecurringJobList) DeepCopyObject() runtime.Object {
 	return nil
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *RecurringJobRetentionPolicy) DeepCopyInto(out *RecurringJobRetentionPolicy) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RecurringJobRetentionPolicy.
+func (in *RecurringJobRetentionPolicy) DeepCopy() *RecurringJobRetentionPolicy {
+	if in == nil {
+		return nil
+	}
+	out := new(RecurringJobRetentionPolicy)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *RecurringJobSpec) DeepCopyInto(out *RecurringJobSpec) {
 	*out = *in
@@ -2048,6 +2267,11 @@ func (in *RecurringJobSpec) DeepCopyInto(out *RecurringJobSpec) {
 		*out = make([]string, len(*in))
 		copy(*out, *in)
 	}
+	if in.RetentionPolicy != nil {
+		in, out := &in.RetentionPolicy, &out.RetentionPolicy
+		*out = new(RecurringJobRetentionPolicy)
+		**out = **in
+	}
 	if in.Labels != nil {
 		in, out := &in.Labels, &out.Labels
 		*out = make(map[string]string, len(*in))
@@ -2186,6 +2410,22 @@ func (in *ReplicaStatus) DeepCopy() *ReplicaStatus {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ReplicaTopologySpreadConstraint) DeepCopyInto(out *ReplicaTopologySpreadConstraint) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ReplicaTopologySpreadConstraint.
+func (in *ReplicaTopologySpreadConstraint) DeepCopy() *ReplicaTopologySpreadConstraint {
+	if in == nil {
+		return nil
+	}
+	out := new(ReplicaTopologySpreadConstraint)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *RestoreStatus) DeepCopyInto(out *RestoreStatus) {
 	*out = *in
@@ -2462,6 +2702,22 @@ func (in *SnapshotInfo) DeepCopy() *SnapshotInfo {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *SnapshotHookResult) DeepCopyInto(out *SnapshotHookResult) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SnapshotHookResult.
+func (in *SnapshotHookResult) DeepCopy() *SnapshotHookResult {
+	if in == nil {
+		return nil
+	}
+	out := new(SnapshotHookResult)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *SnapshotList) DeepCopyInto(out *SnapshotList) {
 	*out = *in
@@ -2535,6 +2791,11 @@ func (in *SnapshotStatus) DeepCopyInto(out *SnapshotStatus) {
 			(*out)[key] = val
 		}
 	}
+	if in.HookResults != nil {
+		in, out := &in.HookResults, &out.HookResults
+		*out = make([]SnapshotHookResult, len(*in))
+		copy(*out, *in)
+	}
 	return
 }
 
@@ -3043,6 +3304,123 @@ func (in *VolumeCloneStatus) DeepCopy() *VolumeCloneStatus {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *VolumeGroupSnapshot) DeepCopyInto(out *VolumeGroupSnapshot) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new VolumeGroupSnapshot.
+func (in *VolumeGroupSnapshot) DeepCopy() *VolumeGroupSnapshot {
+	if in == nil {
+		return nil
+	}
+	out := new(VolumeGroupSnapshot)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *VolumeGroupSnapshot) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *VolumeGroupSnapshotList) DeepCopyInto(out *VolumeGroupSnapshotList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]VolumeGroupSnapshot, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new VolumeGroupSnapshotList.
+func (in *VolumeGroupSnapshotList) DeepCopy() *VolumeGroupSnapshotList {
+	if in == nil {
+		return nil
+	}
+	out := new(VolumeGroupSnapshotList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *VolumeGroupSnapshotList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *VolumeGroupSnapshotSpec) DeepCopyInto(out *VolumeGroupSnapshotSpec) {
+	*out = *in
+	if in.Volumes != nil {
+		in, out := &in.Volumes, &out.Volumes
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.Labels != nil {
+		in, out := &in.Labels, &out.Labels
+		*out = make(map[string]string, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new VolumeGroupSnapshotSpec.
+func (in *VolumeGroupSnapshotSpec) DeepCopy() *VolumeGroupSnapshotSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(VolumeGroupSnapshotSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *VolumeGroupSnapshotStatus) DeepCopyInto(out *VolumeGroupSnapshotStatus) {
+	*out = *in
+	if in.Snapshots != nil {
+		in, out := &in.Snapshots, &out.Snapshots
+		*out = make(map[string]string, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
+	if in.HookResults != nil {
+		in, out := &in.HookResults, &out.HookResults
+		*out = make([]SnapshotHookResult, len(*in))
+		copy(*out, *in)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new VolumeGroupSnapshotStatus.
+func (in *VolumeGroupSnapshotStatus) DeepCopy() *VolumeGroupSnapshotStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(VolumeGroupSnapshotStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *VolumeList) DeepCopyInto(out *VolumeList) {
 	*out = *in
@@ -3117,11 +3495,22 @@ func (in *VolumeRecurringJobInfo) DeepCopy() *VolumeRecurringJobInfo {
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *VolumeSpec) DeepCopyInto(out *VolumeSpec) {
 	*out = *in
+	out.NFSMountOptions = in.NFSMountOptions
 	if in.DiskSelector != nil {
 		in, out := &in.DiskSelector, &out.DiskSelector
 		*out = make([]string, len(*in))
 		copy(*out, *in)
 	}
+	if in.DiskTiers != nil {
+		in, out := &in.DiskTiers, &out.DiskTiers
+		*out = make([]DiskTier, len(*in))
+		copy(*out, *in)
+	}
+	if in.ReplicaTopologySpread != nil {
+		in, out := &in.ReplicaTopologySpread, &out.ReplicaTopologySpread
+		*out = make([]ReplicaTopologySpreadConstraint, len(*in))
+		copy(*out, *in)
+	}
 	if in.NodeSelector != nil {
 		in, out := &in.NodeSelector, &out.NodeSelector
 		*out = make([]string, len(*in))
@@ -71,6 +71,15 @@ type BackupStatusApplyConfiguration struct {
 	ReUploadedDataSize *string `json:"reUploadedDataSize,omitempty"`
 	// The backup target name.
 	BackupTargetName *string `json:"backupTargetName,omitempty"`
+	// The time until which the backup cannot be deleted from the backup target.
+	RetentionLockedUntil *v1.Time `json:"retentionLockedUntil,omitempty"`
+	// The last time that the backup was verified by restoring it into a throwaway volume.
+	LastVerifiedAt *v1.Time `json:"lastVerifiedAt,omitempty"`
+	// The result of the last verification of the backup.
+	// Can be "", "Passed" or "Failed".
+	VerificationResult *longhornv1beta2.BackupVerificationResult `json:"verificationResult,omitempty"`
+	// The message of the last verification of the backup.
+	VerificationMessage *string `json:"verificationMessage,omitempty"`
 }
 
 // BackupStatusApplyConfiguration constructs a declarative configuration of the BackupStatus type for use with
@@ -258,3 +267,35 @@ func (b *BackupStatusApplyConfiguration) WithBackupTargetName(value string) *Bac
 	b.BackupTargetName = &value
 	return b
 }
+
+// WithRetentionLockedUntil sets the RetentionLockedUntil field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the RetentionLockedUntil field is set to the value of the last call.
+func (b *BackupStatusApplyConfiguration) WithRetentionLockedUntil(value v1.Time) *BackupStatusApplyConfiguration {
+	b.RetentionLockedUntil = &value
+	return b
+}
+
+// WithLastVerifiedAt sets the LastVerifiedAt field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the LastVerifiedAt field is set to the value of the last call.
+func (b *BackupStatusApplyConfiguration) WithLastVerifiedAt(value v1.Time) *BackupStatusApplyConfiguration {
+	b.LastVerifiedAt = &value
+	return b
+}
+
+// WithVerificationResult sets the VerificationResult field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the VerificationResult field is set to the value of the last call.
+func (b *BackupStatusApplyConfiguration) WithVerificationResult(value longhornv1beta2.BackupVerificationResult) *BackupStatusApplyConfiguration {
+	b.VerificationResult = &value
+	return b
+}
+
+// WithVerificationMessage sets the VerificationMessage field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the VerificationMessage field is set to the value of the last call.
+func (b *BackupStatusApplyConfiguration) WithVerificationMessage(value string) *BackupStatusApplyConfiguration {
+	b.VerificationMessage = &value
+	return b
+}
@@ -35,6 +35,15 @@ type BackupTargetSpecApplyConfiguration struct {
 	PollInterval *v1.Duration `json:"pollInterval,omitempty"`
 	// The time to request run sync the remote backup target.
 	SyncRequestedAt *v1.Time `json:"syncRequestedAt,omitempty"`
+	// The minimum age of the backups in the backup target before they can be deleted.
+	// Backups are not deleted from the backup target until their retention lock expires. Zero means no retention lock.
+	RetentionLockPeriod *v1.Duration `json:"retentionLockPeriod,omitempty"`
+	// The name of the secondary backup target that the backups of this backup target are replicated to.
+	// The backup volumes, backups, backing image backups and system backups are copied asynchronously.
+	ReplicationTargetName *string `json:"replicationTargetName,omitempty"`
+	// The time window in UTC in the format "HH:MM-HH:MM", for example "22:00-06:00", in which backups can start.
+	// The backups created outside the window are pending until the window opens. Empty means no window.
+	BackupWindow *string `json:"backupWindow,omitempty"`
 }
 
 // BackupTargetSpecApplyConfiguration constructs a declarative configuration of the BackupTargetSpec type for use with
@@ -74,3 +83,27 @@ func (b *BackupTargetSpecApplyConfiguration) WithSyncRequestedAt(value v1.Time)
 	b.SyncRequestedAt = &value
 	return b
 }
+
+// WithRetentionLockPeriod sets the RetentionLockPeriod field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the RetentionLockPeriod field is set to the value of the last call.
+func (b *BackupTargetSpecApplyConfiguration) WithRetentionLockPeriod(value v1.Duration) *BackupTargetSpecApplyConfiguration {
+	b.RetentionLockPeriod = &value
+	return b
+}
+
+// WithReplicationTargetName sets the ReplicationTargetName field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the ReplicationTargetName field is set to the value of the last call.
+func (b *BackupTargetSpecApplyConfiguration) WithReplicationTargetName(value string) *BackupTargetSpecApplyConfiguration {
+	b.ReplicationTargetName = &value
+	return b
+}
+
+// WithBackupWindow sets the BackupWindow field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the BackupWindow field is set to the value of the last call.
+func (b *BackupTargetSpecApplyConfiguration) WithBackupWindow(value string) *BackupTargetSpecApplyConfiguration {
+	b.BackupWindow = &value
+	return b
+}
@@ -19,6 +19,7 @@ limitations under the License.
 package v1beta2
 
 import (
+	longhornv1beta2 "github.com/longhorn/longhorn-manager/k8s/pkg/apis/longhorn/v1beta2"
 	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 )
 
@@ -35,6 +36,8 @@ type BackupTargetStatusApplyConfiguration struct {
 	Conditions []ConditionApplyConfiguration `json:"conditions,omitempty"`
 	// The last time that the controller synced with the remote backup target.
 	LastSyncedAt *v1.Time `json:"lastSyncedAt,omitempty"`
+	// The status of the replication to the secondary backup targets, keyed by the backup target names.
+	ReplicationStatus map[string]*longhornv1beta2.BackupTargetReplicationStatus `json:"replicationStatus,omitempty"`
 }
 
 // BackupTargetStatusApplyConfiguration constructs a declarative configuration of the BackupTargetStatus type for use with
@@ -79,3 +82,17 @@ func (b *BackupTargetStatusApplyConfiguration) WithLastSyncedAt(value v1.Time) *
 	b.LastSyncedAt = &value
 	return b
 }
+
+// WithReplicationStatus puts the entries into the ReplicationStatus field in the declarative configuration
+// and returns the receiver, so that objects can be build by chaining "With" function invocations.
+// If called multiple times, the entries provided by each call will be put on the ReplicationStatus field,
+// overwriting an existing map entries in ReplicationStatus field with the same key.
+func (b *BackupTargetStatusApplyConfiguration) WithReplicationStatus(entries map[string]*longhornv1beta2.BackupTargetReplicationStatus) *BackupTargetStatusApplyConfiguration {
+	if b.ReplicationStatus == nil && len(entries) > 0 {
+		b.ReplicationStatus = make(map[string]*longhornv1beta2.BackupTargetReplicationStatus, len(entries))
+	}
+	for k, v := range entries {
+		b.ReplicationStatus[k] = v
+	}
+	return b
+}
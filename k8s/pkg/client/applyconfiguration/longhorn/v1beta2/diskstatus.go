@@ -26,21 +26,26 @@ import (
 // DiskStatusApplyConfiguration represents a declarative configuration of the DiskStatus type for use
 // with apply.
 type DiskStatusApplyConfiguration struct {
-	Conditions                []ConditionApplyConfiguration           `json:"conditions,omitempty"`
-	StorageAvailable          *int64                                  `json:"storageAvailable,omitempty"`
-	StorageScheduled          *int64                                  `json:"storageScheduled,omitempty"`
-	StorageMaximum            *int64                                  `json:"storageMaximum,omitempty"`
-	ScheduledReplica          map[string]int64                        `json:"scheduledReplica,omitempty"`
-	ScheduledBackingImage     map[string]int64                        `json:"scheduledBackingImage,omitempty"`
-	DiskUUID                  *string                                 `json:"diskUUID,omitempty"`
-	DiskName                  *string                                 `json:"diskName,omitempty"`
-	DiskPath                  *string                                 `json:"diskPath,omitempty"`
-	Type                      *longhornv1beta2.DiskType               `json:"diskType,omitempty"`
-	DiskDriver                *longhornv1beta2.DiskDriver             `json:"diskDriver,omitempty"`
-	FSType                    *string                                 `json:"filesystemType,omitempty"`
-	InstanceManagerName       *string                                 `json:"instanceManagerName,omitempty"`
-	HealthData                map[string]HealthDataApplyConfiguration `json:"healthData,omitempty"`
-	HealthDataLastCollectedAt *v1.Time                                `json:"healthDataLastCollectedAt,omitempty"`
+	Conditions                  []ConditionApplyConfiguration           `json:"conditions,omitempty"`
+	StorageAvailable            *int64                                  `json:"storageAvailable,omitempty"`
+	StorageScheduled            *int64                                  `json:"storageScheduled,omitempty"`
+	StorageMaximum              *int64                                  `json:"storageMaximum,omitempty"`
+	ScheduledReplica            map[string]int64                        `json:"scheduledReplica,omitempty"`
+	ScheduledBackingImage       map[string]int64                        `json:"scheduledBackingImage,omitempty"`
+	DiskUUID                    *string                                 `json:"diskUUID,omitempty"`
+	DiskName                    *string                                 `json:"diskName,omitempty"`
+	DiskPath                    *string                                 `json:"diskPath,omitempty"`
+	Type                        *longhornv1beta2.DiskType               `json:"diskType,omitempty"`
+	DiskDriver                  *longhornv1beta2.DiskDriver             `json:"diskDriver,omitempty"`
+	FSType                      *string                                 `json:"filesystemType,omitempty"`
+	InstanceManagerName         *string                                 `json:"instanceManagerName,omitempty"`
+	Tier                        *longhornv1beta2.DiskTier               `json:"tier,omitempty"`
+	HealthData                  map[string]HealthDataApplyConfiguration `json:"healthData,omitempty"`
+	HealthDataLastCollectedAt   *v1.Time                                `json:"healthDataLastCollectedAt,omitempty"`
+	StorageUsageGrowthRate      *int64                                  `json:"storageUsageGrowthRate,omitempty"`
+	ReplicaActualSize           *int64                                  `json:"replicaActualSize,omitempty"`
+	ReplicaActualSizeGrowthRate *int64                                  `json:"replicaActualSizeGrowthRate,omitempty"`
+	ProjectedFullAt             *string                                 `json:"projectedFullAt,omitempty"`
 }
 
 // DiskStatusApplyConfiguration constructs a declarative configuration of the DiskStatus type for use with
@@ -170,6 +175,14 @@ func (b *DiskStatusApplyConfiguration) WithInstanceManagerName(value string) *Di
 	return b
 }
 
+// WithTier sets the Tier field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Tier field is set to the value of the last call.
+func (b *DiskStatusApplyConfiguration) WithTier(value longhornv1beta2.DiskTier) *DiskStatusApplyConfiguration {
+	b.Tier = &value
+	return b
+}
+
 // WithHealthData puts the entries into the HealthData field in the declarative configuration
 // and returns the receiver, so that objects can be build by chaining "With" function invocations.
 // If called multiple times, the entries provided by each call will be put on the HealthData field,
@@ -191,3 +204,35 @@ func (b *DiskStatusApplyConfiguration) WithHealthDataLastCollectedAt(value v1.Ti
 	b.HealthDataLastCollectedAt = &value
 	return b
 }
+
+// WithStorageUsageGrowthRate sets the StorageUsageGrowthRate field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the StorageUsageGrowthRate field is set to the value of the last call.
+func (b *DiskStatusApplyConfiguration) WithStorageUsageGrowthRate(value int64) *DiskStatusApplyConfiguration {
+	b.StorageUsageGrowthRate = &value
+	return b
+}
+
+// WithReplicaActualSize sets the ReplicaActualSize field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the ReplicaActualSize field is set to the value of the last call.
+func (b *DiskStatusApplyConfiguration) WithReplicaActualSize(value int64) *DiskStatusApplyConfiguration {
+	b.ReplicaActualSize = &value
+	return b
+}
+
+// WithReplicaActualSizeGrowthRate sets the ReplicaActualSizeGrowthRate field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the ReplicaActualSizeGrowthRate field is set to the value of the last call.
+func (b *DiskStatusApplyConfiguration) WithReplicaActualSizeGrowthRate(value int64) *DiskStatusApplyConfiguration {
+	b.ReplicaActualSizeGrowthRate = &value
+	return b
+}
+
+// WithProjectedFullAt sets the ProjectedFullAt field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the ProjectedFullAt field is set to the value of the last call.
+func (b *DiskStatusApplyConfiguration) WithProjectedFullAt(value string) *DiskStatusApplyConfiguration {
+	b.ProjectedFullAt = &value
+	return b
+}
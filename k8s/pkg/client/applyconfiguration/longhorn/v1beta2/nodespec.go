@@ -29,6 +29,7 @@ type NodeSpecApplyConfiguration struct {
 	EvictionRequested         *bool                                 `json:"evictionRequested,omitempty"`
 	Tags                      []string                              `json:"tags,omitempty"`
 	InstanceManagerCPURequest *int                                  `json:"instanceManagerCPURequest,omitempty"`
+	Maintenance               *bool                                 `json:"maintenance,omitempty"`
 }
 
 // NodeSpecApplyConfiguration constructs a declarative configuration of the NodeSpec type for use with
@@ -92,3 +93,11 @@ func (b *NodeSpecApplyConfiguration) WithInstanceManagerCPURequest(value int) *N
 	b.InstanceManagerCPURequest = &value
 	return b
 }
+
+// WithMaintenance sets the Maintenance field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Maintenance field is set to the value of the last call.
+func (b *NodeSpecApplyConfiguration) WithMaintenance(value bool) *NodeSpecApplyConfiguration {
+	b.Maintenance = &value
+	return b
+}
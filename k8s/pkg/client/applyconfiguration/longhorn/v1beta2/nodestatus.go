@@ -27,12 +27,13 @@ import (
 //
 // NodeStatus defines the observed state of the Longhorn node
 type NodeStatusApplyConfiguration struct {
-	Conditions          []ConditionApplyConfiguration          `json:"conditions,omitempty"`
-	DiskStatus          map[string]*longhornv1beta2.DiskStatus `json:"diskStatus,omitempty"`
-	Region              *string                                `json:"region,omitempty"`
-	Zone                *string                                `json:"zone,omitempty"`
-	SnapshotCheckStatus *SnapshotCheckStatusApplyConfiguration `json:"snapshotCheckStatus,omitempty"`
-	AutoEvicting        *bool                                  `json:"autoEvicting,omitempty"`
+	Conditions          []ConditionApplyConfiguration            `json:"conditions,omitempty"`
+	DiskStatus          map[string]*longhornv1beta2.DiskStatus   `json:"diskStatus,omitempty"`
+	Region              *string                                  `json:"region,omitempty"`
+	Zone                *string                                  `json:"zone,omitempty"`
+	SnapshotCheckStatus *SnapshotCheckStatusApplyConfiguration   `json:"snapshotCheckStatus,omitempty"`
+	AutoEvicting        *bool                                    `json:"autoEvicting,omitempty"`
+	Maintenance         *NodeMaintenanceStatusApplyConfiguration `json:"maintenance,omitempty"`
 }
 
 // NodeStatusApplyConfiguration constructs a declarative configuration of the NodeStatus type for use with
@@ -99,3 +100,11 @@ func (b *NodeStatusApplyConfiguration) WithAutoEvicting(value bool) *NodeStatusA
 	b.AutoEvicting = &value
 	return b
 }
+
+// WithMaintenance sets the Maintenance field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Maintenance field is set to the value of the last call.
+func (b *NodeStatusApplyConfiguration) WithMaintenance(value *NodeMaintenanceStatusApplyConfiguration) *NodeStatusApplyConfiguration {
+	b.Maintenance = value
+	return b
+}
@@ -32,18 +32,23 @@ type RecurringJobSpecApplyConfiguration struct {
 	// The recurring job group.
 	Groups []string `json:"groups,omitempty"`
 	// The recurring job task.
-	// Can be "snapshot", "snapshot-force-create", "snapshot-cleanup", "snapshot-delete", "backup", "backup-force-create", "filesystem-trim" or "system-backup".
+	// Can be "snapshot", "snapshot-force-create", "snapshot-cleanup", "snapshot-delete", "backup", "backup-force-create", "filesystem-trim", "system-backup", "volume-group-snapshot", "volume-group-backup", "snapshot-checksum-check" or "backup-verify".
 	Task *longhornv1beta2.RecurringJobType `json:"task,omitempty"`
 	// The cron setting.
 	Cron *string `json:"cron,omitempty"`
 	// The retain count of the snapshot/backup.
 	Retain *int `json:"retain,omitempty"`
+	// The GFS (grandfather-father-son) retention policy of the snapshot/backup.
+	// The snapshots/backups kept by the policy are retained in addition to the latest ones kept by Retain.
+	// Only supported by the "snapshot", "snapshot-force-create", "backup" and "backup-force-create" tasks.
+	RetentionPolicy *RecurringJobRetentionPolicyApplyConfiguration `json:"retentionPolicy,omitempty"`
 	// The concurrency of taking the snapshot/backup.
 	Concurrency *int `json:"concurrency,omitempty"`
 	// The label of the snapshot/backup.
 	Labels map[string]string `json:"labels,omitempty"`
 	// The parameters of the snapshot/backup.
-	// Support parameters: "full-backup-interval", "volume-backup-policy".
+	// Support parameters: "full-backup-interval", "volume-backup-policy", "backup-verify-policy", "backup-verify-node",
+	// "backup-verify-filesystem-check".
 	Parameters map[string]string `json:"parameters,omitempty"`
 }
 
@@ -95,6 +100,14 @@ func (b *RecurringJobSpecApplyConfiguration) WithRetain(value int) *RecurringJob
 	return b
 }
 
+// WithRetentionPolicy sets the RetentionPolicy field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the RetentionPolicy field is set to the value of the last call.
+func (b *RecurringJobSpecApplyConfiguration) WithRetentionPolicy(value *RecurringJobRetentionPolicyApplyConfiguration) *RecurringJobSpecApplyConfiguration {
+	b.RetentionPolicy = value
+	return b
+}
+
 // WithConcurrency sets the Concurrency field in the declarative configuration to the given value
 // and returns the receiver, so that objects can be built by chaining "With" function invocations.
 // If called multiple times, the Concurrency field is set to the value of the last call.
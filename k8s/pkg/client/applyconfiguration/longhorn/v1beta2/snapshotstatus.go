@@ -38,6 +38,8 @@ type SnapshotStatusApplyConfiguration struct {
 	// ChecksumCalculatedAt is the RFC3339 timestamp indicating when the checksum
 	// for this snapshot was last calculated or updated.
 	ChecksumCalculatedAt *string `json:"checksumCalculatedAt,omitempty"`
+	// The results of the pre/post snapshot hooks declared on the workload pods of the volume.
+	HookResults []SnapshotHookResultApplyConfiguration `json:"hookResults,omitempty"`
 }
 
 // SnapshotStatusApplyConfiguration constructs a declarative configuration of the SnapshotStatus type for use with
@@ -161,3 +163,16 @@ func (b *SnapshotStatusApplyConfiguration) WithChecksumCalculatedAt(value string
 	b.ChecksumCalculatedAt = &value
 	return b
 }
+
+// WithHookResults adds the given value to the HookResults field in the declarative configuration
+// and returns the receiver, so that objects can be build by chaining "With" function invocations.
+// If called multiple times, values provided by each call will be appended to the HookResults field.
+func (b *SnapshotStatusApplyConfiguration) WithHookResults(values ...*SnapshotHookResultApplyConfiguration) *SnapshotStatusApplyConfiguration {
+	for i := range values {
+		if values[i] == nil {
+			panic("nil value passed to WithHookResults")
+		}
+		b.HookResults = append(b.HookResults, *values[i])
+	}
+	return b
+}
@@ -45,6 +45,7 @@ type VolumeSpecApplyConfiguration struct {
 	BackingImage              *string                                        `json:"backingImage,omitempty"`
 	Standby                   *bool                                          `json:"Standby,omitempty"`
 	DiskSelector              []string                                       `json:"diskSelector,omitempty"`
+	DiskTiers                 []longhornv1beta2.DiskTier                     `json:"diskTiers,omitempty"`
 	NodeSelector              []string                                       `json:"nodeSelector,omitempty"`
 	DisableFrontend           *bool                                          `json:"disableFrontend,omitempty"`
 	RevisionCounterDisabled   *bool                                          `json:"revisionCounterDisabled,omitempty"`
@@ -55,14 +56,23 @@ type VolumeSpecApplyConfiguration struct {
 	ReplicaZoneSoftAntiAffinity *longhornv1beta2.ReplicaZoneSoftAntiAffinity `json:"replicaZoneSoftAntiAffinity,omitempty"`
 	// Replica disk soft anti affinity of the volume. Set enabled to allow replicas to be scheduled in the same disk.
 	ReplicaDiskSoftAntiAffinity *longhornv1beta2.ReplicaDiskSoftAntiAffinity `json:"replicaDiskSoftAntiAffinity,omitempty"`
-	LastAttachedBy              *string                                      `json:"lastAttachedBy,omitempty"`
-	AccessMode                  *longhornv1beta2.AccessMode                  `json:"accessMode,omitempty"`
-	Migratable                  *bool                                        `json:"migratable,omitempty"`
-	Encrypted                   *bool                                        `json:"encrypted,omitempty"`
-	NumberOfReplicas            *int                                         `json:"numberOfReplicas,omitempty"`
-	ReplicaAutoBalance          *longhornv1beta2.ReplicaAutoBalance          `json:"replicaAutoBalance,omitempty"`
-	SnapshotDataIntegrity       *longhornv1beta2.SnapshotDataIntegrity       `json:"snapshotDataIntegrity,omitempty"`
-	BackupCompressionMethod     *longhornv1beta2.BackupCompressionMethod     `json:"backupCompressionMethod,omitempty"`
+	// Replica topology spread of the volume. The constraints are evaluated in order, after the node and zone
+	// anti affinity, to spread the replicas across the topology domains labelled on the nodes.
+	ReplicaTopologySpread []ReplicaTopologySpreadConstraintApplyConfiguration `json:"replicaTopologySpread,omitempty"`
+	// Replica scheduling policy of the volume. It decides how a disk is picked among the disks that can host a replica.
+	// Set ignored to follow the global setting.
+	ReplicaSchedulingPolicy *longhornv1beta2.ReplicaSchedulingPolicy `json:"replicaSchedulingPolicy,omitempty"`
+	LastAttachedBy          *string                                  `json:"lastAttachedBy,omitempty"`
+	AccessMode              *longhornv1beta2.AccessMode              `json:"accessMode,omitempty"`
+	// NFSMountOptions are the mount options of the share of the RWX volume. The changes take effect when the
+	// volume is staged on a node again.
+	NFSMountOptions         *NFSMountOptionsApplyConfiguration       `json:"nfsMountOptions,omitempty"`
+	Migratable              *bool                                    `json:"migratable,omitempty"`
+	Encrypted               *bool                                    `json:"encrypted,omitempty"`
+	NumberOfReplicas        *int                                     `json:"numberOfReplicas,omitempty"`
+	ReplicaAutoBalance      *longhornv1beta2.ReplicaAutoBalance      `json:"replicaAutoBalance,omitempty"`
+	SnapshotDataIntegrity   *longhornv1beta2.SnapshotDataIntegrity   `json:"snapshotDataIntegrity,omitempty"`
+	BackupCompressionMethod *longhornv1beta2.BackupCompressionMethod `json:"backupCompressionMethod,omitempty"`
 	// BackupBlockSize indicate the block size to create backups. The block size is immutable.
 	BackupBlockSize  *int64                          `json:"backupBlockSize,omitempty"`
 	DataEngine       *longhornv1beta2.DataEngineType `json:"dataEngine,omitempty"`
@@ -90,6 +100,14 @@ type VolumeSpecApplyConfiguration struct {
 	// If SnapshotHashingRequestedAt differs from LastOnDemandSnapshotHashingCompleteAt, it indicates that a hashing request
 	// is still in progress, and a new request will be rejected.
 	SnapshotHashingRequestedAt *string `json:"snapshotHashingRequestedAt,omitempty"`
+	// SnapshotChecksumCheckRequestedAt is the RFC3339 timestamp (e.g., "2026-03-16T10:30:00Z") when a snapshot checksum check is requested.
+	// The check takes a snapshot of the volume head, hashes it and the user snapshots on all the replicas, records the number
+	// of snapshots of each replica whose checksum diverges from the majority and fails the diverged replicas, so they are rebuilt.
+	// For the v2 data engine, the mismatched blocks of the diverged snapshots are located as well.
+	//
+	// If SnapshotChecksumCheckRequestedAt differs from LastSnapshotChecksumCheckCompleteAt, it indicates that a check is still in progress, and a new
+	// request will be rejected.
+	SnapshotChecksumCheckRequestedAt *string `json:"snapshotChecksumCheckRequestedAt,omitempty"`
 }
 
 // VolumeSpecApplyConfiguration constructs a declarative configuration of the VolumeSpec type for use with
@@ -228,6 +246,16 @@ func (b *VolumeSpecApplyConfiguration) WithDiskSelector(values ...string) *Volum
 	return b
 }
 
+// WithDiskTiers adds the given value to the DiskTiers field in the declarative configuration
+// and returns the receiver, so that objects can be build by chaining "With" function invocations.
+// If called multiple times, values provided by each call will be appended to the DiskTiers field.
+func (b *VolumeSpecApplyConfiguration) WithDiskTiers(values ...longhornv1beta2.DiskTier) *VolumeSpecApplyConfiguration {
+	for i := range values {
+		b.DiskTiers = append(b.DiskTiers, values[i])
+	}
+	return b
+}
+
 // WithNodeSelector adds the given value to the NodeSelector field in the declarative configuration
 // and returns the receiver, so that objects can be build by chaining "With" function invocations.
 // If called multiple times, values provided by each call will be appended to the NodeSelector field.
@@ -286,6 +314,27 @@ func (b *VolumeSpecApplyConfiguration) WithReplicaDiskSoftAntiAffinity(value lon
 	return b
 }
 
+// WithReplicaTopologySpread adds the given value to the ReplicaTopologySpread field in the declarative configuration
+// and returns the receiver, so that objects can be build by chaining "With" function invocations.
+// If called multiple times, values provided by each call will be appended to the ReplicaTopologySpread field.
+func (b *VolumeSpecApplyConfiguration) WithReplicaTopologySpread(values ...*ReplicaTopologySpreadConstraintApplyConfiguration) *VolumeSpecApplyConfiguration {
+	for i := range values {
+		if values[i] == nil {
+			panic("nil value passed to WithReplicaTopologySpread")
+		}
+		b.ReplicaTopologySpread = append(b.ReplicaTopologySpread, *values[i])
+	}
+	return b
+}
+
+// WithReplicaSchedulingPolicy sets the ReplicaSchedulingPolicy field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the ReplicaSchedulingPolicy field is set to the value of the last call.
+func (b *VolumeSpecApplyConfiguration) WithReplicaSchedulingPolicy(value longhornv1beta2.ReplicaSchedulingPolicy) *VolumeSpecApplyConfiguration {
+	b.ReplicaSchedulingPolicy = &value
+	return b
+}
+
 // WithLastAttachedBy sets the LastAttachedBy field in the declarative configuration to the given value
 // and returns the receiver, so that objects can be built by chaining "With" function invocations.
 // If called multiple times, the LastAttachedBy field is set to the value of the last call.
@@ -302,6 +351,14 @@ func (b *VolumeSpecApplyConfiguration) WithAccessMode(value longhornv1beta2.Acce
 	return b
 }
 
+// WithNFSMountOptions sets the NFSMountOptions field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the NFSMountOptions field is set to the value of the last call.
+func (b *VolumeSpecApplyConfiguration) WithNFSMountOptions(value *NFSMountOptionsApplyConfiguration) *VolumeSpecApplyConfiguration {
+	b.NFSMountOptions = value
+	return b
+}
+
 // WithMigratable sets the Migratable field in the declarative configuration to the given value
 // and returns the receiver, so that objects can be built by chaining "With" function invocations.
 // If called multiple times, the Migratable field is set to the value of the last call.
@@ -429,3 +486,11 @@ func (b *VolumeSpecApplyConfiguration) WithSnapshotHashingRequestedAt(value stri
 	b.SnapshotHashingRequestedAt = &value
 	return b
 }
+
+// WithSnapshotChecksumCheckRequestedAt sets the SnapshotChecksumCheckRequestedAt field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the SnapshotChecksumCheckRequestedAt field is set to the value of the last call.
+func (b *VolumeSpecApplyConfiguration) WithSnapshotChecksumCheckRequestedAt(value string) *VolumeSpecApplyConfiguration {
+	b.SnapshotChecksumCheckRequestedAt = &value
+	return b
+}
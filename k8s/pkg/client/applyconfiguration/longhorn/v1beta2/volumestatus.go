@@ -53,6 +53,17 @@ type VolumeStatusApplyConfiguration struct {
 	// most recent on-demand snapshot checksum calculation completed.
 	// When this value matches SnapshotHashingRequestedAt, the requested on-demand checksum calculation is considered complete.
 	LastOnDemandSnapshotHashingCompleteAt *string `json:"lastOnDemandSnapshotHashingCompleteAt,omitempty"`
+	// LastSnapshotChecksumCheckCompleteAt is the SnapshotChecksumCheckRequestedAt of the most recent completed snapshot checksum check.
+	// When this value matches SnapshotChecksumCheckRequestedAt, the requested check is considered complete.
+	LastSnapshotChecksumCheckCompleteAt *string `json:"lastSnapshotChecksumCheckCompleteAt,omitempty"`
+	// LastRestoredBackup is the latest backup restored to the DR volume.
+	LastRestoredBackup *string `json:"lastRestoredBackup,omitempty"`
+	// LastRestoredBackupAt is the creation time of LastRestoredBackup, which is the recovery point of the DR volume.
+	LastRestoredBackupAt *string `json:"lastRestoredBackupAt,omitempty"`
+	// LastRestoreCompletedAt is the RFC3339 timestamp when the DR volume last finished an incremental restore.
+	LastRestoreCompletedAt *string `json:"lastRestoreCompletedAt,omitempty"`
+	// PendingRestoreBackupCount is the number of backups newer than LastRestoredBackup that the DR volume has not restored yet.
+	PendingRestoreBackupCount *int `json:"pendingRestoreBackupCount,omitempty"`
 }
 
 // VolumeStatusApplyConfiguration constructs a declarative configuration of the VolumeStatus type for use with
@@ -241,3 +252,43 @@ func (b *VolumeStatusApplyConfiguration) WithLastOnDemandSnapshotHashingComplete
 	b.LastOnDemandSnapshotHashingCompleteAt = &value
 	return b
 }
+
+// WithLastSnapshotChecksumCheckCompleteAt sets the LastSnapshotChecksumCheckCompleteAt field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the LastSnapshotChecksumCheckCompleteAt field is set to the value of the last call.
+func (b *VolumeStatusApplyConfiguration) WithLastSnapshotChecksumCheckCompleteAt(value string) *VolumeStatusApplyConfiguration {
+	b.LastSnapshotChecksumCheckCompleteAt = &value
+	return b
+}
+
+// WithLastRestoredBackup sets the LastRestoredBackup field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the LastRestoredBackup field is set to the value of the last call.
+func (b *VolumeStatusApplyConfiguration) WithLastRestoredBackup(value string) *VolumeStatusApplyConfiguration {
+	b.LastRestoredBackup = &value
+	return b
+}
+
+// WithLastRestoredBackupAt sets the LastRestoredBackupAt field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the LastRestoredBackupAt field is set to the value of the last call.
+func (b *VolumeStatusApplyConfiguration) WithLastRestoredBackupAt(value string) *VolumeStatusApplyConfiguration {
+	b.LastRestoredBackupAt = &value
+	return b
+}
+
+// WithLastRestoreCompletedAt sets the LastRestoreCompletedAt field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the LastRestoreCompletedAt field is set to the value of the last call.
+func (b *VolumeStatusApplyConfiguration) WithLastRestoreCompletedAt(value string) *VolumeStatusApplyConfiguration {
+	b.LastRestoreCompletedAt = &value
+	return b
+}
+
+// WithPendingRestoreBackupCount sets the PendingRestoreBackupCount field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the PendingRestoreBackupCount field is set to the value of the last call.
+func (b *VolumeStatusApplyConfiguration) WithPendingRestoreBackupCount(value int) *VolumeStatusApplyConfiguration {
+	b.PendingRestoreBackupCount = &value
+	return b
+}
@@ -88,6 +88,12 @@ func ForKind(kind schema.GroupVersionKind) interface{} {
 		return &longhornv1beta2.BackupVolumeStatusApplyConfiguration{}
 	case v1beta2.SchemeGroupVersion.WithKind("Condition"):
 		return &longhornv1beta2.ConditionApplyConfiguration{}
+	case v1beta2.SchemeGroupVersion.WithKind("DRGroup"):
+		return &longhornv1beta2.DRGroupApplyConfiguration{}
+	case v1beta2.SchemeGroupVersion.WithKind("DRGroupSpec"):
+		return &longhornv1beta2.DRGroupSpecApplyConfiguration{}
+	case v1beta2.SchemeGroupVersion.WithKind("DRGroupStatus"):
+		return &longhornv1beta2.DRGroupStatusApplyConfiguration{}
 	case v1beta2.SchemeGroupVersion.WithKind("DataEngineSpec"):
 		return &longhornv1beta2.DataEngineSpecApplyConfiguration{}
 	case v1beta2.SchemeGroupVersion.WithKind("DataEngineStatus"):
@@ -134,8 +140,12 @@ func ForKind(kind schema.GroupVersionKind) interface{} {
 		return &longhornv1beta2.InstanceStatusApplyConfiguration{}
 	case v1beta2.SchemeGroupVersion.WithKind("KubernetesStatus"):
 		return &longhornv1beta2.KubernetesStatusApplyConfiguration{}
+	case v1beta2.SchemeGroupVersion.WithKind("NFSMountOptions"):
+		return &longhornv1beta2.NFSMountOptionsApplyConfiguration{}
 	case v1beta2.SchemeGroupVersion.WithKind("Node"):
 		return &longhornv1beta2.NodeApplyConfiguration{}
+	case v1beta2.SchemeGroupVersion.WithKind("NodeMaintenanceStatus"):
+		return &longhornv1beta2.NodeMaintenanceStatusApplyConfiguration{}
 	case v1beta2.SchemeGroupVersion.WithKind("NodeSpec"):
 		return &longhornv1beta2.NodeSpecApplyConfiguration{}
 	case v1beta2.SchemeGroupVersion.WithKind("NodeStatus"):
@@ -152,6 +162,8 @@ func ForKind(kind schema.GroupVersionKind) interface{} {
 		return &longhornv1beta2.RebuildStatusApplyConfiguration{}
 	case v1beta2.SchemeGroupVersion.WithKind("RecurringJob"):
 		return &longhornv1beta2.RecurringJobApplyConfiguration{}
+	case v1beta2.SchemeGroupVersion.WithKind("RecurringJobRetentionPolicy"):
+		return &longhornv1beta2.RecurringJobRetentionPolicyApplyConfiguration{}
 	case v1beta2.SchemeGroupVersion.WithKind("RecurringJobSpec"):
 		return &longhornv1beta2.RecurringJobSpecApplyConfiguration{}
 	case v1beta2.SchemeGroupVersion.WithKind("RecurringJobStatus"):
@@ -160,6 +172,8 @@ func ForKind(kind schema.GroupVersionKind) interface{} {
 		return &longhornv1beta2.ReplicaApplyConfiguration{}
 	case v1beta2.SchemeGroupVersion.WithKind("ReplicaSpec"):
 		return &longhornv1beta2.ReplicaSpecApplyConfiguration{}
+	case v1beta2.SchemeGroupVersion.WithKind("ReplicaTopologySpreadConstraint"):
+		return &longhornv1beta2.ReplicaTopologySpreadConstraintApplyConfiguration{}
 	case v1beta2.SchemeGroupVersion.WithKind("RestoreStatus"):
 		return &longhornv1beta2.RestoreStatusApplyConfiguration{}
 	case v1beta2.SchemeGroupVersion.WithKind("Setting"):
@@ -178,6 +192,8 @@ func ForKind(kind schema.GroupVersionKind) interface{} {
 		return &longhornv1beta2.SnapshotCheckStatusApplyConfiguration{}
 	case v1beta2.SchemeGroupVersion.WithKind("SnapshotCloneStatus"):
 		return &longhornv1beta2.SnapshotCloneStatusApplyConfiguration{}
+	case v1beta2.SchemeGroupVersion.WithKind("SnapshotHookResult"):
+		return &longhornv1beta2.SnapshotHookResultApplyConfiguration{}
 	case v1beta2.SchemeGroupVersion.WithKind("SnapshotInfo"):
 		return &longhornv1beta2.SnapshotInfoApplyConfiguration{}
 	case v1beta2.SchemeGroupVersion.WithKind("SnapshotSpec"):
@@ -216,6 +232,12 @@ func ForKind(kind schema.GroupVersionKind) interface{} {
 		return &longhornv1beta2.VolumeAttachmentStatusApplyConfiguration{}
 	case v1beta2.SchemeGroupVersion.WithKind("VolumeCloneStatus"):
 		return &longhornv1beta2.VolumeCloneStatusApplyConfiguration{}
+	case v1beta2.SchemeGroupVersion.WithKind("VolumeGroupSnapshot"):
+		return &longhornv1beta2.VolumeGroupSnapshotApplyConfiguration{}
+	case v1beta2.SchemeGroupVersion.WithKind("VolumeGroupSnapshotSpec"):
+		return &longhornv1beta2.VolumeGroupSnapshotSpecApplyConfiguration{}
+	case v1beta2.SchemeGroupVersion.WithKind("VolumeGroupSnapshotStatus"):
+		return &longhornv1beta2.VolumeGroupSnapshotStatusApplyConfiguration{}
 	case v1beta2.SchemeGroupVersion.WithKind("VolumeSpec"):
 		return &longhornv1beta2.VolumeSpecApplyConfiguration{}
 	case v1beta2.SchemeGroupVersion.WithKind("VolumeStatus"):
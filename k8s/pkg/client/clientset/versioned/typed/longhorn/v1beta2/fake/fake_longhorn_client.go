@@ -56,6 +56,10 @@ func (c *FakeLonghornV1beta2) BackupVolumes(namespace string) v1beta2.BackupVolu
 	return newFakeBackupVolumes(c, namespace)
 }
 
+func (c *FakeLonghornV1beta2) DRGroups(namespace string) v1beta2.DRGroupInterface {
+	return newFakeDRGroups(c, namespace)
+}
+
 func (c *FakeLonghornV1beta2) Engines(namespace string) v1beta2.EngineInterface {
 	return newFakeEngines(c, namespace)
 }
@@ -116,6 +120,10 @@ func (c *FakeLonghornV1beta2) VolumeAttachments(namespace string) v1beta2.Volume
 	return newFakeVolumeAttachments(c, namespace)
 }
 
+func (c *FakeLonghornV1beta2) VolumeGroupSnapshots(namespace string) v1beta2.VolumeGroupSnapshotInterface {
+	return newFakeVolumeGroupSnapshots(c, namespace)
+}
+
 // RESTClient returns a RESTClient that is used to communicate
 // with API server by this client implementation.
 func (c *FakeLonghornV1beta2) RESTClient() rest.Interface {
@@ -32,6 +32,8 @@ type BackupTargetExpansion interface{}
 
 type BackupVolumeExpansion interface{}
 
+type DRGroupExpansion interface{}
+
 type EngineExpansion interface{}
 
 type EngineImageExpansion interface{}
@@ -61,3 +63,5 @@ type SystemRestoreExpansion interface{}
 type VolumeExpansion interface{}
 
 type VolumeAttachmentExpansion interface{}
+
+type VolumeGroupSnapshotExpansion interface{}
@@ -35,6 +35,7 @@ type LonghornV1beta2Interface interface {
 	BackupBackingImagesGetter
 	BackupTargetsGetter
 	BackupVolumesGetter
+	DRGroupsGetter
 	EnginesGetter
 	EngineImagesGetter
 	InstanceManagersGetter
@@ -50,6 +51,7 @@ type LonghornV1beta2Interface interface {
 	SystemRestoresGetter
 	VolumesGetter
 	VolumeAttachmentsGetter
+	VolumeGroupSnapshotsGetter
 }
 
 // LonghornV1beta2Client is used to interact with features provided by the longhorn.io group.
@@ -85,6 +87,10 @@ func (c *LonghornV1beta2Client) BackupVolumes(namespace string) BackupVolumeInte
 	return newBackupVolumes(c, namespace)
 }
 
+func (c *LonghornV1beta2Client) DRGroups(namespace string) DRGroupInterface {
+	return newDRGroups(c, namespace)
+}
+
 func (c *LonghornV1beta2Client) Engines(namespace string) EngineInterface {
 	return newEngines(c, namespace)
 }
@@ -145,6 +151,10 @@ func (c *LonghornV1beta2Client) VolumeAttachments(namespace string) VolumeAttach
 	return newVolumeAttachments(c, namespace)
 }
 
+func (c *LonghornV1beta2Client) VolumeGroupSnapshots(namespace string) VolumeGroupSnapshotInterface {
+	return newVolumeGroupSnapshots(c, namespace)
+}
+
 // NewForConfig creates a new LonghornV1beta2Client for the given config.
 // NewForConfig is equivalent to NewForConfigAndClient(c, httpClient),
 // where httpClient was generated with rest.HTTPClientFor(c).
@@ -38,6 +38,8 @@ type Interface interface {
 	BackupTargets() BackupTargetInformer
 	// BackupVolumes returns a BackupVolumeInformer.
 	BackupVolumes() BackupVolumeInformer
+	// DRGroups returns a DRGroupInformer.
+	DRGroups() DRGroupInformer
 	// Engines returns a EngineInformer.
 	Engines() EngineInformer
 	// EngineImages returns a EngineImageInformer.
@@ -68,6 +70,8 @@ type Interface interface {
 	Volumes() VolumeInformer
 	// VolumeAttachments returns a VolumeAttachmentInformer.
 	VolumeAttachments() VolumeAttachmentInformer
+	// VolumeGroupSnapshots returns a VolumeGroupSnapshotInformer.
+	VolumeGroupSnapshots() VolumeGroupSnapshotInformer
 }
 
 type version struct {
@@ -116,6 +120,11 @@ func (v *version) BackupVolumes() BackupVolumeInformer {
 	return &backupVolumeInformer{factory: v.factory, namespace: v.namespace, tweakListOptions: v.tweakListOptions}
 }
 
+// DRGroups returns a DRGroupInformer.
+func (v *version) DRGroups() DRGroupInformer {
+	return &drGroupInformer{factory: v.factory, namespace: v.namespace, tweakListOptions: v.tweakListOptions}
+}
+
 // Engines returns a EngineInformer.
 func (v *version) Engines() EngineInformer {
 	return &engineInformer{factory: v.factory, namespace: v.namespace, tweakListOptions: v.tweakListOptions}
@@ -190,3 +199,8 @@ func (v *version) Volumes() VolumeInformer {
 func (v *version) VolumeAttachments() VolumeAttachmentInformer {
 	return &volumeAttachmentInformer{factory: v.factory, namespace: v.namespace, tweakListOptions: v.tweakListOptions}
 }
+
+// VolumeGroupSnapshots returns a VolumeGroupSnapshotInformer.
+func (v *version) VolumeGroupSnapshots() VolumeGroupSnapshotInformer {
+	return &volumeGroupSnapshotInformer{factory: v.factory, namespace: v.namespace, tweakListOptions: v.tweakListOptions}
+}
@@ -74,6 +74,14 @@ type BackupVolumeListerExpansion interface{}
 // BackupVolumeNamespaceLister.
 type BackupVolumeNamespaceListerExpansion interface{}
 
+// DRGroupListerExpansion allows custom methods to be added to
+// DRGroupLister.
+type DRGroupListerExpansion interface{}
+
+// DRGroupNamespaceListerExpansion allows custom methods to be added to
+// DRGroupNamespaceLister.
+type DRGroupNamespaceListerExpansion interface{}
+
 // EngineListerExpansion allows custom methods to be added to
 // EngineLister.
 type EngineListerExpansion interface{}
@@ -193,3 +201,11 @@ type VolumeAttachmentListerExpansion interface{}
 // VolumeAttachmentNamespaceListerExpansion allows custom methods to be added to
 // VolumeAttachmentNamespaceLister.
 type VolumeAttachmentNamespaceListerExpansion interface{}
+
+// VolumeGroupSnapshotListerExpansion allows custom methods to be added to
+// VolumeGroupSnapshotLister.
+type VolumeGroupSnapshotListerExpansion interface{}
+
+// VolumeGroupSnapshotNamespaceListerExpansion allows custom methods to be added to
+// VolumeGroupSnapshotNamespaceLister.
+type VolumeGroupSnapshotNamespaceListerExpansion interface{}
@@ -61,6 +61,12 @@ func (m *VolumeManager) RestoreBackupBackingImage(name, secret, secretNamespace,
 		return fmt.Errorf("backing image %v already exists", biName)
 	}
 
+	// Restore from a replicated backup backing image if the backup target is unreachable
+	bbi, err = m.ds.GetReachableBackupBackingImageRO(bbi)
+	if err != nil {
+		return errors.Wrapf(err, "failed to find reachable backup backing image of backing image %v", biName)
+	}
+
 	return m.restoreBackingImage(bbi.Spec.BackupTargetName, biName, secret, secretNamespace, dataEngine)
 }
 
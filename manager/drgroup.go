@@ -0,0 +1,93 @@
+package manager
+
+import (
+	"fmt"
+
+	"github.com/cockroachdb/errors"
+	"github.com/sirupsen/logrus"
+
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+
+	"github.com/longhorn/longhorn-manager/util"
+
+	longhorn "github.com/longhorn/longhorn-manager/k8s/pkg/apis/longhorn/v1beta2"
+)
+
+func (m *VolumeManager) CreateDRGroup(obj *longhorn.DRGroup) (*longhorn.DRGroup, error) {
+	logrus.WithFields(logrus.Fields{
+		"drGroup":        obj.Name,
+		"role":           obj.Spec.Role,
+		"volumeSelector": obj.Spec.VolumeSelector,
+		"backupTarget":   obj.Spec.BackupTargetName,
+	}).Info("Creating DRGroup")
+
+	return m.ds.CreateDRGroup(obj)
+}
+
+func (m *VolumeManager) DeleteDRGroup(name string) error {
+	logrus.WithField("drGroup", name).Info("Deleting DRGroup")
+
+	err := m.ds.DeleteDRGroup(name)
+	if err != nil && !apierrors.IsNotFound(err) {
+		return err
+	}
+
+	return nil
+}
+
+func (m *VolumeManager) GetDRGroup(name string) (*longhorn.DRGroup, error) {
+	return m.ds.GetDRGroupRO(name)
+}
+
+func (m *VolumeManager) ListDRGroupsSorted() ([]*longhorn.DRGroup, error) {
+	drGroups, err := m.ds.ListDRGroups()
+	if err != nil {
+		return []*longhorn.DRGroup{}, err
+	}
+
+	drGroupNames, err := util.SortKeys(drGroups)
+	if err != nil {
+		return []*longhorn.DRGroup{}, err
+	}
+
+	sortedDRGroups := make([]*longhorn.DRGroup, len(drGroups))
+	for i, name := range drGroupNames {
+		sortedDRGroups[i] = drGroups[name]
+	}
+	return sortedDRGroups, nil
+}
+
+// FailoverDRGroup makes the cluster the primary of the DR group. The DR group controller activates every standby
+// volume of the group and recreates their PV/PVC.
+func (m *VolumeManager) FailoverDRGroup(name string) (*longhorn.DRGroup, error) {
+	return m.setDRGroupRole(name, longhorn.DRGroupRoleSecondary, longhorn.DRGroupRolePrimary)
+}
+
+// FailbackDRGroup makes the cluster the secondary of the DR group. The DR group controller replaces the stale
+// source volumes of the group with standby volumes seeded from the backups of the new primary.
+func (m *VolumeManager) FailbackDRGroup(name string) (*longhorn.DRGroup, error) {
+	return m.setDRGroupRole(name, longhorn.DRGroupRolePrimary, longhorn.DRGroupRoleSecondary)
+}
+
+func (m *VolumeManager) setDRGroupRole(name string, from, to longhorn.DRGroupRole) (drGroup *longhorn.DRGroup, err error) {
+	defer func() {
+		err = errors.Wrapf(err, "unable to change the role of DR group %v to %v", name, to)
+	}()
+
+	drGroup, err = m.ds.GetDRGroup(name)
+	if err != nil {
+		return nil, err
+	}
+	if drGroup.Spec.Role != from || drGroup.Status.Role != from {
+		return nil, fmt.Errorf("DR group role is %v and the cluster has taken over role %v, expected role %v", drGroup.Spec.Role, drGroup.Status.Role, from)
+	}
+
+	drGroup.Spec.Role = to
+	drGroup, err = m.ds.UpdateDRGroup(drGroup)
+	if err != nil {
+		return nil, err
+	}
+
+	logrus.Infof("Changing the role of DR group %v from %v to %v", name, from, to)
+	return drGroup, nil
+}
@@ -415,7 +415,10 @@ func (m *VolumeManager) UpdateBackupTarget(backupTargetName string, backupTarget
 func isBackupTargetSpecChanged(newSpec, existingSpec *longhorn.BackupTargetSpec) bool {
 	return newSpec.BackupTargetURL != existingSpec.BackupTargetURL ||
 		newSpec.CredentialSecret != existingSpec.CredentialSecret ||
-		newSpec.PollInterval != existingSpec.PollInterval
+		newSpec.PollInterval != existingSpec.PollInterval ||
+		newSpec.RetentionLockPeriod != existingSpec.RetentionLockPeriod ||
+		newSpec.ReplicationTargetName != existingSpec.ReplicationTargetName ||
+		newSpec.BackupWindow != existingSpec.BackupWindow
 }
 
 func (m *VolumeManager) DeleteBackupTarget(backupTargetName string) error {
@@ -70,6 +70,27 @@ func (m *VolumeManager) UpdateNode(n *longhorn.Node) (*longhorn.Node, error) {
 	return node, nil
 }
 
+// SetNodeMaintenance puts the node into maintenance or takes it out of maintenance. Entering maintenance stops
+// replica scheduling to the node and evicts the last healthy replicas, and exiting maintenance restores scheduling
+// so the volumes with replica auto-balance enabled are rebalanced onto the node.
+func (m *VolumeManager) SetNodeMaintenance(name string, maintenance bool) (*longhorn.Node, error) {
+	node, err := m.ds.GetNode(name)
+	if err != nil {
+		return nil, err
+	}
+	if node.Spec.Maintenance == maintenance {
+		return node, nil
+	}
+
+	node.Spec.Maintenance = maintenance
+	node, err = m.ds.UpdateNode(node)
+	if err != nil {
+		return nil, err
+	}
+	logrus.Infof("Set node %v maintenance to %v", node.Name, maintenance)
+	return node, nil
+}
+
 func (m *VolumeManager) ListNodes() (map[string]*longhorn.Node, error) {
 	nodeList, err := m.ds.ListNodes()
 	if err != nil {
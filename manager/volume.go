@@ -15,6 +15,8 @@ import (
 	apierrors "k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 
+	"github.com/longhorn/backupstore"
+
 	"github.com/longhorn/longhorn-manager/controller"
 	"github.com/longhorn/longhorn-manager/datastore"
 	"github.com/longhorn/longhorn-manager/engineapi"
@@ -174,6 +176,7 @@ func (m *VolumeManager) Create(name string, spec *longhorn.VolumeSpec, recurring
 		Spec: longhorn.VolumeSpec{
 			Size:                            spec.Size,
 			AccessMode:                      spec.AccessMode,
+			NFSMountOptions:                 spec.NFSMountOptions,
 			Migratable:                      spec.Migratable,
 			Encrypted:                       spec.Encrypted,
 			Frontend:                        spec.Frontend,
@@ -189,7 +192,9 @@ func (m *VolumeManager) Create(name string, spec *longhorn.VolumeSpec, recurring
 			BackingImage:                    spec.BackingImage,
 			Standby:                         spec.Standby,
 			DiskSelector:                    spec.DiskSelector,
+			DiskTiers:                       spec.DiskTiers,
 			NodeSelector:                    spec.NodeSelector,
+			ReplicaTopologySpread:           spec.ReplicaTopologySpread,
 			RevisionCounterDisabled:         spec.RevisionCounterDisabled,
 			SnapshotDataIntegrity:           spec.SnapshotDataIntegrity,
 			SnapshotMaxCount:                spec.SnapshotMaxCount,
@@ -200,6 +205,7 @@ func (m *VolumeManager) Create(name string, spec *longhorn.VolumeSpec, recurring
 			ReplicaSoftAntiAffinity:         spec.ReplicaSoftAntiAffinity,
 			ReplicaZoneSoftAntiAffinity:     spec.ReplicaZoneSoftAntiAffinity,
 			ReplicaDiskSoftAntiAffinity:     spec.ReplicaDiskSoftAntiAffinity,
+			ReplicaSchedulingPolicy:         spec.ReplicaSchedulingPolicy,
 			DataEngine:                      spec.DataEngine,
 			FreezeFilesystemForSnapshot:     spec.FreezeFilesystemForSnapshot,
 			BackupTargetName:                backupTargetName,
@@ -717,6 +723,112 @@ func (m *VolumeManager) trimRWXVolumeFilesystem(volumeName string, encryptedDevi
 	return client.FilesystemTrim(encryptedDevice)
 }
 
+// CheckFilesystem runs a read-only filesystem check on the volume. The volume must be attached with the frontend
+// enabled and must not be mounted, so it is only meant for the throwaway volumes of the backup verification.
+// Encrypted volumes are not opened by the manager, so their filesystem cannot be checked.
+func (m *VolumeManager) CheckFilesystem(name string) (v *longhorn.Volume, err error) {
+	defer func() {
+		err = errors.Wrapf(err, "unable to check filesystem for volume %v", name)
+	}()
+
+	v, err = m.getVolumeAttachedToCurrentNode(name)
+	if err != nil {
+		return nil, err
+	}
+	if v.Spec.Encrypted {
+		return nil, fmt.Errorf("cannot check filesystem of encrypted volume")
+	}
+
+	return v, util.CheckFilesystem(name)
+}
+
+// CheckBackupBlocks compares the blocks of the volume restored from a backup with the block checksums of the backup.
+// The volume must be attached to the current node with the frontend enabled, so it is only meant for the throwaway
+// volumes of the backup verification. The raw device is read, so encrypted volumes are checked as well.
+func (m *VolumeManager) CheckBackupBlocks(name string) (v *longhorn.Volume, err error) {
+	defer func() {
+		err = errors.Wrapf(err, "unable to check backup blocks for volume %v", name)
+	}()
+
+	v, err = m.getVolumeAttachedToCurrentNode(name)
+	if err != nil {
+		return nil, err
+	}
+	if v.Spec.FromBackup == "" {
+		return nil, fmt.Errorf("volume is not restored from a backup")
+	}
+
+	backupName, volumeName, _, err := backupstore.DecodeBackupURL(v.Spec.FromBackup)
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to decode backup URL %v", v.Spec.FromBackup)
+	}
+	backupTarget, err := m.ds.GetBackupTargetRO(v.Spec.BackupTargetName)
+	if err != nil {
+		return nil, err
+	}
+	backupTargetClient, err := engineapi.NewBackupTargetClientFromBackupTarget(backupTarget, m.ds)
+	if err != nil {
+		return nil, err
+	}
+	driver, err := engineapi.NewBackupStoreDriver(backupTargetClient.URL, backupTargetClient.Credential)
+	if err != nil {
+		return nil, err
+	}
+
+	blocks, err := engineapi.CheckBackupBlocksOnDevice(driver, volumeName, backupName, util.RegularDeviceDirectory+name)
+	if err != nil {
+		return nil, err
+	}
+	logrus.Infof("Checked %v blocks of backup %v restored into volume %v", blocks, backupName, name)
+	return v, nil
+}
+
+func (m *VolumeManager) getVolumeAttachedToCurrentNode(name string) (*longhorn.Volume, error) {
+	v, err := m.ds.GetVolume(name)
+	if err != nil {
+		return nil, err
+	}
+
+	if v.Status.State != longhorn.VolumeStateAttached {
+		return nil, fmt.Errorf("volume is not attached")
+	}
+	if v.Status.FrontendDisabled {
+		return nil, fmt.Errorf("volume frontend is disabled")
+	}
+	if v.Status.CurrentNodeID != m.currentNodeID {
+		return nil, fmt.Errorf("volume is attached to node %v instead of node %v", v.Status.CurrentNodeID, m.currentNodeID)
+	}
+	return v, nil
+}
+
+// SnapshotChecksumCheck requests comparing the snapshot checksums of the replicas of the volume. The replicas diverged from the majority are failed
+// and rebuilt.
+func (m *VolumeManager) SnapshotChecksumCheck(name string) (v *longhorn.Volume, err error) {
+	defer func() {
+		err = errors.Wrapf(err, "unable to check snapshot checksums of volume %v", name)
+	}()
+
+	v, err = m.ds.GetVolume(name)
+	if err != nil {
+		return nil, err
+	}
+
+	if v.Status.State != longhorn.VolumeStateAttached {
+		return nil, fmt.Errorf("volume is not attached")
+	}
+	if v.Spec.SnapshotChecksumCheckRequestedAt != "" && v.Spec.SnapshotChecksumCheckRequestedAt != v.Status.LastSnapshotChecksumCheckCompleteAt {
+		return nil, fmt.Errorf("previous snapshot checksum check requested at %v is still in progress", v.Spec.SnapshotChecksumCheckRequestedAt)
+	}
+
+	v.Spec.SnapshotChecksumCheckRequestedAt = util.Now()
+	v, err = m.ds.UpdateVolume(v)
+	if err != nil {
+		return nil, err
+	}
+	logrus.Infof("Requested snapshot checksum check of volume %v at %v", v.Name, v.Spec.SnapshotChecksumCheckRequestedAt)
+	return v, nil
+}
+
 func (m *VolumeManager) AddVolumeRecurringJob(volumeName string, name string, isGroup bool) (volumeRecurringJob map[string]*longhorn.VolumeRecurringJob, err error) {
 	defer func() {
 		err = errors.Wrapf(err, "failed to add volume recurring jobs for %v", volumeName)
@@ -1162,6 +1274,81 @@ func (m *VolumeManager) UpdateReplicaDiskSoftAntiAffinity(name string, replicaDi
 	return v, nil
 }
 
+func (m *VolumeManager) UpdateReplicaSchedulingPolicy(name string, replicaSchedulingPolicy longhorn.ReplicaSchedulingPolicy) (v *longhorn.Volume, err error) {
+	defer func() {
+		err = errors.Wrapf(err, "unable to update field ReplicaSchedulingPolicy for volume %v", name)
+	}()
+
+	v, err = m.ds.GetVolume(name)
+	if err != nil {
+		return nil, err
+	}
+
+	if v.Spec.ReplicaSchedulingPolicy == replicaSchedulingPolicy {
+		logrus.Debugf("Volume %v already set field ReplicaSchedulingPolicy to %v", v.Name, replicaSchedulingPolicy)
+		return v, nil
+	}
+
+	oldReplicaSchedulingPolicy := v.Spec.ReplicaSchedulingPolicy
+	v.Spec.ReplicaSchedulingPolicy = replicaSchedulingPolicy
+	v, err = m.ds.UpdateVolume(v)
+	if err != nil {
+		return nil, err
+	}
+
+	logrus.Infof("Updated volume %v field ReplicaSchedulingPolicy from %v to %v", v.Name, oldReplicaSchedulingPolicy, replicaSchedulingPolicy)
+	return v, nil
+}
+
+// ExplainScheduling runs a scheduling dry run for a new replica of the existing volume.
+func (m *VolumeManager) ExplainScheduling(name string) (explanation *scheduler.SchedulingExplanation, err error) {
+	defer func() {
+		err = errors.Wrapf(err, "unable to explain replica scheduling for volume %v", name)
+	}()
+
+	v, err := m.ds.GetVolumeRO(name)
+	if err != nil {
+		return nil, err
+	}
+
+	replicas, err := m.ds.ListVolumeReplicasRO(name)
+	if err != nil {
+		return nil, err
+	}
+
+	return m.scheduler.ExplainReplicaScheduling(v, replicas)
+}
+
+// ExplainSchedulingForSpec runs a scheduling dry run for the first replica of a volume that would be created with the
+// spec. Nothing is created.
+func (m *VolumeManager) ExplainSchedulingForSpec(name string, spec *longhorn.VolumeSpec) (explanation *scheduler.SchedulingExplanation, err error) {
+	defer func() {
+		err = errors.Wrapf(err, "unable to explain replica scheduling for volume spec %v", name)
+	}()
+
+	v := &longhorn.Volume{
+		ObjectMeta: metav1.ObjectMeta{
+			Name: name,
+		},
+		Spec: *spec,
+	}
+	v.Spec.Size = util.RoundUpSize(v.Spec.Size)
+	if v.Spec.DataEngine == "" {
+		v.Spec.DataEngine = longhorn.DataEngineTypeV1
+	}
+	if v.Spec.Image == "" {
+		defaultImageSetting := types.SettingNameDefaultEngineImage
+		if types.IsDataEngineV2(v.Spec.DataEngine) {
+			defaultImageSetting = types.SettingNameDefaultInstanceManagerImage
+		}
+		if v.Spec.Image, err = m.ds.GetSettingValueExisted(defaultImageSetting); err != nil {
+			return nil, err
+		}
+	}
+
+	return m.scheduler.ExplainReplicaScheduling(v, map[string]*longhorn.Replica{})
+}
+
 func (m *VolumeManager) verifyDataSourceForVolumeCreation(dataSource longhorn.VolumeDataSource, requestSize int64) (err error) {
 	defer func() {
 		err = errors.Wrapf(err, "failed to verify data source")
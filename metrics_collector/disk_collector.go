@@ -3,6 +3,7 @@ package metricscollector
 import (
 	"strconv"
 	"strings"
+	"time"
 
 	"github.com/cockroachdb/errors"
 	"github.com/prometheus/client_golang/prometheus"
@@ -11,6 +12,7 @@ import (
 	"github.com/longhorn/longhorn-manager/datastore"
 	"github.com/longhorn/longhorn-manager/engineapi"
 	"github.com/longhorn/longhorn-manager/types"
+	"github.com/longhorn/longhorn-manager/util"
 
 	longhorn "github.com/longhorn/longhorn-manager/k8s/pkg/apis/longhorn/v1beta2"
 )
@@ -34,6 +36,12 @@ type DiskCollector struct {
 	// Health metrics
 	healthMetric          metricInfo
 	healthAttributeMetric metricInfo
+
+	// Capacity forecast metrics
+	usageGrowthRateMetric             metricInfo
+	replicaActualSizeMetric           metricInfo
+	replicaActualSizeGrowthRateMetric metricInfo
+	timeToFullMetric                  metricInfo
 }
 
 func NewDiskCollector(
@@ -166,6 +174,47 @@ func NewDiskCollector(
 		Type: prometheus.GaugeValue,
 	}
 
+	// Capacity forecast metrics
+	dc.usageGrowthRateMetric = metricInfo{
+		Desc: prometheus.NewDesc(
+			prometheus.BuildFQName(longhornName, subsystemDisk, "usage_growth_rate_bytes_per_second"),
+			"The growth rate of the used storage of this disk calculated from the recent usage history",
+			[]string{nodeLabel, diskLabel},
+			nil,
+		),
+		Type: prometheus.GaugeValue,
+	}
+
+	dc.replicaActualSizeMetric = metricInfo{
+		Desc: prometheus.NewDesc(
+			prometheus.BuildFQName(longhornName, subsystemDisk, "replica_actual_size_bytes"),
+			"The sum of the actual sizes of the replicas on this disk",
+			[]string{nodeLabel, diskLabel},
+			nil,
+		),
+		Type: prometheus.GaugeValue,
+	}
+
+	dc.replicaActualSizeGrowthRateMetric = metricInfo{
+		Desc: prometheus.NewDesc(
+			prometheus.BuildFQName(longhornName, subsystemDisk, "replica_actual_size_growth_rate_bytes_per_second"),
+			"The growth rate of the actual sizes of the replicas on this disk",
+			[]string{nodeLabel, diskLabel},
+			nil,
+		),
+		Type: prometheus.GaugeValue,
+	}
+
+	dc.timeToFullMetric = metricInfo{
+		Desc: prometheus.NewDesc(
+			prometheus.BuildFQName(longhornName, subsystemDisk, "time_to_full_seconds"),
+			"The projected time until the available storage of this disk drops below the storage minimal available percentage. Only reported when the used storage is growing",
+			[]string{nodeLabel, diskLabel},
+			nil,
+		),
+		Type: prometheus.GaugeValue,
+	}
+
 	return dc
 }
 
@@ -182,6 +231,10 @@ func (dc *DiskCollector) Describe(ch chan<- *prometheus.Desc) {
 	ch <- dc.writeLatencyMetric.Desc
 	ch <- dc.healthMetric.Desc
 	ch <- dc.healthAttributeMetric.Desc
+	ch <- dc.usageGrowthRateMetric.Desc
+	ch <- dc.replicaActualSizeMetric.Desc
+	ch <- dc.replicaActualSizeGrowthRateMetric.Desc
+	ch <- dc.timeToFullMetric.Desc
 }
 
 func (dc *DiskCollector) Collect(ch chan<- prometheus.Metric) {
@@ -244,6 +297,8 @@ func (dc *DiskCollector) collectDiskStorage(ch chan<- prometheus.Metric) {
 		ch <- prometheus.MustNewConstMetric(dc.usageMetric.Desc, dc.usageMetric.Type, float64(storageUsage), dc.currentNodeID, diskName)
 		ch <- prometheus.MustNewConstMetric(dc.reservationMetric.Desc, dc.reservationMetric.Type, float64(storageReservation), dc.currentNodeID, diskName)
 
+		dc.collectDiskForecast(ch, diskName, &disk.Status)
+
 		if diskServiceClient != nil && disk.Spec.Type == longhorn.DiskTypeBlock {
 			diskMetrics, err := diskServiceClient.MetricsGet(string(disk.Spec.Type), diskName, diskPath, diskDriver)
 			if err == nil {
@@ -299,3 +354,23 @@ func (dc *DiskCollector) collectDiskStorage(ch chan<- prometheus.Metric) {
 		}
 	}
 }
+
+func (dc *DiskCollector) collectDiskForecast(ch chan<- prometheus.Metric, diskName string, diskStatus *longhorn.DiskStatus) {
+	ch <- prometheus.MustNewConstMetric(dc.usageGrowthRateMetric.Desc, dc.usageGrowthRateMetric.Type, float64(diskStatus.StorageUsageGrowthRate)/time.Hour.Seconds(), dc.currentNodeID, diskName)
+	ch <- prometheus.MustNewConstMetric(dc.replicaActualSizeMetric.Desc, dc.replicaActualSizeMetric.Type, float64(diskStatus.ReplicaActualSize), dc.currentNodeID, diskName)
+	ch <- prometheus.MustNewConstMetric(dc.replicaActualSizeGrowthRateMetric.Desc, dc.replicaActualSizeGrowthRateMetric.Type, float64(diskStatus.ReplicaActualSizeGrowthRate)/time.Hour.Seconds(), dc.currentNodeID, diskName)
+
+	if diskStatus.ProjectedFullAt == "" {
+		return
+	}
+	projectedFullAt, err := util.ParseTime(diskStatus.ProjectedFullAt)
+	if err != nil {
+		dc.logger.WithError(err).WithField("disk", diskName).Warn("Failed to parse projected full time of disk")
+		return
+	}
+	timeToFull := time.Until(projectedFullAt).Seconds()
+	if timeToFull < 0 {
+		timeToFull = 0
+	}
+	ch <- prometheus.MustNewConstMetric(dc.timeToFullMetric.Desc, dc.timeToFullMetric.Type, timeToFull, dc.currentNodeID, diskName)
+}
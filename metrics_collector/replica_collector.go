@@ -12,8 +12,10 @@ import (
 type ReplicaCollector struct {
 	*baseCollector
 
-	infoMetric  metricInfo
-	stateMetric metricInfo
+	infoMetric                        metricInfo
+	stateMetric                       metricInfo
+	mismatchedSnapshotChecksumsMetric metricInfo
+	mismatchedBlocksMetric            metricInfo
 }
 
 func NewReplicaCollector(
@@ -45,12 +47,34 @@ func NewReplicaCollector(
 		Type: prometheus.GaugeValue,
 	}
 
+	rc.mismatchedSnapshotChecksumsMetric = metricInfo{
+		Desc: prometheus.NewDesc(
+			prometheus.BuildFQName(longhornName, subsystemReplica, "mismatched_snapshot_checksums"),
+			"The number of snapshots of this replica whose checksum diverged from the other replicas in the most recent snapshot checksum check",
+			[]string{replicaLabel, volumeLabel, nodeLabel},
+			nil,
+		),
+		Type: prometheus.GaugeValue,
+	}
+
+	rc.mismatchedBlocksMetric = metricInfo{
+		Desc: prometheus.NewDesc(
+			prometheus.BuildFQName(longhornName, subsystemReplica, "mismatched_blocks"),
+			"The number of data blocks of this replica that diverged from a healthy replica in the most recent snapshot checksum check. Only the v2 data engine locates the blocks",
+			[]string{replicaLabel, volumeLabel, nodeLabel},
+			nil,
+		),
+		Type: prometheus.GaugeValue,
+	}
+
 	return rc
 }
 
 func (rc *ReplicaCollector) Describe(ch chan<- *prometheus.Desc) {
 	ch <- rc.infoMetric.Desc
 	ch <- rc.stateMetric.Desc
+	ch <- rc.mismatchedSnapshotChecksumsMetric.Desc
+	ch <- rc.mismatchedBlocksMetric.Desc
 }
 
 func (rc *ReplicaCollector) Collect(ch chan<- prometheus.Metric) {
@@ -98,5 +122,25 @@ func (rc *ReplicaCollector) Collect(ch chan<- prometheus.Metric) {
 				string(s),
 			)
 		}
+
+		// snapshot checksum check metric: only the checked replicas are reported
+		if r.Status.LastSnapshotChecksumCheckedAt != "" {
+			ch <- prometheus.MustNewConstMetric(
+				rc.mismatchedSnapshotChecksumsMetric.Desc,
+				rc.mismatchedSnapshotChecksumsMetric.Type,
+				float64(r.Status.MismatchedSnapshotChecksums),
+				r.Name,
+				r.Spec.VolumeName,
+				r.Spec.NodeID,
+			)
+			ch <- prometheus.MustNewConstMetric(
+				rc.mismatchedBlocksMetric.Desc,
+				rc.mismatchedBlocksMetric.Type,
+				float64(r.Status.MismatchedBlocks),
+				r.Name,
+				r.Spec.VolumeName,
+				r.Spec.NodeID,
+			)
+		}
 	}
 }
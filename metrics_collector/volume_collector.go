@@ -31,6 +31,8 @@ type VolumeCollector struct {
 	lastBackupAtMetric       metricInfo
 	encryptedMetric          metricInfo
 
+	drVolumeMetrics
+
 	volumePerfMetrics
 }
 
@@ -40,6 +42,14 @@ type volumePerfMetrics struct {
 	latencyMetrics    rwMetrics
 }
 
+// drVolumeMetrics describe how far a DR volume lags behind the backups of its source volume
+type drVolumeMetrics struct {
+	drLastRestoredBackupAgeMetric   metricInfo
+	drLastRestoreCompletedAgeMetric metricInfo
+	drPendingRestoreBackupsMetric   metricInfo
+	drRPOExceededMetric             metricInfo
+}
+
 type rwMetrics struct {
 	read  metricInfo
 	write metricInfo
@@ -185,6 +195,46 @@ func NewVolumeCollector(
 		Type: prometheus.GaugeValue,
 	}
 
+	vc.drLastRestoredBackupAgeMetric = metricInfo{
+		Desc: prometheus.NewDesc(
+			prometheus.BuildFQName(longhornName, subsystemVolume, "dr_last_restored_backup_age_seconds"),
+			"Age in seconds of the last backup restored to this DR volume, which is the recovery point of the volume",
+			[]string{nodeLabel, volumeLabel, pvcLabel, pvcNamespaceLabel},
+			nil,
+		),
+		Type: prometheus.GaugeValue,
+	}
+
+	vc.drLastRestoreCompletedAgeMetric = metricInfo{
+		Desc: prometheus.NewDesc(
+			prometheus.BuildFQName(longhornName, subsystemVolume, "dr_last_restore_completed_age_seconds"),
+			"Seconds since this DR volume last finished an incremental restore",
+			[]string{nodeLabel, volumeLabel, pvcLabel, pvcNamespaceLabel},
+			nil,
+		),
+		Type: prometheus.GaugeValue,
+	}
+
+	vc.drPendingRestoreBackupsMetric = metricInfo{
+		Desc: prometheus.NewDesc(
+			prometheus.BuildFQName(longhornName, subsystemVolume, "dr_pending_restore_backups"),
+			"Number of backups that this DR volume has not restored yet",
+			[]string{nodeLabel, volumeLabel, pvcLabel, pvcNamespaceLabel},
+			nil,
+		),
+		Type: prometheus.GaugeValue,
+	}
+
+	vc.drRPOExceededMetric = metricInfo{
+		Desc: prometheus.NewDesc(
+			prometheus.BuildFQName(longhornName, subsystemVolume, "dr_rpo_exceeded"),
+			"Indicates if the recovery point of this DR volume is older than the standby volume RPO threshold",
+			[]string{nodeLabel, volumeLabel, pvcLabel, pvcNamespaceLabel},
+			nil,
+		),
+		Type: prometheus.GaugeValue,
+	}
+
 	return vc
 }
 
@@ -196,6 +246,10 @@ func (vc *VolumeCollector) Describe(ch chan<- *prometheus.Desc) {
 	ch <- vc.robustnessMetric.Desc
 	ch <- vc.fileSystemReadOnlyMetric.Desc
 	ch <- vc.encryptedMetric.Desc
+	ch <- vc.drLastRestoredBackupAgeMetric.Desc
+	ch <- vc.drLastRestoreCompletedAgeMetric.Desc
+	ch <- vc.drPendingRestoreBackupsMetric.Desc
+	ch <- vc.drRPOExceededMetric.Desc
 }
 
 func (vc *VolumeCollector) Collect(ch chan<- prometheus.Metric) {
@@ -236,6 +290,7 @@ func (vc *VolumeCollector) collectMetrics(ch chan<- prometheus.Metric, v *longho
 
 	vc.collectVolumeState(ch, v)
 	vc.collectVolumeRobustness(ch, v)
+	vc.collectDRVolumeMetrics(ch, v)
 
 	e, err := vc.ds.GetVolumeCurrentEngine(v.Name)
 	if err != nil {
@@ -310,6 +365,28 @@ func (vc *VolumeCollector) collectVolumeState(ch chan<- prometheus.Metric, v *lo
 	}
 }
 
+// collectDRVolumeMetrics emits the recovery point metrics of a DR volume
+func (vc *VolumeCollector) collectDRVolumeMetrics(ch chan<- prometheus.Metric, v *longhorn.Volume) {
+	if !v.Status.IsStandby {
+		return
+	}
+
+	now := time.Now()
+	if restoredBackupAt := getLastBackupAtValue(v.Status.LastRestoredBackupAt, vc.logger); restoredBackupAt != 0 {
+		ch <- prometheus.MustNewConstMetric(vc.drLastRestoredBackupAgeMetric.Desc, vc.drLastRestoredBackupAgeMetric.Type, float64(now.Unix()-restoredBackupAt), vc.currentNodeID, v.Name, v.Status.KubernetesStatus.PVCName, v.Status.KubernetesStatus.Namespace)
+	}
+	if restoreCompletedAt := getLastBackupAtValue(v.Status.LastRestoreCompletedAt, vc.logger); restoreCompletedAt != 0 {
+		ch <- prometheus.MustNewConstMetric(vc.drLastRestoreCompletedAgeMetric.Desc, vc.drLastRestoreCompletedAgeMetric.Type, float64(now.Unix()-restoreCompletedAt), vc.currentNodeID, v.Name, v.Status.KubernetesStatus.PVCName, v.Status.KubernetesStatus.Namespace)
+	}
+	ch <- prometheus.MustNewConstMetric(vc.drPendingRestoreBackupsMetric.Desc, vc.drPendingRestoreBackupsMetric.Type, float64(v.Status.PendingRestoreBackupCount), vc.currentNodeID, v.Name, v.Status.KubernetesStatus.PVCName, v.Status.KubernetesStatus.Namespace)
+
+	rpoExceeded := float64(0)
+	if types.GetCondition(v.Status.Conditions, longhorn.VolumeConditionTypeRPOExceeded).Status == longhorn.ConditionStatusTrue {
+		rpoExceeded = 1
+	}
+	ch <- prometheus.MustNewConstMetric(vc.drRPOExceededMetric.Desc, vc.drRPOExceededMetric.Type, rpoExceeded, vc.currentNodeID, v.Name, v.Status.KubernetesStatus.PVCName, v.Status.KubernetesStatus.Namespace)
+}
+
 func getLastBackupAtValue(lastBackupAt string, logger logrus.FieldLogger) int64 {
 	if lastBackupAt == "" {
 		return 0
@@ -0,0 +1,313 @@
+package scheduler
+
+import (
+	"fmt"
+	"sort"
+
+	"github.com/cockroachdb/errors"
+	"github.com/sirupsen/logrus"
+
+	"github.com/longhorn/longhorn-manager/types"
+
+	longhorn "github.com/longhorn/longhorn-manager/k8s/pkg/apis/longhorn/v1beta2"
+)
+
+// SchedulingRule is the rule of the ReplicaScheduler that rejects a node or a disk for a new replica.
+type SchedulingRule string
+
+const (
+	SchedulingRuleNodeDeleting            = SchedulingRule("NodeDeleting")
+	SchedulingRuleNodeNotReady            = SchedulingRule("NodeNotReady")
+	SchedulingRuleNodeCordoned            = SchedulingRule("NodeCordoned")
+	SchedulingRuleNodeUnschedulable       = SchedulingRule("NodeUnschedulable")
+	SchedulingRuleNodeSchedulingDisabled  = SchedulingRule("NodeSchedulingDisabled")
+	SchedulingRuleDataEngineDisabled      = SchedulingRule("DataEngineDisabled")
+	SchedulingRuleHardNodeAffinity        = SchedulingRule("HardNodeAffinity")
+	SchedulingRuleLinkedClone             = SchedulingRule("LinkedClone")
+	SchedulingRuleInstanceManagerNotReady = SchedulingRule("InstanceManagerNotReady")
+	SchedulingRuleDataEngineImageNotReady = SchedulingRule("DataEngineImageNotReady")
+	SchedulingRuleNodeTags                = SchedulingRule("NodeTags")
+	SchedulingRuleBackingImageNodeTags    = SchedulingRule("BackingImageNodeTags")
+	SchedulingRuleNodeAntiAffinity        = SchedulingRule("NodeAntiAffinity")
+	SchedulingRuleZoneAntiAffinity        = SchedulingRule("ZoneAntiAffinity")
+	SchedulingRuleTopologySpread          = SchedulingRule("TopologySpread")
+
+	SchedulingRuleDiskSchedulingDisabled     = SchedulingRule("DiskSchedulingDisabled")
+	SchedulingRuleDiskEvictionRequested      = SchedulingRule("DiskEvictionRequested")
+	SchedulingRuleDiskPressure               = SchedulingRule("DiskPressure")
+	SchedulingRuleDiskUnschedulable          = SchedulingRule("DiskUnschedulable")
+	SchedulingRuleDiskTypeIncompatible       = SchedulingRule("DiskTypeIncompatible")
+	SchedulingRuleVolumeSizeIncompatible     = SchedulingRule("VolumeSizeIncompatible")
+	SchedulingRuleStorageOverProvisioning    = SchedulingRule("StorageOverProvisioning")
+	SchedulingRuleDiskTags                   = SchedulingRule("DiskTags")
+	SchedulingRuleDiskTier                   = SchedulingRule("DiskTier")
+	SchedulingRuleBackingImageDiskTags       = SchedulingRule("BackingImageDiskTags")
+	SchedulingRuleDiskAntiAffinity           = SchedulingRule("DiskAntiAffinity")
+	SchedulingRuleDiskSchedulingInfoNotFound = SchedulingRule("DiskSchedulingInfoNotFound")
+)
+
+// DiskSchedulingExplanation tells whether a disk can host a new replica of the volume and, if not, which rule rejects it.
+type DiskSchedulingExplanation struct {
+	DiskName         string
+	DiskUUID         string
+	Path             string
+	Tags             []string
+	Tier             longhorn.DiskTier
+	StorageAvailable int64
+	StorageMaximum   int64
+	StorageReserved  int64
+	StorageScheduled int64
+
+	Rejected bool
+	Rule     SchedulingRule
+	Message  string
+}
+
+// NodeSchedulingExplanation tells whether a node can host a new replica of the volume and, if not, which rule rejects
+// it. The disks of a rejected node are still evaluated, so that all the blocking rules can be fixed at once.
+type NodeSchedulingExplanation struct {
+	NodeName string
+	Zone     string
+	Tags     []string
+
+	Rejected bool
+	Rule     SchedulingRule
+	Message  string
+
+	Disks map[string]*DiskSchedulingExplanation
+}
+
+// SchedulingExplanation is the result of a scheduling dry run for a new replica of a volume.
+// Schedulable, DiskCandidates and SelectedDisk come from FindDiskCandidates, which also honors the soft anti-affinity
+// preferences, while Nodes lists the verdict of each hard rule.
+type SchedulingExplanation struct {
+	VolumeName     string
+	Schedulable    bool
+	DiskCandidates []string
+	SelectedNode   string
+	SelectedDisk   string
+	Errors         map[string][]string
+
+	Nodes map[string]*NodeSchedulingExplanation
+}
+
+func (e *NodeSchedulingExplanation) reject(rule SchedulingRule, format string, args ...interface{}) {
+	if e.Rejected {
+		return
+	}
+	e.Rejected = true
+	e.Rule = rule
+	e.Message = fmt.Sprintf(format, args...)
+}
+
+func (e *NodeSchedulingExplanation) rejectBy(rejection *schedulingRejection) {
+	if rejection != nil {
+		e.reject(rejection.rule, "%v", rejection.message)
+	}
+}
+
+func (e *DiskSchedulingExplanation) reject(rule SchedulingRule, format string, args ...interface{}) {
+	if e.Rejected {
+		return
+	}
+	e.Rejected = true
+	e.Rule = rule
+	e.Message = fmt.Sprintf(format, args...)
+}
+
+func (e *DiskSchedulingExplanation) rejectBy(rejection *schedulingRejection) {
+	if rejection != nil {
+		e.reject(rejection.rule, "%v", rejection.message)
+	}
+}
+
+// ExplainReplicaScheduling runs the scheduling rules for a new replica of the volume without mutating anything. The
+// volume does not have to exist, in which case replicas should be empty.
+func (rcs *ReplicaScheduler) ExplainReplicaScheduling(volume *longhorn.Volume, replicas map[string]*longhorn.Replica) (*SchedulingExplanation, error) {
+	replica := newExplainReplica(volume)
+
+	explanation := &SchedulingExplanation{
+		VolumeName:     volume.Name,
+		DiskCandidates: []string{},
+		Errors:         map[string][]string{},
+		Nodes:          map[string]*NodeSchedulingExplanation{},
+	}
+
+	nodes, err := rcs.ds.ListNodes()
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to list nodes")
+	}
+
+	biNodeSelector := []string{}
+	biDiskSelector := []string{}
+	if volume.Spec.BackingImage != "" {
+		bi, err := rcs.ds.GetBackingImageRO(volume.Spec.BackingImage)
+		if err != nil {
+			return nil, errors.Wrapf(err, "failed to get backing image %v", volume.Spec.BackingImage)
+		}
+		biNodeSelector = bi.Spec.NodeSelector
+		biDiskSelector = bi.Spec.DiskSelector
+	}
+
+	linkedClone := volume.Spec.CloneMode == longhorn.CloneModeLinkedClone
+	linkedCloneSrcReplicaNodes := map[string]bool{}
+	linkedCloneSrcReplicaDisks := map[string]bool{}
+	if linkedClone {
+		linkedCloneSrcReplicaNodes, linkedCloneSrcReplicaDisks, err = rcs.getSrcReplicaNodesAndDisks(volume)
+		if err != nil {
+			return nil, errors.Wrapf(err, "failed to list replicas of the src volume of volume %v", volume.Name)
+		}
+	}
+
+	nodeSoftAntiAffinity, zoneSoftAntiAffinity, diskSoftAntiAffinity, err := rcs.getSoftAntiAffinity(volume)
+	if err != nil {
+		return nil, err
+	}
+
+	allowEmptyNodeSelectorVolume, err := rcs.ds.GetSettingAsBool(types.SettingNameAllowEmptyNodeSelectorVolume)
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to get %v setting", types.SettingNameAllowEmptyNodeSelectorVolume)
+	}
+	allowEmptyDiskSelectorVolume, err := rcs.ds.GetSettingAsBool(types.SettingNameAllowEmptyDiskSelectorVolume)
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to get %v setting", types.SettingNameAllowEmptyDiskSelectorVolume)
+	}
+
+	usedNodes, usedZones, onlyEvictingNodes, onlyEvictingZones := getCurrentNodesAndZones(replicas, nodes, false, false)
+	topologySpread, err := rcs.newReplicaTopologySpread(volume, nodes, replicas, false, false)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to get replica topology spread")
+	}
+	nodeNames := map[string]struct{}{}
+	for nodeName := range nodes {
+		nodeNames[nodeName] = struct{}{}
+	}
+	topologySpreadNodes := topologySpread.filterNodes(nodeNames, longhorn.ReplicaTopologySpreadModeHard)
+
+	for _, node := range nodes {
+		nodeExplanation := rcs.explainNode(node, replica, volume, linkedClone, linkedCloneSrcReplicaNodes,
+			biNodeSelector, allowEmptyNodeSelectorVolume)
+
+		if _, used := usedNodes[node.Name]; used && !onlyEvictingNodes[node.Name] && !nodeSoftAntiAffinity {
+			nodeExplanation.reject(SchedulingRuleNodeAntiAffinity,
+				"node already hosts a replica of volume %v and replica node soft anti-affinity is disabled", volume.Name)
+		}
+		if usedZones[node.Status.Zone] && !onlyEvictingZones[node.Status.Zone] && !zoneSoftAntiAffinity {
+			nodeExplanation.reject(SchedulingRuleZoneAntiAffinity,
+				"zone %q already hosts a replica of volume %v and replica zone soft anti-affinity is disabled", node.Status.Zone, volume.Name)
+		}
+		if _, ok := topologySpreadNodes[node.Name]; !ok {
+			nodeExplanation.reject(SchedulingRuleTopologySpread,
+				"node does not satisfy the hard replica topology spread %+v of volume %v", volume.Spec.ReplicaTopologySpread, volume.Name)
+		}
+
+		for diskName, diskStatus := range node.Status.DiskStatus {
+			diskSpec, exists := node.Spec.Disks[diskName]
+			if !exists {
+				continue
+			}
+			diskExplanation := rcs.explainDisk(node, diskName, diskSpec, diskStatus, replicas, volume,
+				linkedClone, linkedCloneSrcReplicaDisks, biDiskSelector, allowEmptyDiskSelectorVolume)
+			disks := map[string]*Disk{diskStatus.DiskUUID: {DiskSpec: diskSpec, DiskStatus: diskStatus, NodeID: node.Name}}
+			if len(filterDisksWithMatchingReplicas(disks, replicas, diskSoftAntiAffinity, false)) == 0 {
+				diskExplanation.reject(SchedulingRuleDiskAntiAffinity,
+					"disk already hosts a replica of volume %v and replica disk soft anti-affinity is disabled", volume.Name)
+			}
+			nodeExplanation.Disks[diskName] = diskExplanation
+		}
+
+		explanation.Nodes[node.Name] = nodeExplanation
+	}
+
+	diskCandidates, multiError := rcs.FindDiskCandidates(replica, replicas, volume)
+	for reason, errs := range multiError {
+		for _, err := range errs {
+			explanation.Errors[reason] = append(explanation.Errors[reason], err.Error())
+		}
+	}
+	for diskUUID := range diskCandidates {
+		explanation.DiskCandidates = append(explanation.DiskCandidates, diskUUID)
+	}
+	sort.Strings(explanation.DiskCandidates)
+
+	if len(diskCandidates) > 0 {
+		explanation.Schedulable = true
+		policy := rcs.ds.GetReplicaSchedulingPolicySetting(volume, logrus.WithField("volume", volume.Name))
+		if disk := rcs.selectDisk(diskCandidates, volume.Spec.Size, policy); disk != nil {
+			explanation.SelectedNode = disk.NodeID
+			explanation.SelectedDisk = disk.DiskUUID
+		}
+	}
+
+	return explanation, nil
+}
+
+// newExplainReplica returns a replica that is never persisted, only used to evaluate the scheduling rules.
+func newExplainReplica(volume *longhorn.Volume) *longhorn.Replica {
+	replica := &longhorn.Replica{
+		Spec: longhorn.ReplicaSpec{
+			InstanceSpec: longhorn.InstanceSpec{
+				VolumeName: volume.Name,
+				VolumeSize: volume.Spec.Size,
+				Image:      volume.Spec.Image,
+				DataEngine: volume.Spec.DataEngine,
+			},
+		},
+	}
+	replica.Name = volume.Name + "-explain"
+	if volume.Status.CurrentImage != "" {
+		replica.Spec.Image = volume.Status.CurrentImage
+	}
+	return replica
+}
+
+// explainNode evaluates the node filters of the ReplicaScheduler. The anti-affinity and topology spread rules depend
+// on the other replicas, so they are evaluated by the caller.
+func (rcs *ReplicaScheduler) explainNode(node *longhorn.Node, replica *longhorn.Replica, volume *longhorn.Volume,
+	linkedClone bool, linkedCloneSrcReplicaNodes map[string]bool, biNodeSelector []string, allowEmptyNodeSelectorVolume bool) *NodeSchedulingExplanation {
+	e := &NodeSchedulingExplanation{
+		NodeName: node.Name,
+		Zone:     node.Status.Zone,
+		Tags:     node.Spec.Tags,
+		Disks:    map[string]*DiskSchedulingExplanation{},
+	}
+
+	e.rejectBy(rcs.getNodeRejection(node, volume.Spec.DataEngine))
+
+	if replica.Spec.HardNodeAffinity != "" && replica.Spec.HardNodeAffinity != node.Name {
+		e.reject(SchedulingRuleHardNodeAffinity, "replica has hard node affinity to node %v", replica.Spec.HardNodeAffinity)
+	}
+
+	if linkedClone && !linkedCloneSrcReplicaNodes[node.Name] {
+		e.reject(SchedulingRuleLinkedClone, "node does not host a healthy replica of the source volume %v",
+			types.GetVolumeName(volume.Spec.DataSource))
+	}
+
+	e.rejectBy(rcs.getNodeCandidateRejection(node, replica))
+	e.rejectBy(getNodeSelectorRejection(node, volume, biNodeSelector, allowEmptyNodeSelectorVolume))
+
+	return e
+}
+
+// explainDisk evaluates the disk filters of the ReplicaScheduler, including the scheduling check of the disk storage.
+func (rcs *ReplicaScheduler) explainDisk(node *longhorn.Node, diskName string, diskSpec longhorn.DiskSpec, diskStatus *longhorn.DiskStatus,
+	replicas map[string]*longhorn.Replica, volume *longhorn.Volume, linkedClone bool, linkedCloneSrcReplicaDisks map[string]bool,
+	biDiskSelector []string, allowEmptyDiskSelectorVolume bool) *DiskSchedulingExplanation {
+	e := &DiskSchedulingExplanation{
+		DiskName:         diskName,
+		DiskUUID:         diskStatus.DiskUUID,
+		Path:             diskSpec.Path,
+		Tags:             diskSpec.Tags,
+		Tier:             diskStatus.Tier,
+		StorageAvailable: diskStatus.StorageAvailable,
+		StorageMaximum:   diskStatus.StorageMaximum,
+		StorageReserved:  diskSpec.StorageReserved,
+		StorageScheduled: diskStatus.StorageScheduled,
+	}
+
+	e.rejectBy(getDiskRejection(diskSpec, diskStatus, volume, linkedClone, linkedCloneSrcReplicaDisks))
+	e.rejectBy(rcs.getDiskReplicaRejection(node, diskName, diskSpec, diskStatus, replicas, volume, true,
+		biDiskSelector, allowEmptyDiskSelectorVolume))
+
+	return e
+}
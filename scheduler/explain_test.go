@@ -0,0 +1,145 @@
+package scheduler
+
+import (
+	"context"
+
+	"k8s.io/client-go/kubernetes/fake"
+	"k8s.io/kubernetes/pkg/controller"
+
+	corev1 "k8s.io/api/core/v1"
+	apiextensionsfake "k8s.io/apiextensions-apiserver/pkg/client/clientset/clientset/fake"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/longhorn/longhorn-manager/util"
+
+	longhorn "github.com/longhorn/longhorn-manager/k8s/pkg/apis/longhorn/v1beta2"
+	lhfake "github.com/longhorn/longhorn-manager/k8s/pkg/client/clientset/versioned/fake"
+
+	. "gopkg.in/check.v1"
+)
+
+func newExplainTestDiskStatus(diskUUID string, storageAvailable int64) *longhorn.DiskStatus {
+	return &longhorn.DiskStatus{
+		StorageAvailable: storageAvailable,
+		StorageMaximum:   TestDiskSize,
+		Conditions: []longhorn.Condition{
+			newCondition(longhorn.DiskConditionTypeSchedulable, longhorn.ConditionStatusTrue),
+		},
+		DiskUUID: diskUUID,
+		Type:     longhorn.DiskTypeFilesystem,
+	}
+}
+
+func (s *TestSuite) TestExplainReplicaScheduling(c *C) {
+	tc := generateSchedulerTestCase()
+	tc.daemons = []*corev1.Pod{
+		newDaemonPod(corev1.PodRunning, TestDaemon1, TestNamespace, TestNode1, TestIP1),
+		newDaemonPod(corev1.PodRunning, TestDaemon2, TestNamespace, TestNode2, TestIP2),
+		newDaemonPod(corev1.PodRunning, TestDaemon3, TestNamespace, TestNode3, TestIP3),
+	}
+
+	// Node1 already hosts a replica of the volume.
+	node1 := newNode(TestNode1, TestNamespace, TestZone1, true, longhorn.ConditionStatusTrue)
+	node1.Spec.Disks = map[string]longhorn.DiskSpec{
+		getDiskID(TestNode1, "1"): newDisk(TestDefaultDataPath, true, 0),
+	}
+	node1.Status.DiskStatus = map[string]*longhorn.DiskStatus{
+		getDiskID(TestNode1, "1"): newExplainTestDiskStatus(getDiskID(TestNode1, "1"), TestDiskAvailableSize),
+	}
+
+	// Node2 is cordoned.
+	node2 := newNode(TestNode2, TestNamespace, TestZone2, true, longhorn.ConditionStatusTrue)
+	node2.Status.Conditions[0].Status = longhorn.ConditionStatusFalse
+	node2.Status.Conditions[0].Reason = longhorn.NodeConditionReasonKubernetesNodeCordoned
+	node2.Spec.Disks = map[string]longhorn.DiskSpec{
+		getDiskID(TestNode2, "1"): newDisk(TestDefaultDataPath, true, 0),
+	}
+	node2.Status.DiskStatus = map[string]*longhorn.DiskStatus{
+		getDiskID(TestNode2, "1"): newExplainTestDiskStatus(getDiskID(TestNode2, "1"), TestDiskAvailableSize),
+	}
+
+	// Node3 has an evicting disk, a full disk and a disk that can host the replica.
+	node3 := newNode(TestNode3, TestNamespace, TestZone2, true, longhorn.ConditionStatusTrue)
+	evictingDisk := newDisk("/mnt/evicting", true, 0)
+	evictingDisk.EvictionRequested = true
+	node3.Spec.Disks = map[string]longhorn.DiskSpec{
+		getDiskID(TestNode3, "1"): evictingDisk,
+		getDiskID(TestNode3, "2"): newDisk("/mnt/full", true, 0),
+		getDiskID(TestNode3, "3"): newDisk(TestDefaultDataPath, true, 0),
+	}
+	node3.Status.DiskStatus = map[string]*longhorn.DiskStatus{
+		getDiskID(TestNode3, "1"): newExplainTestDiskStatus(getDiskID(TestNode3, "1"), TestDiskAvailableSize),
+		getDiskID(TestNode3, "2"): newExplainTestDiskStatus(getDiskID(TestNode3, "2"), 100),
+		getDiskID(TestNode3, "3"): newExplainTestDiskStatus(getDiskID(TestNode3, "3"), TestDiskAvailableSize),
+	}
+
+	tc.nodes = map[string]*longhorn.Node{
+		TestNode1: node1,
+		TestNode2: node2,
+		TestNode3: node3,
+	}
+	for name := range tc.nodes {
+		tc.engineImage.Status.NodeDeploymentMap[name] = true
+	}
+	tc.replicaNodeSoftAntiAffinity = "false"
+	tc.replicaDiskSoftAntiAffinity = "false"
+
+	replicas := map[string]*longhorn.Replica{}
+	for _, r := range tc.allReplicas {
+		r.Spec.NodeID = TestNode1
+		r.Spec.DiskID = getDiskID(TestNode1, "1")
+		r.Spec.HealthyAt = TestTimeNow
+		replicas[r.Name] = r
+		break
+	}
+
+	kubeClient := fake.NewSimpleClientset()                    // nolint: staticcheck
+	lhClient := lhfake.NewSimpleClientset()                    // nolint: staticcheck
+	extensionsClient := apiextensionsfake.NewSimpleClientset() // nolint: staticcheck
+
+	informerFactories := util.NewInformerFactories(TestNamespace, kubeClient, lhClient, controller.NoResyncPeriodFunc())
+
+	nIndexer := informerFactories.LhInformerFactory.Longhorn().V1beta2().Nodes().Informer().GetIndexer()
+	eiIndexer := informerFactories.LhInformerFactory.Longhorn().V1beta2().EngineImages().Informer().GetIndexer()
+	imIndexer := informerFactories.LhInformerFactory.Longhorn().V1beta2().InstanceManagers().Informer().GetIndexer()
+	sIndexer := informerFactories.LhInformerFactory.Longhorn().V1beta2().Settings().Informer().GetIndexer()
+	pIndexer := informerFactories.KubeInformerFactory.Core().V1().Pods().Informer().GetIndexer()
+
+	rcs := newReplicaScheduler(lhClient, kubeClient, extensionsClient, informerFactories)
+	for _, daemon := range tc.daemons {
+		p, err := kubeClient.CoreV1().Pods(TestNamespace).Create(context.TODO(), daemon, metav1.CreateOptions{})
+		c.Assert(err, IsNil)
+		c.Assert(pIndexer.Add(p), IsNil)
+	}
+	for _, node := range tc.nodes {
+		n, err := lhClient.LonghornV1beta2().Nodes(TestNamespace).Create(context.TODO(), node, metav1.CreateOptions{})
+		c.Assert(err, IsNil)
+		c.Assert(nIndexer.Add(n), IsNil)
+
+		im, err := lhClient.LonghornV1beta2().InstanceManagers(TestNamespace).Create(context.TODO(), newInstanceManager(node.Name), metav1.CreateOptions{})
+		c.Assert(err, IsNil)
+		c.Assert(imIndexer.Add(im), IsNil)
+	}
+	ei, err := lhClient.LonghornV1beta2().EngineImages(TestNamespace).Create(context.TODO(), tc.engineImage, metav1.CreateOptions{})
+	c.Assert(err, IsNil)
+	c.Assert(eiIndexer.Add(ei), IsNil)
+	setSettings(tc, lhClient, sIndexer, c)
+
+	explanation, err := rcs.ExplainReplicaScheduling(tc.volume, replicas)
+	c.Assert(err, IsNil)
+
+	c.Assert(explanation.Schedulable, Equals, true)
+	c.Assert(explanation.DiskCandidates, DeepEquals, []string{getDiskID(TestNode3, "3")})
+	c.Assert(explanation.SelectedNode, Equals, TestNode3)
+	c.Assert(explanation.SelectedDisk, Equals, getDiskID(TestNode3, "3"))
+
+	c.Assert(explanation.Nodes, HasLen, 3)
+	c.Assert(explanation.Nodes[TestNode1].Rule, Equals, SchedulingRuleNodeAntiAffinity)
+	c.Assert(explanation.Nodes[TestNode1].Disks[getDiskID(TestNode1, "1")].Rule, Equals, SchedulingRuleDiskAntiAffinity)
+	c.Assert(explanation.Nodes[TestNode2].Rule, Equals, SchedulingRuleNodeCordoned)
+	c.Assert(explanation.Nodes[TestNode2].Disks[getDiskID(TestNode2, "1")].Rejected, Equals, false)
+	c.Assert(explanation.Nodes[TestNode3].Rejected, Equals, false)
+	c.Assert(explanation.Nodes[TestNode3].Disks[getDiskID(TestNode3, "1")].Rule, Equals, SchedulingRuleDiskEvictionRequested)
+	c.Assert(explanation.Nodes[TestNode3].Disks[getDiskID(TestNode3, "2")].Rule, Equals, SchedulingRuleStorageOverProvisioning)
+	c.Assert(explanation.Nodes[TestNode3].Disks[getDiskID(TestNode3, "3")].Rejected, Equals, false)
+}
@@ -86,14 +86,22 @@ func (rcs *ReplicaScheduler) ScheduleReplica(replica *longhorn.Replica, replicas
 		return nil, errs
 	}
 
+	policy := rcs.ds.GetReplicaSchedulingPolicySetting(volume, logrus.WithField("volume", volume.Name))
+
 	// If data locality is set to best-effort, try to schedule at least one replica on the local node.
 	if volume.Spec.DataLocality == longhorn.DataLocalityBestEffort {
-		rcs.scheduleReplicaToDiskOnLocalNode(replica, replicas, volume, diskCandidates)
+		rcs.scheduleReplicaToDiskOnLocalNode(replica, replicas, volume, diskCandidates, policy)
 	}
 
 	// Data locality is not best-effort, or a local replica already exists, or there are no valid disk candidates on the local node.
 	if replica.Spec.NodeID == "" {
-		rcs.scheduleReplicaToDisk(replica, diskCandidates)
+		rcs.scheduleReplicaToDisk(replica, diskCandidates, policy)
+	}
+
+	if replica.Spec.NodeID == "" {
+		errs.Append(longhorn.ErrorReplicaScheduleInsufficientStorage,
+			fmt.Errorf("replica scheduling policy %v rejected all disk candidates of replica %v", policy, replica.Name))
+		return nil, errs
 	}
 
 	return replica, nil
@@ -101,7 +109,7 @@ func (rcs *ReplicaScheduler) ScheduleReplica(replica *longhorn.Replica, replicas
 
 // If no replicas are scheduled on the local node, try to schedule one there.
 // The local node refers to the node where the volume is attached.
-func (rcs *ReplicaScheduler) scheduleReplicaToDiskOnLocalNode(replica *longhorn.Replica, replicas map[string]*longhorn.Replica, volume *longhorn.Volume, diskCandidates map[string]*Disk) {
+func (rcs *ReplicaScheduler) scheduleReplicaToDiskOnLocalNode(replica *longhorn.Replica, replicas map[string]*longhorn.Replica, volume *longhorn.Volume, diskCandidates map[string]*Disk, policy longhorn.ReplicaSchedulingPolicy) {
 	localNodeID := volume.Spec.NodeID
 	if localNodeID == "" {
 		logrus.Warnf("Failed to schedule replica %s on local node because volume %s is not attached", replica.Name, volume.Name)
@@ -121,7 +129,7 @@ func (rcs *ReplicaScheduler) scheduleReplicaToDiskOnLocalNode(replica *longhorn.
 		}
 	}
 	if len(diskCandidatesOnLocalNode) > 0 {
-		rcs.scheduleReplicaToDisk(replica, diskCandidatesOnLocalNode)
+		rcs.scheduleReplicaToDisk(replica, diskCandidatesOnLocalNode, policy)
 	}
 }
 
@@ -171,17 +179,9 @@ func (rcs *ReplicaScheduler) FindDiskCandidates(replica *longhorn.Replica, repli
 			if !exists {
 				continue
 			}
-			if !diskSpec.AllowScheduling || diskSpec.EvictionRequested {
-				continue
-			}
-			if types.GetCondition(diskStatus.Conditions, longhorn.DiskConditionTypeSchedulable).Status != longhorn.ConditionStatusTrue {
+			if rejection := getDiskRejection(diskSpec, diskStatus, volume, linkedClone, linkedCloneSrcReplicaDisks); rejection != nil {
 				continue
 			}
-			if linkedClone {
-				if _, ok := linkedCloneSrcReplicaDisks[diskStatus.DiskUUID]; !ok {
-					continue // only disks that host the source replicas
-				}
-			}
 			disks[diskStatus.DiskUUID] = struct{}{}
 		}
 		nodeDisksMap[node.Name] = disks
@@ -247,36 +247,8 @@ func (rcs *ReplicaScheduler) getNodeCandidates(nodes map[string]*longhorn.Node,
 	// Find nodes that are ready and have a schedulable instance manager.
 	nodeCandidates = map[string]*longhorn.Node{}
 	for _, node := range nodes {
-		log := logrus.WithField("node", node.Name)
-
-		if types.IsDataEngineV2(schedulingReplica.Spec.DataEngine) {
-			disabled, err := rcs.ds.IsV2DataEngineDisabledForNode(node.Name)
-			if err != nil {
-				log.WithError(err).Errorf("Failed to check if v2 data engine is disabled on node %v", node.Name)
-				continue
-			}
-			if disabled {
-				log.Debugf("Excluding node %v from candidates because v2 data engine is disabled on it", node.Name)
-				continue
-			}
-		}
-
-		// After a node reboot, it might be listed in the nodeInfo but its InstanceManager
-		// is not ready. To prevent scheduling replicas on such nodes, verify the
-		// InstanceManager's readiness before including it in the candidate list.
-		if isReady, err := rcs.ds.CheckInstanceManagersReadiness(schedulingReplica.Spec.DataEngine, node.Name); !isReady {
-			if err != nil {
-				log = log.WithError(err)
-			}
-			log.Debugf("Excluding node in node candidates because instance manager on node is not ready")
-			continue
-		}
-
-		if isReady, err := rcs.ds.CheckDataEngineImageReadiness(schedulingReplica.Spec.Image, schedulingReplica.Spec.DataEngine, node.Name); !isReady {
-			if err != nil {
-				log = log.WithError(err)
-			}
-			log.Debugf("Excluding node in node candidates because data engine image on node is not ready")
+		if rejection := rcs.getNodeCandidateRejection(node, schedulingReplica); rejection != nil {
+			logrus.WithField("node", node.Name).Debugf("Excluding node in node candidates because %v", rejection.message)
 			continue
 		}
 
@@ -319,39 +291,11 @@ func (rcs *ReplicaScheduler) getDiskCandidates(nodeInfo map[string]*longhorn.Nod
 		biDiskSelector = bi.Spec.DiskSelector
 	}
 
-	nodeSoftAntiAffinity, err := rcs.ds.GetSettingAsBool(types.SettingNameReplicaSoftAntiAffinity)
-	if err != nil {
-		errs.Append(longhorn.ErrorReplicaScheduleLonghornClientOperationFailed,
-			errors.Wrapf(err, "failed to get %v setting", types.SettingNameReplicaSoftAntiAffinity))
-		return map[string]*Disk{}, errs
-	}
-
-	if volume.Spec.ReplicaSoftAntiAffinity != longhorn.ReplicaSoftAntiAffinityDefault &&
-		volume.Spec.ReplicaSoftAntiAffinity != "" {
-		nodeSoftAntiAffinity = volume.Spec.ReplicaSoftAntiAffinity == longhorn.ReplicaSoftAntiAffinityEnabled
-	}
-
-	zoneSoftAntiAffinity, err := rcs.ds.GetSettingAsBool(types.SettingNameReplicaZoneSoftAntiAffinity)
+	nodeSoftAntiAffinity, zoneSoftAntiAffinity, diskSoftAntiAffinity, err := rcs.getSoftAntiAffinity(volume)
 	if err != nil {
-		errs.Append(longhorn.ErrorReplicaScheduleLonghornClientOperationFailed,
-			errors.Wrapf(err, "failed to get %v setting", types.SettingNameReplicaZoneSoftAntiAffinity))
-		return map[string]*Disk{}, errs
-	}
-	if volume.Spec.ReplicaZoneSoftAntiAffinity != longhorn.ReplicaZoneSoftAntiAffinityDefault &&
-		volume.Spec.ReplicaZoneSoftAntiAffinity != "" {
-		zoneSoftAntiAffinity = volume.Spec.ReplicaZoneSoftAntiAffinity == longhorn.ReplicaZoneSoftAntiAffinityEnabled
-	}
-
-	diskSoftAntiAffinity, err := rcs.ds.GetSettingAsBool(types.SettingNameReplicaDiskSoftAntiAffinity)
-	if err != nil {
-		errs.Append(longhorn.ErrorReplicaScheduleLonghornClientOperationFailed,
-			errors.Wrapf(err, "failed to get %v setting", types.SettingNameReplicaDiskSoftAntiAffinity))
+		errs.Append(longhorn.ErrorReplicaScheduleLonghornClientOperationFailed, err)
 		return map[string]*Disk{}, errs
 	}
-	if volume.Spec.ReplicaDiskSoftAntiAffinity != longhorn.ReplicaDiskSoftAntiAffinityDefault &&
-		volume.Spec.ReplicaDiskSoftAntiAffinity != "" {
-		diskSoftAntiAffinity = volume.Spec.ReplicaDiskSoftAntiAffinity == longhorn.ReplicaDiskSoftAntiAffinityEnabled
-	}
 
 	creatingNewReplicasForReplenishment := false
 	if volume.Status.Robustness == longhorn.VolumeRobustnessDegraded {
@@ -364,11 +308,29 @@ func (rcs *ReplicaScheduler) getDiskCandidates(nodeInfo map[string]*longhorn.Nod
 		creatingNewReplicasForReplenishment = timeToReplacementReplica == 0
 	}
 
+	topologySpread, err := rcs.newReplicaTopologySpread(volume, nodeInfo, replicas, ignoreFailedReplicas, creatingNewReplicasForReplenishment)
+	if err != nil {
+		errs.Append(longhorn.ErrorReplicaScheduleLonghornClientOperationFailed,
+			errors.Wrap(err, "failed to get replica topology spread"))
+		return map[string]*Disk{}, errs
+	}
+	nodeNames := map[string]struct{}{}
+	for nodeName := range nodeInfo {
+		nodeNames[nodeName] = struct{}{}
+	}
+	topologySpreadNodes := topologySpread.filterNodes(nodeNames, longhorn.ReplicaTopologySpreadModeHard)
+
 	getDiskCandidatesFromNodes := func(nodes map[string]*longhorn.Node) (diskCandidates map[string]*Disk, multiError multierr.MultiError) {
 		diskCandidates = map[string]*Disk{}
 		filterErrs := multierr.NewMultiError()
 
 		for _, node := range nodes {
+			if _, ok := topologySpreadNodes[node.Name]; !ok {
+				filterErrs.Append(longhorn.ErrorReplicaScheduleTopologySpreadNotSatisfied,
+					fmt.Errorf("node %v does not satisfy the hard replica topology spread %+v of volume %v",
+						node.Name, volume.Spec.ReplicaTopologySpread, volume.Name))
+				continue
+			}
 			diskCandidatesFromNode, filterNodeDiskErrs := rcs.filterNodeDisksForReplica(node, nodeDisksMap[node.Name], replicas,
 				volume, requireSchedulingCheck, biDiskSelector)
 			for k, v := range diskCandidatesFromNode {
@@ -377,6 +339,8 @@ func (rcs *ReplicaScheduler) getDiskCandidates(nodeInfo map[string]*longhorn.Nod
 			filterErrs.AppendMultiError(filterNodeDiskErrs)
 		}
 		diskCandidates = filterDisksWithMatchingReplicas(diskCandidates, replicas, diskSoftAntiAffinity, ignoreFailedReplicas)
+		diskCandidates = filterDisksWithPreferredTier(diskCandidates, volume.Spec.DiskTiers)
+		diskCandidates = topologySpread.filterDisks(diskCandidates)
 		return diskCandidates, filterErrs
 	}
 
@@ -410,16 +374,9 @@ func (rcs *ReplicaScheduler) getDiskCandidates(nodeInfo map[string]*longhorn.Nod
 
 	for nodeName, node := range nodeInfo {
 		// Filter Nodes. If the Nodes don't match the tags, don't bother marking them as candidates.
-		if !types.IsSelectorsInTags(node.Spec.Tags, volume.Spec.NodeSelector, allowEmptyNodeSelectorVolume) {
+		if rejection := getNodeSelectorRejection(node, volume, biNodeSelector, allowEmptyNodeSelectorVolume); rejection != nil {
 			continue
 		}
-		// If the Nodes don't match the tags of the backing image of this volume,
-		// don't schedule the replica on it because it will hang there
-		if volume.Spec.BackingImage != "" {
-			if !types.IsSelectorsInTags(node.Spec.Tags, biNodeSelector, allowEmptyNodeSelectorVolume) {
-				continue
-			}
-		}
 
 		if _, ok := usedNodes[nodeName]; !ok {
 			unusedNodes[nodeName] = node
@@ -547,79 +504,288 @@ func (rcs *ReplicaScheduler) filterNodeDisksForReplica(node *longhorn.Node, disk
 			continue
 		}
 
-		isV1EngineFilesystemDisk := types.IsDataEngineV1(volume.Spec.DataEngine) && diskSpec.Type == longhorn.DiskTypeFilesystem
-		isV2EngineBlockDisk := types.IsDataEngineV2(volume.Spec.DataEngine) && diskSpec.Type == longhorn.DiskTypeBlock
-		if !isV1EngineFilesystemDisk && !isV2EngineBlockDisk {
-			logrus.Debugf("Volume %v is not compatible with disk %v", volume.Name, diskName)
+		rejection := rcs.getDiskReplicaRejection(node, diskName, diskSpec, diskStatus, replicas, volume,
+			requireSchedulingCheck, biDiskSelector, allowEmptyDiskSelectorVolume)
+		if rejection != nil {
+			if rejection.reason == "" {
+				logrus.Debug(rejection.message)
+				continue
+			}
+			errs.Append(rejection.reason, errors.New(rejection.message))
+			if rejection.rule == SchedulingRuleDiskSchedulingInfoNotFound {
+				return preferredDisks, errs
+			}
 			continue
 		}
 
-		if !datastore.IsSupportedVolumeSize(volume.Spec.DataEngine, diskStatus.FSType, volume.Spec.Size) {
-			logrus.Debugf("Volume %v size %v is not compatible with the file system %v of the disk %v", volume.Name, volume.Spec.Size, diskStatus.Type, diskName)
-			errs.Append(longhorn.ErrorReplicaScheduleIncompatibleVolumeSize,
-				fmt.Errorf("volume %v size %v is not compatible with the file system %v of the disk %v",
-					volume.Name, volume.Spec.Size, diskStatus.Type, diskName))
-			continue
+		suggestDisk := &Disk{
+			DiskSpec:   diskSpec,
+			DiskStatus: diskStatus,
+			NodeID:     node.Name,
 		}
+		preferredDisks[diskUUID] = suggestDisk
+	}
 
-		if requireSchedulingCheck {
-			info, err := rcs.GetDiskSchedulingInfo(diskSpec, diskStatus)
-			if err != nil {
-				errs.Append(longhorn.ErrorReplicaScheduleLonghornClientOperationFailed,
-					errors.Wrapf(err, "failed to get disk scheduling info for disk %v", diskName))
-				return preferredDisks, errs
-			}
+	return preferredDisks, errs
+}
 
-			scheduledReplica := diskStatus.ScheduledReplica
+// schedulingRejection is the rule that rejects a node or a disk for a new replica. The reason is the replica
+// scheduling failure reason reported in the volume condition, and is empty if the rejection is not reported.
+type schedulingRejection struct {
+	rule    SchedulingRule
+	reason  string
+	message string
+}
 
-			// account for replicas already assigned to this disk in the current
-			// scheduling cycle to prevent over-scheduling on the same disk.
-			var storageScheduled int64
-			for rName, r := range replicas {
-				_, ok := scheduledReplica[rName]
-				if !ok && r.Spec.NodeID != "" && r.Spec.NodeID == node.Name && r.Spec.DiskID == diskUUID {
-					storageScheduled += r.Spec.VolumeSize
-				}
-			}
-			if storageScheduled > 0 {
-				info.StorageScheduled += storageScheduled
-			}
-			if isSchedulableToDisk, _ := rcs.IsSchedulableToDisk(volume.Spec.Size, volume.Status.ActualSize, info); !isSchedulableToDisk {
-				errs.Append(longhorn.ErrorReplicaScheduleInsufficientStorage,
-					fmt.Errorf("disk %v on node %v does not have enough storage available for replica %v with size %v",
-						diskName, node.Name, volume.Name, volume.Spec.Size))
-				continue
-			}
+func newSchedulingRejection(rule SchedulingRule, reason, format string, args ...interface{}) *schedulingRejection {
+	return &schedulingRejection{
+		rule:    rule,
+		reason:  reason,
+		message: fmt.Sprintf(format, args...),
+	}
+}
+
+// getNodeRejection returns the rule that excludes the node from the schedulable nodes, or nil if it is schedulable.
+func (rcs *ReplicaScheduler) getNodeRejection(node *longhorn.Node, dataEngine longhorn.DataEngineType) *schedulingRejection {
+	if node.DeletionTimestamp != nil {
+		return newSchedulingRejection(SchedulingRuleNodeDeleting, "", "node is being deleted")
+	}
+
+	readyCondition := types.GetCondition(node.Status.Conditions, longhorn.NodeConditionTypeReady)
+	if readyCondition.Status != longhorn.ConditionStatusTrue {
+		return newSchedulingRejection(SchedulingRuleNodeNotReady, "", "node is not ready: %v", readyCondition.Message)
+	}
+
+	schedulableCondition := types.GetCondition(node.Status.Conditions, longhorn.NodeConditionTypeSchedulable)
+	if schedulableCondition.Status != longhorn.ConditionStatusTrue {
+		if schedulableCondition.Reason == longhorn.NodeConditionReasonKubernetesNodeCordoned {
+			return newSchedulingRejection(SchedulingRuleNodeCordoned, "", "kubernetes node is cordoned")
 		}
+		return newSchedulingRejection(SchedulingRuleNodeUnschedulable, "", "node is unschedulable: %v", schedulableCondition.Message)
+	}
 
-		// Check if the Disk's Tags are valid.
-		if !types.IsSelectorsInTags(diskSpec.Tags, volume.Spec.DiskSelector, allowEmptyDiskSelectorVolume) {
-			errs.Append(longhorn.ErrorReplicaScheduleTagsNotFulfilled,
-				fmt.Errorf("disk %v on node %v does not match the disk selector %v for volume %v",
-					diskName, node.Name, volume.Spec.DiskSelector, volume.Name))
-			continue
+	if !node.Spec.AllowScheduling {
+		return newSchedulingRejection(SchedulingRuleNodeSchedulingDisabled, "", "replica scheduling is disabled on node")
+	}
+
+	// Exclude nodes where the data engine is disabled.
+	if types.IsDataEngineV2(dataEngine) {
+		kubeNode, err := rcs.ds.GetKubernetesNodeRO(node.Name)
+		if err != nil {
+			return newSchedulingRejection(SchedulingRuleDataEngineDisabled, "", "failed to get corresponding kubernetes node: %v", err)
 		}
+		if val, ok := kubeNode.Labels[types.NodeDisableV2DataEngineLabelKey]; ok && val == types.NodeDisableV2DataEngineLabelKeyTrue {
+			return newSchedulingRejection(SchedulingRuleDataEngineDisabled, "", "v2 data engine is disabled on node")
+		}
+	}
 
-		if volume.Spec.BackingImage != "" {
-			// If the disks don't match the tags of the backing image of this volume,
-			// don't schedule the replica on it because it will hang there
-			if !types.IsSelectorsInTags(diskSpec.Tags, biDiskSelector, allowEmptyDiskSelectorVolume) {
-				errs.Append(longhorn.ErrorReplicaScheduleTagsNotFulfilled,
-					fmt.Errorf("disk %v on node %v does not match the disk selector %v for backing image %v of volume %v",
-						diskName, node.Name, biDiskSelector, volume.Spec.BackingImage, volume.Name))
-				continue
-			}
+	return nil
+}
+
+// getNodeCandidateRejection returns the rule that excludes the schedulable node from the node candidates of the
+// replica, or nil if the node is a candidate.
+func (rcs *ReplicaScheduler) getNodeCandidateRejection(node *longhorn.Node, replica *longhorn.Replica) *schedulingRejection {
+	if types.IsDataEngineV2(replica.Spec.DataEngine) {
+		disabled, err := rcs.ds.IsV2DataEngineDisabledForNode(node.Name)
+		if err != nil {
+			return newSchedulingRejection(SchedulingRuleDataEngineDisabled, "", "failed to check if v2 data engine is disabled on node: %v", err)
 		}
+		if disabled {
+			return newSchedulingRejection(SchedulingRuleDataEngineDisabled, "", "v2 data engine is disabled on node")
+		}
+	}
 
-		suggestDisk := &Disk{
-			DiskSpec:   diskSpec,
-			DiskStatus: diskStatus,
-			NodeID:     node.Name,
+	// After a node reboot, it might be listed in the nodeInfo but its InstanceManager
+	// is not ready. To prevent scheduling replicas on such nodes, verify the
+	// InstanceManager's readiness before including it in the candidate list.
+	if isReady, err := rcs.ds.CheckInstanceManagersReadiness(replica.Spec.DataEngine, node.Name); !isReady {
+		if err != nil {
+			return newSchedulingRejection(SchedulingRuleInstanceManagerNotReady, "", "instance manager on node is not ready: %v", err)
 		}
-		preferredDisks[diskUUID] = suggestDisk
+		return newSchedulingRejection(SchedulingRuleInstanceManagerNotReady, "", "instance manager on node is not ready")
 	}
 
-	return preferredDisks, errs
+	if isReady, err := rcs.ds.CheckDataEngineImageReadiness(replica.Spec.Image, replica.Spec.DataEngine, node.Name); !isReady {
+		if err != nil {
+			return newSchedulingRejection(SchedulingRuleDataEngineImageNotReady, "", "data engine image %v on node is not ready: %v", replica.Spec.Image, err)
+		}
+		return newSchedulingRejection(SchedulingRuleDataEngineImageNotReady, "", "data engine image %v on node is not ready", replica.Spec.Image)
+	}
+
+	return nil
+}
+
+// getNodeSelectorRejection returns the rule that rejects the node because its tags do not match the node selector of
+// the volume or of its backing image, or nil if they match.
+func getNodeSelectorRejection(node *longhorn.Node, volume *longhorn.Volume, biNodeSelector []string, allowEmptyNodeSelectorVolume bool) *schedulingRejection {
+	if !types.IsSelectorsInTags(node.Spec.Tags, volume.Spec.NodeSelector, allowEmptyNodeSelectorVolume) {
+		return newSchedulingRejection(SchedulingRuleNodeTags, "", "node tags %v do not match the node selector %v", node.Spec.Tags, volume.Spec.NodeSelector)
+	}
+
+	// If the Nodes don't match the tags of the backing image of this volume,
+	// don't schedule the replica on it because it will hang there
+	if volume.Spec.BackingImage != "" && !types.IsSelectorsInTags(node.Spec.Tags, biNodeSelector, allowEmptyNodeSelectorVolume) {
+		return newSchedulingRejection(SchedulingRuleBackingImageNodeTags, "", "node tags %v do not match the node selector %v of backing image %v",
+			node.Spec.Tags, biNodeSelector, volume.Spec.BackingImage)
+	}
+
+	return nil
+}
+
+// getDiskRejection returns the rule that excludes the disk from the disks of a node candidate, or nil if the disk is
+// available for scheduling.
+func getDiskRejection(diskSpec longhorn.DiskSpec, diskStatus *longhorn.DiskStatus, volume *longhorn.Volume,
+	linkedClone bool, linkedCloneSrcReplicaDisks map[string]bool) *schedulingRejection {
+	if !diskSpec.AllowScheduling {
+		return newSchedulingRejection(SchedulingRuleDiskSchedulingDisabled, "", "replica scheduling is disabled on disk")
+	}
+
+	if diskSpec.EvictionRequested {
+		return newSchedulingRejection(SchedulingRuleDiskEvictionRequested, "", "eviction is requested on disk")
+	}
+
+	schedulableCondition := types.GetCondition(diskStatus.Conditions, longhorn.DiskConditionTypeSchedulable)
+	if schedulableCondition.Status != longhorn.ConditionStatusTrue {
+		if schedulableCondition.Reason == string(longhorn.DiskConditionReasonDiskPressure) {
+			return newSchedulingRejection(SchedulingRuleDiskPressure, "", "disk is under pressure: %v", schedulableCondition.Message)
+		}
+		return newSchedulingRejection(SchedulingRuleDiskUnschedulable, "", "disk is unschedulable: %v", schedulableCondition.Message)
+	}
+
+	// only disks that host the source replicas
+	if linkedClone && !linkedCloneSrcReplicaDisks[diskStatus.DiskUUID] {
+		return newSchedulingRejection(SchedulingRuleLinkedClone, "", "disk does not host a healthy replica of the source volume %v",
+			types.GetVolumeName(volume.Spec.DataSource))
+	}
+
+	return nil
+}
+
+// getDiskReplicaRejection returns the rule that rejects the disk for a replica of the volume, or nil if the replica
+// fits on the disk.
+func (rcs *ReplicaScheduler) getDiskReplicaRejection(node *longhorn.Node, diskName string, diskSpec longhorn.DiskSpec, diskStatus *longhorn.DiskStatus,
+	replicas map[string]*longhorn.Replica, volume *longhorn.Volume, requireSchedulingCheck bool, biDiskSelector []string,
+	allowEmptyDiskSelectorVolume bool) *schedulingRejection {
+	isV1EngineFilesystemDisk := types.IsDataEngineV1(volume.Spec.DataEngine) && diskSpec.Type == longhorn.DiskTypeFilesystem
+	isV2EngineBlockDisk := types.IsDataEngineV2(volume.Spec.DataEngine) && diskSpec.Type == longhorn.DiskTypeBlock
+	if !isV1EngineFilesystemDisk && !isV2EngineBlockDisk {
+		return newSchedulingRejection(SchedulingRuleDiskTypeIncompatible, "",
+			"volume %v is not compatible with disk %v of type %v", volume.Name, diskName, diskSpec.Type)
+	}
+
+	if !datastore.IsSupportedVolumeSize(volume.Spec.DataEngine, diskStatus.FSType, volume.Spec.Size) {
+		return newSchedulingRejection(SchedulingRuleVolumeSizeIncompatible, longhorn.ErrorReplicaScheduleIncompatibleVolumeSize,
+			"volume %v size %v is not compatible with the file system %v of the disk %v",
+			volume.Name, volume.Spec.Size, diskStatus.Type, diskName)
+	}
+
+	if requireSchedulingCheck {
+		info, err := rcs.GetDiskSchedulingInfo(diskSpec, diskStatus)
+		if err != nil {
+			return newSchedulingRejection(SchedulingRuleDiskSchedulingInfoNotFound, longhorn.ErrorReplicaScheduleLonghornClientOperationFailed,
+				"failed to get disk scheduling info for disk %v: %v", diskName, err)
+		}
+
+		// account for replicas already assigned to this disk in the current
+		// scheduling cycle to prevent over-scheduling on the same disk.
+		info.StorageScheduled += getStorageScheduledInCycle(node.Name, diskStatus, replicas)
+		if isSchedulableToDisk, message := rcs.IsSchedulableToDisk(volume.Spec.Size, volume.Status.ActualSize, info); !isSchedulableToDisk {
+			return newSchedulingRejection(SchedulingRuleStorageOverProvisioning, longhorn.ErrorReplicaScheduleInsufficientStorage,
+				"disk %v on node %v does not have enough storage available for replica %v with size %v: %v",
+				diskName, node.Name, volume.Name, volume.Spec.Size, message)
+		}
+	}
+
+	// Check if the Disk's Tags are valid.
+	if !types.IsSelectorsInTags(diskSpec.Tags, volume.Spec.DiskSelector, allowEmptyDiskSelectorVolume) {
+		return newSchedulingRejection(SchedulingRuleDiskTags, longhorn.ErrorReplicaScheduleTagsNotFulfilled,
+			"disk %v on node %v does not match the disk selector %v for volume %v",
+			diskName, node.Name, volume.Spec.DiskSelector, volume.Name)
+	}
+
+	// Check if the Disk's tier is requested by the volume.
+	if !types.IsDiskTierInTiers(diskStatus.Tier, volume.Spec.DiskTiers) {
+		return newSchedulingRejection(SchedulingRuleDiskTier, longhorn.ErrorReplicaScheduleDiskTierNotFulfilled,
+			"disk %v on node %v with tier %q does not match the disk tiers %v for volume %v",
+			diskName, node.Name, diskStatus.Tier, volume.Spec.DiskTiers, volume.Name)
+	}
+
+	// If the disks don't match the tags of the backing image of this volume,
+	// don't schedule the replica on it because it will hang there
+	if volume.Spec.BackingImage != "" && !types.IsSelectorsInTags(diskSpec.Tags, biDiskSelector, allowEmptyDiskSelectorVolume) {
+		return newSchedulingRejection(SchedulingRuleBackingImageDiskTags, longhorn.ErrorReplicaScheduleTagsNotFulfilled,
+			"disk %v on node %v does not match the disk selector %v for backing image %v of volume %v",
+			diskName, node.Name, biDiskSelector, volume.Spec.BackingImage, volume.Name)
+	}
+
+	return nil
+}
+
+// getStorageScheduledInCycle returns the size of the replicas assigned to the disk that are not yet recorded in the
+// scheduled replicas of the disk status.
+func getStorageScheduledInCycle(nodeName string, diskStatus *longhorn.DiskStatus, replicas map[string]*longhorn.Replica) int64 {
+	var storageScheduled int64
+	for rName, r := range replicas {
+		_, ok := diskStatus.ScheduledReplica[rName]
+		if !ok && r.Spec.NodeID != "" && r.Spec.NodeID == nodeName && r.Spec.DiskID == diskStatus.DiskUUID {
+			storageScheduled += r.Spec.VolumeSize
+		}
+	}
+	return storageScheduled
+}
+
+// getSoftAntiAffinity returns the node, zone and disk soft anti-affinity of the volume. The volume values take
+// precedence over the global settings unless they are set to ignored.
+func (rcs *ReplicaScheduler) getSoftAntiAffinity(volume *longhorn.Volume) (nodeSoftAntiAffinity, zoneSoftAntiAffinity, diskSoftAntiAffinity bool, err error) {
+	nodeSoftAntiAffinity, err = rcs.ds.GetSettingAsBool(types.SettingNameReplicaSoftAntiAffinity)
+	if err != nil {
+		return false, false, false, errors.Wrapf(err, "failed to get %v setting", types.SettingNameReplicaSoftAntiAffinity)
+	}
+	if volume.Spec.ReplicaSoftAntiAffinity != longhorn.ReplicaSoftAntiAffinityDefault &&
+		volume.Spec.ReplicaSoftAntiAffinity != "" {
+		nodeSoftAntiAffinity = volume.Spec.ReplicaSoftAntiAffinity == longhorn.ReplicaSoftAntiAffinityEnabled
+	}
+
+	zoneSoftAntiAffinity, err = rcs.ds.GetSettingAsBool(types.SettingNameReplicaZoneSoftAntiAffinity)
+	if err != nil {
+		return false, false, false, errors.Wrapf(err, "failed to get %v setting", types.SettingNameReplicaZoneSoftAntiAffinity)
+	}
+	if volume.Spec.ReplicaZoneSoftAntiAffinity != longhorn.ReplicaZoneSoftAntiAffinityDefault &&
+		volume.Spec.ReplicaZoneSoftAntiAffinity != "" {
+		zoneSoftAntiAffinity = volume.Spec.ReplicaZoneSoftAntiAffinity == longhorn.ReplicaZoneSoftAntiAffinityEnabled
+	}
+
+	diskSoftAntiAffinity, err = rcs.ds.GetSettingAsBool(types.SettingNameReplicaDiskSoftAntiAffinity)
+	if err != nil {
+		return false, false, false, errors.Wrapf(err, "failed to get %v setting", types.SettingNameReplicaDiskSoftAntiAffinity)
+	}
+	if volume.Spec.ReplicaDiskSoftAntiAffinity != longhorn.ReplicaDiskSoftAntiAffinityDefault &&
+		volume.Spec.ReplicaDiskSoftAntiAffinity != "" {
+		diskSoftAntiAffinity = volume.Spec.ReplicaDiskSoftAntiAffinity == longhorn.ReplicaDiskSoftAntiAffinityEnabled
+	}
+
+	return nodeSoftAntiAffinity, zoneSoftAntiAffinity, diskSoftAntiAffinity, nil
+}
+
+// filterDisksWithPreferredTier returns the disks of the first tier in the preference order that has disks.
+// It returns the input disks map if no tier is requested.
+func filterDisksWithPreferredTier(disks map[string]*Disk, tiers []longhorn.DiskTier) map[string]*Disk {
+	if len(tiers) == 0 {
+		return disks
+	}
+
+	for _, tier := range tiers {
+		tierDisks := map[string]*Disk{}
+		for diskUUID, disk := range disks {
+			if disk.Tier == tier {
+				tierDisks[diskUUID] = disk
+			}
+		}
+		if len(tierDisks) > 0 {
+			return tierDisks
+		}
+	}
+	return map[string]*Disk{}
 }
 
 // filterDiskWithMatchingReplicas returns disk that have no matching replicas when diskSoftAntiAffinity is false.
@@ -664,33 +830,12 @@ func (rcs *ReplicaScheduler) ListSchedulableNodes(dataEngine longhorn.DataEngine
 	scheduledNode := map[string]*longhorn.Node{}
 
 	for _, node := range nodeInfo {
-		if node == nil || node.DeletionTimestamp != nil {
-			continue
-		}
-
-		nodeReadyCondition := types.GetCondition(node.Status.Conditions, longhorn.NodeConditionTypeReady)
-		nodeSchedulableCondition := types.GetCondition(node.Status.Conditions, longhorn.NodeConditionTypeSchedulable)
-
-		if nodeReadyCondition.Status != longhorn.ConditionStatusTrue {
+		if node == nil {
 			continue
 		}
-		if nodeSchedulableCondition.Status != longhorn.ConditionStatusTrue {
+		if rejection := rcs.getNodeRejection(node, dataEngine); rejection != nil {
 			continue
 		}
-		if !node.Spec.AllowScheduling {
-			continue
-		}
-		// Exclude nodes where the data engine is disabled.
-		if types.IsDataEngineV2(dataEngine) {
-			kubeNode, err := rcs.ds.GetKubernetesNodeRO(node.Name)
-			if err != nil {
-				logrus.WithField("node", node.Name).WithError(err).Warn("Skipping node because failed to get corresponding kubernetes node")
-				continue
-			}
-			if val, ok := kubeNode.Labels[types.NodeDisableV2DataEngineLabelKey]; ok && val == types.NodeDisableV2DataEngineLabelKeyTrue {
-				continue
-			}
-		}
 
 		scheduledNode[node.Name] = node
 	}
@@ -698,8 +843,12 @@ func (rcs *ReplicaScheduler) ListSchedulableNodes(dataEngine longhorn.DataEngine
 	return scheduledNode, nil
 }
 
-func (rcs *ReplicaScheduler) scheduleReplicaToDisk(replica *longhorn.Replica, diskCandidates map[string]*Disk) {
-	disk := rcs.getDiskWithMostBalanceScore(diskCandidates, replica.Spec.VolumeSize)
+func (rcs *ReplicaScheduler) scheduleReplicaToDisk(replica *longhorn.Replica, diskCandidates map[string]*Disk, policy longhorn.ReplicaSchedulingPolicy) {
+	disk := rcs.selectDisk(diskCandidates, replica.Spec.VolumeSize, policy)
+	if disk == nil {
+		logrus.Warnf("Failed to schedule replica %v: replica scheduling policy %v rejected all disk candidates", replica.Name, policy)
+		return
+	}
 
 	replica.Spec.NodeID = disk.NodeID
 	replica.Spec.DiskID = disk.DiskUUID
@@ -708,12 +857,34 @@ func (rcs *ReplicaScheduler) scheduleReplicaToDisk(replica *longhorn.Replica, di
 
 	logrus.WithFields(logrus.Fields{
 		"replica":           replica.Name,
+		"schedulingPolicy":  policy,
 		"disk":              replica.Spec.DiskID,
 		"diskPath":          replica.Spec.DiskPath,
 		"dataDirectoryName": replica.Spec.DataDirectoryName,
 	}).Infof("Schedule replica to node %v", replica.Spec.NodeID)
 }
 
+// selectDisk picks a disk among the disk candidates according to the replica scheduling policy.
+// It returns nil if the policy rejects every disk candidate.
+func (rcs *ReplicaScheduler) selectDisk(diskCandidates map[string]*Disk, replicaSize int64, policy longhorn.ReplicaSchedulingPolicy) *Disk {
+	p := GetSchedulingPolicy(policy)
+	if p == nil {
+		return rcs.getDiskWithMostBalanceScore(diskCandidates, replicaSize)
+	}
+
+	overProvisioningPercentage, err := rcs.ds.GetSettingAsInt(types.SettingNameStorageOverProvisioningPercentage)
+	if err != nil {
+		logrus.WithError(err).Warnf("Failed to get setting %v for replica scheduling policy %v", types.SettingNameStorageOverProvisioningPercentage, policy)
+		return nil
+	}
+	minimalAvailablePercentage, err := rcs.ds.GetSettingAsInt(types.SettingNameStorageMinimalAvailablePercentage)
+	if err != nil {
+		logrus.WithError(err).Warnf("Failed to get setting %v for replica scheduling policy %v", types.SettingNameStorageMinimalAvailablePercentage, policy)
+		return nil
+	}
+	return p.SelectDisk(diskCandidates, replicaSize, overProvisioningPercentage, minimalAvailablePercentage)
+}
+
 // getDiskWithMostBalanceScore selects a disk for a replica by minimizing imbalance.
 func (rcs *ReplicaScheduler) getDiskWithMostBalanceScore(candidateDisks map[string]*Disk, replicaSize int64) *Disk {
 	// It works in two stages:
@@ -1155,6 +1326,9 @@ func (rcs *ReplicaScheduler) isFailedReplicaReusable(r *longhorn.Replica, v *lon
 			if !types.IsSelectorsInTags(diskSpec.Tags, v.Spec.DiskSelector, allowEmptyDiskSelectorVolume) {
 				return false, nil
 			}
+			if !types.IsDiskTierInTiers(diskStatus.Tier, v.Spec.DiskTiers) {
+				return false, nil
+			}
 		}
 	}
 	if !diskFound {
@@ -1212,6 +1386,11 @@ func GetLatestFailedReplica(rs ...*longhorn.Replica) (res *longhorn.Replica) {
 }
 
 func (rcs *ReplicaScheduler) IsSchedulableToDisk(size int64, requiredStorage int64, info *DiskSchedulingInfo) (isSchedulable bool, message string) {
+	return isSchedulableToDisk(size, requiredStorage, info)
+}
+
+// isSchedulableToDisk checks the actual space usage and the over-provisioning of the disk after placing a replica on it
+func isSchedulableToDisk(size int64, requiredStorage int64, info *DiskSchedulingInfo) (isSchedulable bool, message string) {
 	// StorageReserved = the space is already used by 3rd party + the space will be used by 3rd party.
 	// StorageAvailable = the space can be used by 3rd party or Longhorn system.
 	// There is no (direct) relationship between StorageReserved and StorageAvailable.
@@ -1472,20 +1651,9 @@ func getCurrentNodesAndZones(replicas map[string]*longhorn.Replica, nodeInfo map
 		if r.Spec.NodeID == "" {
 			continue
 		}
-		if r.DeletionTimestamp != nil {
+		if !isReplicaCountedForScheduling(r, ignoreFailedReplicas, creatingNewReplicasForReplenishment) {
 			continue
 		}
-		if r.Spec.FailedAt != "" {
-			if ignoreFailedReplicas {
-				continue
-			}
-			if !IsPotentiallyReusableReplica(r) {
-				continue // This replica can never be used again, so it does not count in scheduling decisions.
-			}
-			if creatingNewReplicasForReplenishment {
-				continue // Maybe this replica can be used again, but it is being actively replaced anyway.
-			}
-		}
 
 		if node, ok := nodeInfo[r.Spec.NodeID]; ok {
 			if r.Spec.EvictionRequested {
@@ -1514,6 +1682,26 @@ func getCurrentNodesAndZones(replicas map[string]*longhorn.Replica, nodeInfo map
 	return usedNodes, usedZones, onlyEvictingNodes, onlyEvictingZones
 }
 
+// isReplicaCountedForScheduling returns false if the replica does not occupy its node, zone and topology domains in
+// the scheduling decisions. See getCurrentNodesAndZones.
+func isReplicaCountedForScheduling(r *longhorn.Replica, ignoreFailedReplicas, creatingNewReplicasForReplenishment bool) bool {
+	if r.DeletionTimestamp != nil {
+		return false
+	}
+	if r.Spec.FailedAt != "" {
+		if ignoreFailedReplicas {
+			return false
+		}
+		if !IsPotentiallyReusableReplica(r) {
+			return false // This replica can never be used again, so it does not count in scheduling decisions.
+		}
+		if creatingNewReplicasForReplenishment {
+			return false // Maybe this replica can be used again, but it is being actively replaced anyway.
+		}
+	}
+	return true
+}
+
 // timeToReplacementReplica returns the amount of time until Longhorn should create a new replica for a degraded volume,
 // even if there are potentially reusable failed replicas. It returns 0 if replica-replenishment-wait-interval has
 // elapsed and a new replica is needed right now.
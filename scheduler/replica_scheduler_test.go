@@ -1597,6 +1597,161 @@ func (s *TestSuite) TestFilterDisksWithMatchingReplicas(c *C) {
 	}
 }
 
+func (s *TestSuite) TestFilterDisksWithPreferredTier(c *C) {
+	type testCase struct {
+		inputDiskTiers map[string]longhorn.DiskTier
+		volumeTiers    []longhorn.DiskTier
+
+		expectDiskUUIDs []string
+	}
+	tests := map[string]testCase{}
+
+	diskUUID1 := getDiskID(TestNode1, "1")
+	diskUUID2 := getDiskID(TestNode1, "2")
+	diskUUID3 := getDiskID(TestNode2, "3")
+	diskUUID4 := getDiskID(TestNode2, "4")
+	diskTiers := map[string]longhorn.DiskTier{
+		diskUUID1: longhorn.DiskTierNvme,
+		diskUUID2: longhorn.DiskTierSSD,
+		diskUUID3: longhorn.DiskTierSSD,
+		diskUUID4: longhorn.DiskTierUnknown,
+	}
+
+	tests["no tier requested"] = testCase{
+		inputDiskTiers:  diskTiers,
+		expectDiskUUIDs: []string{diskUUID1, diskUUID2, diskUUID3, diskUUID4},
+	}
+	tests["only schedule to the most preferred tier"] = testCase{
+		inputDiskTiers:  diskTiers,
+		volumeTiers:     []longhorn.DiskTier{longhorn.DiskTierNvme, longhorn.DiskTierSSD},
+		expectDiskUUIDs: []string{diskUUID1},
+	}
+	tests["fall back to the next tier"] = testCase{
+		inputDiskTiers: map[string]longhorn.DiskTier{
+			diskUUID2: longhorn.DiskTierSSD,
+			diskUUID3: longhorn.DiskTierSSD,
+			diskUUID4: longhorn.DiskTierUnknown,
+		},
+		volumeTiers:     []longhorn.DiskTier{longhorn.DiskTierNvme, longhorn.DiskTierSSD},
+		expectDiskUUIDs: []string{diskUUID2, diskUUID3},
+	}
+	tests["no disk of the requested tiers"] = testCase{
+		inputDiskTiers:  diskTiers,
+		volumeTiers:     []longhorn.DiskTier{longhorn.DiskTierHDD},
+		expectDiskUUIDs: []string{},
+	}
+
+	for name, tc := range tests {
+		fmt.Printf("testing %v\n", name)
+		inputDisks := map[string]*Disk{}
+		for UUID, tier := range tc.inputDiskTiers {
+			inputDisks[UUID] = &Disk{DiskStatus: &longhorn.DiskStatus{Tier: tier}}
+		}
+		outputDisks := filterDisksWithPreferredTier(inputDisks, tc.volumeTiers)
+		c.Assert(len(outputDisks), Equals, len(tc.expectDiskUUIDs))
+		for _, UUID := range tc.expectDiskUUIDs {
+			_, ok := outputDisks[UUID]
+			c.Assert(ok, Equals, true)
+		}
+	}
+}
+
+func (s *TestSuite) TestReplicaTopologySpreadFilterNodes(c *C) {
+	type testCase struct {
+		constraints       []longhorn.ReplicaTopologySpreadConstraint
+		nodeReplicaCounts map[string]int
+		mode              longhorn.ReplicaTopologySpreadMode
+
+		expectNodes []string
+	}
+	tests := map[string]testCase{}
+
+	// node-1 and node-2 are in rack-a, node-3 is in rack-b, node-4 is in rack-c and node-5 is not labelled.
+	nodeLabels := map[string]map[string]string{
+		"node-1": {"rack": "rack-a", "power-domain": "pd-1"},
+		"node-2": {"rack": "rack-a", "power-domain": "pd-2"},
+		"node-3": {"rack": "rack-b", "power-domain": "pd-1"},
+		"node-4": {"rack": "rack-c", "power-domain": "pd-2"},
+		"node-5": {},
+	}
+
+	tests["no replica"] = testCase{
+		constraints: []longhorn.ReplicaTopologySpreadConstraint{
+			{TopologyKey: "rack", Mode: longhorn.ReplicaTopologySpreadModeHard, MaxSkew: 1},
+		},
+		mode:        longhorn.ReplicaTopologySpreadModeHard,
+		expectNodes: []string{"node-1", "node-2", "node-3", "node-4"},
+	}
+	tests["hard skips the used rack"] = testCase{
+		constraints: []longhorn.ReplicaTopologySpreadConstraint{
+			{TopologyKey: "rack", Mode: longhorn.ReplicaTopologySpreadModeHard, MaxSkew: 1},
+		},
+		nodeReplicaCounts: map[string]int{"node-1": 1},
+		mode:              longhorn.ReplicaTopologySpreadModeHard,
+		expectNodes:       []string{"node-3", "node-4"},
+	}
+	tests["max skew allows the used rack"] = testCase{
+		constraints: []longhorn.ReplicaTopologySpreadConstraint{
+			{TopologyKey: "rack", Mode: longhorn.ReplicaTopologySpreadModeHard, MaxSkew: 2},
+		},
+		nodeReplicaCounts: map[string]int{"node-1": 1},
+		mode:              longhorn.ReplicaTopologySpreadModeHard,
+		expectNodes:       []string{"node-1", "node-2", "node-3", "node-4"},
+	}
+	tests["ordered keys"] = testCase{
+		constraints: []longhorn.ReplicaTopologySpreadConstraint{
+			{TopologyKey: "rack", Mode: longhorn.ReplicaTopologySpreadModeHard, MaxSkew: 1},
+			{TopologyKey: "power-domain", Mode: longhorn.ReplicaTopologySpreadModeHard, MaxSkew: 1},
+		},
+		nodeReplicaCounts: map[string]int{"node-1": 1},
+		mode:              longhorn.ReplicaTopologySpreadModeHard,
+		expectNodes:       []string{"node-4"},
+	}
+	tests["hard skips the crowded rack"] = testCase{
+		constraints: []longhorn.ReplicaTopologySpreadConstraint{
+			{TopologyKey: "rack", Mode: longhorn.ReplicaTopologySpreadModeHard, MaxSkew: 1},
+		},
+		nodeReplicaCounts: map[string]int{"node-1": 1, "node-3": 1, "node-4": 1, "node-2": 1},
+		mode:              longhorn.ReplicaTopologySpreadModeHard,
+		expectNodes:       []string{"node-3", "node-4"},
+	}
+	tests["soft falls back if not satisfied"] = testCase{
+		constraints: []longhorn.ReplicaTopologySpreadConstraint{
+			{TopologyKey: "zone-rack", Mode: longhorn.ReplicaTopologySpreadModeSoft, MaxSkew: 1},
+		},
+		nodeReplicaCounts: map[string]int{"node-1": 1},
+		mode:              longhorn.ReplicaTopologySpreadModeSoft,
+		expectNodes:       []string{"node-1", "node-2", "node-3", "node-4", "node-5"},
+	}
+	tests["mode not requested"] = testCase{
+		constraints: []longhorn.ReplicaTopologySpreadConstraint{
+			{TopologyKey: "rack", Mode: longhorn.ReplicaTopologySpreadModeSoft, MaxSkew: 1},
+		},
+		nodeReplicaCounts: map[string]int{"node-1": 1},
+		mode:              longhorn.ReplicaTopologySpreadModeHard,
+		expectNodes:       []string{"node-1", "node-2", "node-3", "node-4", "node-5"},
+	}
+
+	for name, tc := range tests {
+		fmt.Printf("testing %v\n", name)
+		ts := &replicaTopologySpread{
+			constraints:       tc.constraints,
+			nodeLabels:        nodeLabels,
+			nodeReplicaCounts: tc.nodeReplicaCounts,
+		}
+		nodeNames := map[string]struct{}{}
+		for nodeName := range nodeLabels {
+			nodeNames[nodeName] = struct{}{}
+		}
+		outputNodes := ts.filterNodes(nodeNames, tc.mode)
+		c.Assert(len(outputNodes), Equals, len(tc.expectNodes), Commentf("test case %v", name))
+		for _, nodeName := range tc.expectNodes {
+			_, ok := outputNodes[nodeName]
+			c.Assert(ok, Equals, true, Commentf("test case %v", name))
+		}
+	}
+}
+
 // TestGetCurrentNodesAndZones can easily be extended with additional test cases. However, it was originally written to
 // verify the behavior of getCurrentNodesAndZones when replicas with different values of
 // replica.Status.EvictionRequested were considered in different orders.
@@ -1826,28 +1981,28 @@ func (s *TestSuite) TestScheduleReplicaToDiskOnLocalNode(c *C) {
 	diskCandidates["disk3"] = &Disk{NodeID: TestNode3, DiskSpec: longhorn.DiskSpec{}, DiskStatus: &longhorn.DiskStatus{}}
 
 	// Case 1: Volume not attached, skip scheduling
-	rs.scheduleReplicaToDiskOnLocalNode(replica1, replicas, volume, diskCandidates)
+	rs.scheduleReplicaToDiskOnLocalNode(replica1, replicas, volume, diskCandidates, longhorn.ReplicaSchedulingPolicyBalanced)
 	c.Assert(replica1.Spec.NodeID, Equals, "")
 
 	// Case 2: Volume attached but no disks available on local node
 	volume.Spec.NodeID = TestNode1
-	rs.scheduleReplicaToDiskOnLocalNode(replica1, replicas, volume, diskCandidates)
+	rs.scheduleReplicaToDiskOnLocalNode(replica1, replicas, volume, diskCandidates, longhorn.ReplicaSchedulingPolicyBalanced)
 	c.Assert(replica1.Spec.NodeID, Equals, "")
 
 	// Case 3: Schedule to available local disk
 	diskCandidates["disk1"] = &Disk{NodeID: TestNode1, DiskSpec: longhorn.DiskSpec{}, DiskStatus: &longhorn.DiskStatus{StorageAvailable: TestVolumeSize}}
-	rs.scheduleReplicaToDiskOnLocalNode(replica1, replicas, volume, diskCandidates)
+	rs.scheduleReplicaToDiskOnLocalNode(replica1, replicas, volume, diskCandidates, longhorn.ReplicaSchedulingPolicyBalanced)
 	c.Assert(replica1.Spec.NodeID, Equals, TestNode1)
 
 	// Case 4: Another replica (replica2) should not be scheduled to the local node
 	// because there is already a healthy replica (replica1) on that node.
-	rs.scheduleReplicaToDiskOnLocalNode(replica2, replicas, volume, diskCandidates)
+	rs.scheduleReplicaToDiskOnLocalNode(replica2, replicas, volume, diskCandidates, longhorn.ReplicaSchedulingPolicyBalanced)
 	c.Assert(replica2.Spec.NodeID, Equals, "")
 
 	// Case 5: replica1 is marked as failed. In this case, replica2 is allowed to be
 	// scheduled to the local node.
 	replica1.Spec.FailedAt = getTestNow().String()
-	rs.scheduleReplicaToDiskOnLocalNode(replica2, replicas, volume, diskCandidates)
+	rs.scheduleReplicaToDiskOnLocalNode(replica2, replicas, volume, diskCandidates, longhorn.ReplicaSchedulingPolicyBalanced)
 	c.Assert(replica2.Spec.NodeID, Equals, TestNode1)
 }
 
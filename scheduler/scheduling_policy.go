@@ -0,0 +1,323 @@
+package scheduler
+
+import (
+	"fmt"
+	"slices"
+	"sort"
+
+	"github.com/sirupsen/logrus"
+
+	longhorn "github.com/longhorn/longhorn-manager/k8s/pkg/apis/longhorn/v1beta2"
+)
+
+const (
+	// MaxPluginScore is the highest score a DiskScorePlugin can give to a disk.
+	MaxPluginScore = int64(100)
+	// MinPluginScore is the lowest score a DiskScorePlugin can give to a disk.
+	MinPluginScore = int64(0)
+)
+
+// FastDiskTags are the disk tags considered by the prefer-fast-disk-tag policy.
+var FastDiskTags = []string{"nvme", "ssd", "fast"}
+
+// DiskFilterPlugin rejects a disk candidate that must not host the replica.
+// It is evaluated after the disk passed the tag, anti-affinity and storage checks of the ReplicaScheduler.
+type DiskFilterPlugin interface {
+	Name() string
+	Filter(state *PolicyState, disk *Disk) error
+}
+
+// DiskScorePlugin ranks the disk candidates that passed the filter plugins.
+// The score must be in the range [MinPluginScore, MaxPluginScore], the higher the better.
+type DiskScorePlugin interface {
+	Name() string
+	Score(state *PolicyState, disk *Disk) int64
+}
+
+// WeightedScorePlugin is a DiskScorePlugin with its weight in the policy.
+type WeightedScorePlugin struct {
+	DiskScorePlugin
+	Weight int64
+}
+
+// SchedulingPolicy selects a disk for a replica among the disk candidates with filter and score plugins.
+type SchedulingPolicy struct {
+	Name    longhorn.ReplicaSchedulingPolicy
+	Filters []DiskFilterPlugin
+	Scores  []WeightedScorePlugin
+}
+
+// PolicyState carries the information shared by the plugins during a single scheduling cycle.
+type PolicyState struct {
+	ReplicaSize int64
+	Candidates  map[string]*Disk
+
+	// The storage settings that the disks are checked against
+	OverProvisioningPercentage int64
+	MinimalAvailablePercentage int64
+
+	diskUsable map[string]int64
+	diskTotal  map[string]int64
+	nodeUsable map[string]int64
+	nodeTotal  map[string]int64
+
+	diskReplicaCount map[string]int64
+	nodeReplicaCount map[string]int64
+}
+
+func newPolicyState(candidates map[string]*Disk, replicaSize, overProvisioningPercentage, minimalAvailablePercentage int64) *PolicyState {
+	state := &PolicyState{
+		ReplicaSize:                replicaSize,
+		Candidates:                 candidates,
+		OverProvisioningPercentage: overProvisioningPercentage,
+		MinimalAvailablePercentage: minimalAvailablePercentage,
+		diskUsable:                 map[string]int64{},
+		diskTotal:                  map[string]int64{},
+		nodeUsable:                 map[string]int64{},
+		nodeTotal:                  map[string]int64{},
+		diskReplicaCount:           map[string]int64{},
+		nodeReplicaCount:           map[string]int64{},
+	}
+
+	for _, disk := range candidates {
+		usable := max(disk.StorageAvailable-disk.StorageReserved-disk.StorageScheduled, 0)
+		total := max(disk.StorageMaximum-disk.StorageReserved, 0)
+		replicaCount := int64(len(disk.ScheduledReplica))
+
+		state.diskUsable[disk.DiskUUID] = usable
+		state.diskTotal[disk.DiskUUID] = total
+		state.diskReplicaCount[disk.DiskUUID] = replicaCount
+
+		state.nodeUsable[disk.NodeID] += usable
+		state.nodeTotal[disk.NodeID] += total
+		state.nodeReplicaCount[disk.NodeID] += replicaCount
+	}
+
+	return state
+}
+
+// DiskUsableStorage returns the usable bytes of the disk before placing the replica.
+func (state *PolicyState) DiskUsableStorage(disk *Disk) int64 {
+	return state.diskUsable[disk.DiskUUID]
+}
+
+// NodeUsableStorage returns the usable bytes of all candidate disks on the node of the disk.
+func (state *PolicyState) NodeUsableStorage(disk *Disk) int64 {
+	return state.nodeUsable[disk.NodeID]
+}
+
+// usableRatioAfterPlacement returns the usable/total ratio of the disk after placing the replica on it.
+func (state *PolicyState) usableRatioAfterPlacement(disk *Disk) float64 {
+	total := state.diskTotal[disk.DiskUUID]
+	if total <= 0 {
+		return 0
+	}
+	usable := max(state.diskUsable[disk.DiskUUID]-state.ReplicaSize, 0)
+	return float64(usable) / float64(total)
+}
+
+// storageFitFilter rejects disks that cannot hold the replica within the storage over-provisioning and minimal
+// available percentages, the same way the ReplicaScheduler checks the disk candidates.
+type storageFitFilter struct{}
+
+func (storageFitFilter) Name() string { return "StorageFit" }
+
+func (storageFitFilter) Filter(state *PolicyState, disk *Disk) error {
+	info := &DiskSchedulingInfo{
+		DiskUUID:                   disk.DiskUUID,
+		StorageAvailable:           disk.StorageAvailable,
+		StorageMaximum:             disk.StorageMaximum,
+		StorageReserved:            disk.StorageReserved,
+		StorageScheduled:           disk.StorageScheduled,
+		OverProvisioningPercentage: state.OverProvisioningPercentage,
+		MinimalAvailablePercentage: state.MinimalAvailablePercentage,
+	}
+	// The actual size of the volume is already checked when the disk candidates are collected
+	if isSchedulable, message := isSchedulableToDisk(state.ReplicaSize, 0, info); !isSchedulable {
+		return fmt.Errorf("disk %v on node %v cannot hold replica size %v: %v", disk.DiskUUID, disk.NodeID, state.ReplicaSize, message)
+	}
+	return nil
+}
+
+// leastAllocatedScore favors disks with the highest usable ratio after placing the replica.
+type leastAllocatedScore struct{}
+
+func (leastAllocatedScore) Name() string { return "LeastAllocated" }
+
+func (leastAllocatedScore) Score(state *PolicyState, disk *Disk) int64 {
+	return int64(state.usableRatioAfterPlacement(disk) * float64(MaxPluginScore))
+}
+
+// mostAllocatedScore favors disks with the lowest usable ratio after placing the replica, so that replicas are
+// bin-packed onto fewer disks.
+type mostAllocatedScore struct{}
+
+func (mostAllocatedScore) Name() string { return "MostAllocated" }
+
+func (mostAllocatedScore) Score(state *PolicyState, disk *Disk) int64 {
+	return MaxPluginScore - int64(state.usableRatioAfterPlacement(disk)*float64(MaxPluginScore))
+}
+
+// nodeMostAllocatedScore favors nodes with the least usable storage among the candidates, so that replicas are
+// packed onto fewer nodes.
+type nodeMostAllocatedScore struct{}
+
+func (nodeMostAllocatedScore) Name() string { return "NodeMostAllocated" }
+
+func (nodeMostAllocatedScore) Score(state *PolicyState, disk *Disk) int64 {
+	return normalizeLowerIsBetter(state.nodeUsable, disk.NodeID)
+}
+
+// nodeReplicaCountScore favors nodes hosting the fewest replicas.
+type nodeReplicaCountScore struct{}
+
+func (nodeReplicaCountScore) Name() string { return "NodeReplicaCount" }
+
+func (nodeReplicaCountScore) Score(state *PolicyState, disk *Disk) int64 {
+	return normalizeLowerIsBetter(state.nodeReplicaCount, disk.NodeID)
+}
+
+// diskReplicaCountScore favors disks hosting the fewest replicas.
+type diskReplicaCountScore struct{}
+
+func (diskReplicaCountScore) Name() string { return "DiskReplicaCount" }
+
+func (diskReplicaCountScore) Score(state *PolicyState, disk *Disk) int64 {
+	return normalizeLowerIsBetter(state.diskReplicaCount, disk.DiskUUID)
+}
+
+// fastDiskTagScore favors disks tagged with one of the FastDiskTags.
+type fastDiskTagScore struct{}
+
+func (fastDiskTagScore) Name() string { return "FastDiskTag" }
+
+func (fastDiskTagScore) Score(state *PolicyState, disk *Disk) int64 {
+	for _, tag := range disk.Tags {
+		if slices.Contains(FastDiskTags, tag) {
+			return MaxPluginScore
+		}
+	}
+	return MinPluginScore
+}
+
+// normalizeLowerIsBetter maps values[key] into [MinPluginScore, MaxPluginScore], giving MaxPluginScore to the lowest value.
+func normalizeLowerIsBetter(values map[string]int64, key string) int64 {
+	if len(values) == 0 {
+		return MinPluginScore
+	}
+	lowest, highest := int64(0), int64(0)
+	first := true
+	for _, v := range values {
+		if first || v < lowest {
+			lowest = v
+		}
+		if first || v > highest {
+			highest = v
+		}
+		first = false
+	}
+	if highest == lowest {
+		return MaxPluginScore
+	}
+	return (highest - values[key]) * MaxPluginScore / (highest - lowest)
+}
+
+var schedulingPolicies = map[longhorn.ReplicaSchedulingPolicy]*SchedulingPolicy{
+	longhorn.ReplicaSchedulingPolicyLeastAllocated: {
+		Name:    longhorn.ReplicaSchedulingPolicyLeastAllocated,
+		Filters: []DiskFilterPlugin{storageFitFilter{}},
+		Scores: []WeightedScorePlugin{
+			{DiskScorePlugin: leastAllocatedScore{}, Weight: 1},
+		},
+	},
+	longhorn.ReplicaSchedulingPolicyMostAllocated: {
+		Name:    longhorn.ReplicaSchedulingPolicyMostAllocated,
+		Filters: []DiskFilterPlugin{storageFitFilter{}},
+		Scores: []WeightedScorePlugin{
+			{DiskScorePlugin: nodeMostAllocatedScore{}, Weight: 2},
+			{DiskScorePlugin: mostAllocatedScore{}, Weight: 1},
+		},
+	},
+	longhorn.ReplicaSchedulingPolicySpreadByReplicaCount: {
+		Name:    longhorn.ReplicaSchedulingPolicySpreadByReplicaCount,
+		Filters: []DiskFilterPlugin{storageFitFilter{}},
+		Scores: []WeightedScorePlugin{
+			{DiskScorePlugin: nodeReplicaCountScore{}, Weight: 4},
+			{DiskScorePlugin: diskReplicaCountScore{}, Weight: 2},
+			{DiskScorePlugin: leastAllocatedScore{}, Weight: 1},
+		},
+	},
+	longhorn.ReplicaSchedulingPolicyPreferFastDiskTag: {
+		Name:    longhorn.ReplicaSchedulingPolicyPreferFastDiskTag,
+		Filters: []DiskFilterPlugin{storageFitFilter{}},
+		Scores: []WeightedScorePlugin{
+			{DiskScorePlugin: fastDiskTagScore{}, Weight: 10},
+			{DiskScorePlugin: leastAllocatedScore{}, Weight: 1},
+		},
+	},
+}
+
+// GetSchedulingPolicy returns the built-in scheduling policy with the given name.
+// It returns nil for the balanced policy, which is handled by getDiskWithMostBalanceScore.
+func GetSchedulingPolicy(name longhorn.ReplicaSchedulingPolicy) *SchedulingPolicy {
+	return schedulingPolicies[name]
+}
+
+// SelectDisk runs the filter plugins and picks the disk with the highest weighted score.
+// Ties are broken by the disk UUID to keep the result stable. It returns nil if every disk is rejected by the filters.
+func (p *SchedulingPolicy) SelectDisk(candidates map[string]*Disk, replicaSize, overProvisioningPercentage, minimalAvailablePercentage int64) *Disk {
+	if len(candidates) == 0 {
+		return nil
+	}
+
+	state := newPolicyState(candidates, replicaSize, overProvisioningPercentage, minimalAvailablePercentage)
+
+	diskUUIDs := make([]string, 0, len(candidates))
+	for diskUUID := range candidates {
+		diskUUIDs = append(diskUUIDs, diskUUID)
+	}
+	sort.Strings(diskUUIDs)
+
+	feasible := []*Disk{}
+	for _, diskUUID := range diskUUIDs {
+		disk := candidates[diskUUID]
+		if err := p.filter(state, disk); err != nil {
+			logrus.WithError(err).Debugf("Scheduling policy %v filtered out disk %v", p.Name, diskUUID)
+			continue
+		}
+		feasible = append(feasible, disk)
+	}
+	if len(feasible) == 0 {
+		logrus.Debugf("Scheduling policy %v filtered out all disks", p.Name)
+		return nil
+	}
+
+	var bestDisk *Disk
+	bestScore := int64(-1)
+	for _, disk := range feasible {
+		score := p.score(state, disk)
+		if score > bestScore {
+			bestScore = score
+			bestDisk = disk
+		}
+	}
+	return bestDisk
+}
+
+func (p *SchedulingPolicy) filter(state *PolicyState, disk *Disk) error {
+	for _, plugin := range p.Filters {
+		if err := plugin.Filter(state, disk); err != nil {
+			return fmt.Errorf("%v: %w", plugin.Name(), err)
+		}
+	}
+	return nil
+}
+
+func (p *SchedulingPolicy) score(state *PolicyState, disk *Disk) int64 {
+	total := int64(0)
+	for _, plugin := range p.Scores {
+		score := min(max(plugin.Score(state, disk), MinPluginScore), MaxPluginScore)
+		total += score * plugin.Weight
+	}
+	return total
+}
@@ -0,0 +1,173 @@
+package scheduler
+
+import (
+	"k8s.io/client-go/kubernetes/fake"
+	"k8s.io/kubernetes/pkg/controller"
+
+	apiextensionsfake "k8s.io/apiextensions-apiserver/pkg/client/clientset/clientset/fake"
+
+	"github.com/longhorn/longhorn-manager/util"
+
+	longhorn "github.com/longhorn/longhorn-manager/k8s/pkg/apis/longhorn/v1beta2"
+	lhfake "github.com/longhorn/longhorn-manager/k8s/pkg/client/clientset/versioned/fake"
+
+	. "gopkg.in/check.v1"
+)
+
+func newPolicyTestDisk(diskUUID, nodeID string, available, maximum int64, scheduledReplicas int, tags ...string) *Disk {
+	scheduledReplica := map[string]int64{}
+	for i := 0; i < scheduledReplicas; i++ {
+		scheduledReplica[diskUUID+"-replica-"+string(rune('a'+i))] = 1
+	}
+	return &Disk{
+		DiskSpec: longhorn.DiskSpec{
+			Tags: tags,
+		},
+		DiskStatus: &longhorn.DiskStatus{
+			DiskUUID:         diskUUID,
+			StorageAvailable: available,
+			StorageMaximum:   maximum,
+			ScheduledReplica: scheduledReplica,
+		},
+		NodeID: nodeID,
+	}
+}
+
+func (s *TestSuite) TestSchedulingPolicySelectDisk(c *C) {
+	// Node1: Disk1 (900/1000 avail, 1 replica), Disk3 (200/1000 avail, 3 replicas, ssd)
+	// Node2: Disk2 (600/1000 avail, 0 replica)
+	// Node3: Disk4 (50/1000 avail, 0 replica, nvme)
+	disk1 := newPolicyTestDisk(TestDisk1ID, TestNode1, 900, 1000, 1)
+	disk2 := newPolicyTestDisk(TestDisk2ID, TestNode2, 600, 1000, 0)
+	disk3 := newPolicyTestDisk(TestDisk3ID, TestNode1, 200, 1000, 3, "ssd")
+	disk4 := newPolicyTestDisk(TestDisk4ID, TestNode3, 50, 1000, 0, "nvme")
+
+	candidates := map[string]*Disk{
+		TestDisk1ID: disk1,
+		TestDisk2ID: disk2,
+		TestDisk3ID: disk3,
+		TestDisk4ID: disk4,
+	}
+
+	tests := []struct {
+		name                       string
+		policy                     longhorn.ReplicaSchedulingPolicy
+		candidates                 map[string]*Disk
+		replicaSize                int64
+		overProvisioningPercentage int64
+		minimalAvailablePercentage int64
+		expectDisk                 *Disk
+	}{
+		{
+			name:                       "least-allocated picks the disk with the highest usable ratio",
+			policy:                     longhorn.ReplicaSchedulingPolicyLeastAllocated,
+			candidates:                 candidates,
+			replicaSize:                100,
+			overProvisioningPercentage: 100,
+			minimalAvailablePercentage: 10,
+			expectDisk:                 disk1,
+		},
+		{
+			name:                       "most-allocated packs onto the fullest node that still fits the replica",
+			policy:                     longhorn.ReplicaSchedulingPolicyMostAllocated,
+			candidates:                 candidates,
+			replicaSize:                100,
+			overProvisioningPercentage: 100,
+			minimalAvailablePercentage: 10,
+			expectDisk:                 disk2,
+		},
+		{
+			name:                       "most-allocated uses the fullest disk when every disk fits the replica",
+			policy:                     longhorn.ReplicaSchedulingPolicyMostAllocated,
+			candidates:                 candidates,
+			replicaSize:                10,
+			overProvisioningPercentage: 100,
+			minimalAvailablePercentage: 0,
+			expectDisk:                 disk4,
+		},
+		{
+			name:                       "spread-by-replica-count prefers nodes and disks without replicas",
+			policy:                     longhorn.ReplicaSchedulingPolicySpreadByReplicaCount,
+			candidates:                 candidates,
+			replicaSize:                10,
+			overProvisioningPercentage: 100,
+			minimalAvailablePercentage: 10,
+			expectDisk:                 disk2,
+		},
+		{
+			name:                       "prefer-fast-disk-tag prefers the fast disk that fits the replica",
+			policy:                     longhorn.ReplicaSchedulingPolicyPreferFastDiskTag,
+			candidates:                 candidates,
+			replicaSize:                100,
+			overProvisioningPercentage: 100,
+			minimalAvailablePercentage: 10,
+			expectDisk:                 disk3,
+		},
+		{
+			name:   "no disk is selected when the over-provisioning limit rejects every disk",
+			policy: longhorn.ReplicaSchedulingPolicyLeastAllocated,
+			candidates: map[string]*Disk{
+				TestDisk3ID: disk3,
+				TestDisk4ID: disk4,
+			},
+			replicaSize:                5000,
+			overProvisioningPercentage: 100,
+			minimalAvailablePercentage: 0,
+			expectDisk:                 nil,
+		},
+		{
+			name:   "over-provisioning allows a replica larger than the disk",
+			policy: longhorn.ReplicaSchedulingPolicyLeastAllocated,
+			candidates: map[string]*Disk{
+				TestDisk3ID: disk3,
+				TestDisk4ID: disk4,
+			},
+			replicaSize:                1500,
+			overProvisioningPercentage: 200,
+			minimalAvailablePercentage: 10,
+			expectDisk:                 disk3,
+		},
+		{
+			name:   "no disk is selected when the minimal available percentage rejects every disk",
+			policy: longhorn.ReplicaSchedulingPolicyMostAllocated,
+			candidates: map[string]*Disk{
+				TestDisk3ID: disk3,
+				TestDisk4ID: disk4,
+			},
+			replicaSize:                10,
+			overProvisioningPercentage: 100,
+			minimalAvailablePercentage: 25,
+			expectDisk:                 nil,
+		},
+	}
+
+	for _, tt := range tests {
+		c.Logf("Running scenario: %s", tt.name)
+		policy := GetSchedulingPolicy(tt.policy)
+		c.Assert(policy, NotNil)
+		c.Assert(policy.SelectDisk(tt.candidates, tt.replicaSize, tt.overProvisioningPercentage, tt.minimalAvailablePercentage), Equals, tt.expectDisk)
+	}
+
+	c.Assert(GetSchedulingPolicy(longhorn.ReplicaSchedulingPolicyBalanced), IsNil)
+	c.Assert(GetSchedulingPolicy(longhorn.ReplicaSchedulingPolicyLeastAllocated).SelectDisk(map[string]*Disk{}, 10, 100, 25), IsNil)
+}
+
+func (s *TestSuite) TestSelectDiskWithPolicy(c *C) {
+	kubeClient := fake.NewSimpleClientset()                    // nolint: staticcheck
+	lhClient := lhfake.NewSimpleClientset()                    // nolint: staticcheck
+	extensionsClient := apiextensionsfake.NewSimpleClientset() // nolint: staticcheck
+	informerFactories := util.NewInformerFactories(TestNamespace, kubeClient, lhClient, controller.NoResyncPeriodFunc())
+	replicaScheduler := newReplicaScheduler(lhClient, kubeClient, extensionsClient, informerFactories)
+
+	disk1 := newPolicyTestDisk(TestDisk1ID, TestNode1, 1000, 1000, 0)
+	disk2 := newPolicyTestDisk(TestDisk2ID, TestNode2, 400, 1000, 0)
+	candidates := map[string]*Disk{
+		TestDisk1ID: disk1,
+		TestDisk2ID: disk2,
+	}
+
+	c.Assert(replicaScheduler.selectDisk(candidates, 100, longhorn.ReplicaSchedulingPolicyBalanced), Equals, disk1)
+	c.Assert(replicaScheduler.selectDisk(candidates, 100, longhorn.ReplicaSchedulingPolicyMostAllocated), Equals, disk2)
+	// The default storage over-provisioning percentage is 100
+	c.Assert(replicaScheduler.selectDisk(candidates, 5000, longhorn.ReplicaSchedulingPolicyMostAllocated), IsNil)
+}
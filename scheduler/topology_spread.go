@@ -0,0 +1,173 @@
+package scheduler
+
+import (
+	"github.com/cockroachdb/errors"
+
+	"github.com/longhorn/longhorn-manager/datastore"
+
+	longhorn "github.com/longhorn/longhorn-manager/k8s/pkg/apis/longhorn/v1beta2"
+)
+
+// replicaTopologySpread evaluates the replica topology spread constraints of a volume against the replicas already
+// scheduled to the topology domains
+type replicaTopologySpread struct {
+	constraints []longhorn.ReplicaTopologySpreadConstraint
+	// nodeLabels are the labels of the Kubernetes nodes keyed by the node name
+	nodeLabels map[string]map[string]string
+	// nodeReplicaCounts are the replicas counted for the spread keyed by the node name
+	nodeReplicaCounts map[string]int
+}
+
+// GetNodeTopologyLabels returns the labels of the Kubernetes nodes, which carry the topology domains of the nodes.
+// The nodes without a Kubernetes node are skipped.
+func (rcs *ReplicaScheduler) GetNodeTopologyLabels(nodeNames map[string]struct{}) (map[string]map[string]string, error) {
+	nodeLabels := map[string]map[string]string{}
+	for nodeName := range nodeNames {
+		kubeNode, err := rcs.ds.GetKubernetesNodeRO(nodeName)
+		if err != nil {
+			if datastore.ErrorIsNotFound(err) {
+				continue
+			}
+			return nil, errors.Wrapf(err, "failed to get Kubernetes node %v", nodeName)
+		}
+		nodeLabels[nodeName] = kubeNode.Labels
+	}
+	return nodeLabels, nil
+}
+
+// GetTopologyDomain returns the topology domain of the node for the topology key. It returns false if the node is
+// not labelled with the key.
+func GetTopologyDomain(nodeLabels map[string]map[string]string, nodeName, topologyKey string) (string, bool) {
+	domain, ok := nodeLabels[nodeName][topologyKey]
+	return domain, ok
+}
+
+// newReplicaTopologySpread collects the topology labels of the nodes and the replicas counted for the spread.
+// It returns nil if the volume has no replica topology spread constraint.
+func (rcs *ReplicaScheduler) newReplicaTopologySpread(volume *longhorn.Volume, nodes map[string]*longhorn.Node,
+	replicas map[string]*longhorn.Replica, ignoreFailedReplicas, creatingNewReplicasForReplenishment bool) (*replicaTopologySpread, error) {
+	if len(volume.Spec.ReplicaTopologySpread) == 0 {
+		return nil, nil
+	}
+
+	nodeReplicaCounts := map[string]int{}
+	for _, r := range replicas {
+		if r.Spec.NodeID == "" || r.Spec.EvictionRequested {
+			// The evicting replicas are going away, so they do not hold their topology domains
+			continue
+		}
+		if !isReplicaCountedForScheduling(r, ignoreFailedReplicas, creatingNewReplicasForReplenishment) {
+			continue
+		}
+		nodeReplicaCounts[r.Spec.NodeID]++
+	}
+
+	nodeNames := map[string]struct{}{}
+	for nodeName := range nodes {
+		nodeNames[nodeName] = struct{}{}
+	}
+	for nodeName := range nodeReplicaCounts {
+		nodeNames[nodeName] = struct{}{}
+	}
+	nodeLabels, err := rcs.GetNodeTopologyLabels(nodeNames)
+	if err != nil {
+		return nil, err
+	}
+
+	return &replicaTopologySpread{
+		constraints:       volume.Spec.ReplicaTopologySpread,
+		nodeLabels:        nodeLabels,
+		nodeReplicaCounts: nodeReplicaCounts,
+	}, nil
+}
+
+// hasMode returns true if any of the constraints is in the mode
+func (ts *replicaTopologySpread) hasMode(mode longhorn.ReplicaTopologySpreadMode) bool {
+	if ts == nil {
+		return false
+	}
+	for _, constraint := range ts.constraints {
+		if constraint.Mode == mode {
+			return true
+		}
+	}
+	return false
+}
+
+// getDomainReplicaCounts returns the replica count of each topology domain of the key
+func (ts *replicaTopologySpread) getDomainReplicaCounts(topologyKey string) map[string]int {
+	domainReplicaCounts := map[string]int{}
+	for nodeName, count := range ts.nodeReplicaCounts {
+		if domain, ok := GetTopologyDomain(ts.nodeLabels, nodeName, topologyKey); ok {
+			domainReplicaCounts[domain] += count
+		}
+	}
+	return domainReplicaCounts
+}
+
+// filterNodes returns the nodes that keep the replica count skew between the topology domains of the nodes within
+// the max skew after hosting one more replica. The constraints of the mode are applied in order. A hard constraint
+// rejects the nodes not labelled with the topology key, while a soft constraint is skipped if none of the nodes
+// satisfies it.
+func (ts *replicaTopologySpread) filterNodes(nodeNames map[string]struct{}, mode longhorn.ReplicaTopologySpreadMode) map[string]struct{} {
+	if ts == nil {
+		return nodeNames
+	}
+
+	for _, constraint := range ts.constraints {
+		if constraint.Mode != mode {
+			continue
+		}
+
+		domainReplicaCounts := ts.getDomainReplicaCounts(constraint.TopologyKey)
+		minCount := -1
+		for nodeName := range nodeNames {
+			domain, ok := GetTopologyDomain(ts.nodeLabels, nodeName, constraint.TopologyKey)
+			if !ok {
+				continue
+			}
+			if count := domainReplicaCounts[domain]; minCount < 0 || count < minCount {
+				minCount = count
+			}
+		}
+
+		filtered := map[string]struct{}{}
+		for nodeName := range nodeNames {
+			domain, ok := GetTopologyDomain(ts.nodeLabels, nodeName, constraint.TopologyKey)
+			if !ok {
+				continue
+			}
+			if domainReplicaCounts[domain]+1-minCount <= constraint.MaxSkew {
+				filtered[nodeName] = struct{}{}
+			}
+		}
+
+		if len(filtered) == 0 && mode == longhorn.ReplicaTopologySpreadModeSoft {
+			continue
+		}
+		nodeNames = filtered
+	}
+	return nodeNames
+}
+
+// filterDisks returns the disks on the nodes preferred by the soft constraints. It returns the input disks map if
+// none of the nodes satisfies the soft constraints.
+func (ts *replicaTopologySpread) filterDisks(disks map[string]*Disk) map[string]*Disk {
+	if !ts.hasMode(longhorn.ReplicaTopologySpreadModeSoft) || len(disks) == 0 {
+		return disks
+	}
+
+	nodeNames := map[string]struct{}{}
+	for _, disk := range disks {
+		nodeNames[disk.NodeID] = struct{}{}
+	}
+	preferredNodeNames := ts.filterNodes(nodeNames, longhorn.ReplicaTopologySpreadModeSoft)
+
+	preferredDisks := map[string]*Disk{}
+	for diskUUID, disk := range disks {
+		if _, ok := preferredNodeNames[disk.NodeID]; ok {
+			preferredDisks[diskUUID] = disk
+		}
+	}
+	return preferredDisks
+}
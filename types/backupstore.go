@@ -1,7 +1,70 @@
 package types
 
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/cockroachdb/errors"
+)
+
 const (
 	SettingNameBackupTarget                 = SettingName("backup-target")
 	SettingNameBackupTargetCredentialSecret = SettingName("backup-target-credential-secret")
 	SettingNameBackupstorePollInterval      = SettingName("backupstore-poll-interval")
 )
+
+// ParseBackupWindow parses the backup window in the format "HH:MM-HH:MM" and returns the start and end as the
+// offsets from midnight. The window spans midnight if the end is earlier than the start.
+func ParseBackupWindow(window string) (start, end time.Duration, err error) {
+	parts := strings.Split(window, "-")
+	if len(parts) != 2 {
+		return 0, 0, fmt.Errorf("invalid backup window %v, expecting the format HH:MM-HH:MM", window)
+	}
+	if start, err = parseBackupWindowTime(parts[0]); err != nil {
+		return 0, 0, errors.Wrapf(err, "invalid backup window %v", window)
+	}
+	if end, err = parseBackupWindowTime(parts[1]); err != nil {
+		return 0, 0, errors.Wrapf(err, "invalid backup window %v", window)
+	}
+	if start == end {
+		return 0, 0, fmt.Errorf("invalid backup window %v, the start and end cannot be the same", window)
+	}
+	return start, end, nil
+}
+
+func parseBackupWindowTime(value string) (time.Duration, error) {
+	t, err := time.Parse("15:04", strings.TrimSpace(value))
+	if err != nil {
+		return 0, err
+	}
+	return time.Duration(t.Hour())*time.Hour + time.Duration(t.Minute())*time.Minute, nil
+}
+
+// IsInBackupWindow returns true if the given time in UTC is in the backup window. Otherwise, it returns the duration
+// until the window opens. An empty window allows backups at any time.
+func IsInBackupWindow(window string, now time.Time) (bool, time.Duration, error) {
+	if window == "" {
+		return true, 0, nil
+	}
+	start, end, err := ParseBackupWindow(window)
+	if err != nil {
+		return false, 0, err
+	}
+
+	now = now.UTC()
+	offset := now.Sub(time.Date(now.Year(), now.Month(), now.Day(), 0, 0, 0, 0, time.UTC))
+	if start < end {
+		if offset >= start && offset < end {
+			return true, 0, nil
+		}
+	} else if offset >= start || offset < end {
+		return true, 0, nil
+	}
+
+	untilOpen := start - offset
+	if untilOpen < 0 {
+		untilOpen += 24 * time.Hour
+	}
+	return false, untilOpen, nil
+}
@@ -74,10 +74,16 @@ const (
 	SettingNameReplicaAutoBalanceDiskPressurePercentage                 = SettingName("replica-auto-balance-disk-pressure-percentage")
 	SettingNameStorageOverProvisioningPercentage                        = SettingName("storage-over-provisioning-percentage")
 	SettingNameStorageMinimalAvailablePercentage                        = SettingName("storage-minimal-available-percentage")
+	SettingNameDiskTimeToFullWarningThreshold                           = SettingName("disk-time-to-full-warning-threshold")
 	SettingNameStorageReservedPercentageForDefaultDisk                  = SettingName("storage-reserved-percentage-for-default-disk")
 	SettingNameUpgradeChecker                                           = SettingName("upgrade-checker")
 	SettingNameUpgradeResponderURL                                      = SettingName("upgrade-responder-url")
 	SettingNameManagerURL                                               = SettingName("manager-url")
+	SettingNameAPIAccessMode                                            = SettingName("api-access-mode")
+	SettingNameAuditLogEnabled                                          = SettingName("audit-log-enabled")
+	SettingNameAuditLogMaxSize                                          = SettingName("audit-log-max-size")
+	SettingNameAuditLogMaxBackups                                       = SettingName("audit-log-max-backups")
+	SettingNameAuditLogWebhookURL                                       = SettingName("audit-log-webhook-url")
 	SettingNameAllowCollectingLonghornUsage                             = SettingName("allow-collecting-longhorn-usage-metrics")
 	SettingNameCurrentLonghornVersion                                   = SettingName("current-longhorn-version")
 	SettingNameLatestLonghornVersion                                    = SettingName("latest-longhorn-version")
@@ -141,6 +147,7 @@ const (
 	SettingNameRestoreConcurrentLimit                                   = SettingName("restore-concurrent-limit")
 	SettingNameLogLevel                                                 = SettingName("log-level")
 	SettingNameReplicaDiskSoftAntiAffinity                              = SettingName("replica-disk-soft-anti-affinity")
+	SettingNameReplicaSchedulingPolicy                                  = SettingName("replica-scheduling-policy")
 	SettingNameAllowEmptyNodeSelectorVolume                             = SettingName("allow-empty-node-selector-volume")
 	SettingNameAllowEmptyDiskSelectorVolume                             = SettingName("allow-empty-disk-selector-volume")
 	SettingNameDisableSnapshotPurge                                     = SettingName("disable-snapshot-purge")
@@ -157,6 +164,7 @@ const (
 	SettingNameAutoCleanupSnapshotAfterOnDemandBackupCompleted          = SettingName("auto-cleanup-snapshot-after-on-demand-backup-completed")
 	SettingNameDefaultMinNumberOfBackingImageCopies                     = SettingName("default-min-number-of-backing-image-copies")
 	SettingNameBackupExecutionTimeout                                   = SettingName("backup-execution-timeout")
+	SettingNameStandbyVolumeRPOThreshold                                = SettingName("standby-volume-rpo-threshold")
 	SettingNameRWXVolumeFastFailover                                    = SettingName("rwx-volume-fast-failover")
 	SettingNameOfflineReplicaRebuilding                                 = SettingName("offline-replica-rebuilding")
 	SettingNameReplicaRebuildingBandwidthLimit                          = SettingName("replica-rebuilding-bandwidth-limit")
@@ -195,10 +203,16 @@ var (
 		SettingNameReplicaAutoBalanceDiskPressurePercentage,
 		SettingNameStorageOverProvisioningPercentage,
 		SettingNameStorageMinimalAvailablePercentage,
+		SettingNameDiskTimeToFullWarningThreshold,
 		SettingNameStorageReservedPercentageForDefaultDisk,
 		SettingNameUpgradeChecker,
 		SettingNameUpgradeResponderURL,
 		SettingNameManagerURL,
+		SettingNameAPIAccessMode,
+		SettingNameAuditLogEnabled,
+		SettingNameAuditLogMaxSize,
+		SettingNameAuditLogMaxBackups,
+		SettingNameAuditLogWebhookURL,
 		SettingNameAllowCollectingLonghornUsage,
 		SettingNameCurrentLonghornVersion,
 		SettingNameLatestLonghornVersion,
@@ -270,6 +284,7 @@ var (
 		SettingNameSnapshotDataIntegrity,
 		SettingNameDataEngineInterruptModeEnabled,
 		SettingNameReplicaDiskSoftAntiAffinity,
+		SettingNameReplicaSchedulingPolicy,
 		SettingNameAllowEmptyNodeSelectorVolume,
 		SettingNameAllowEmptyDiskSelectorVolume,
 		SettingNameDisableSnapshotPurge,
@@ -278,6 +293,7 @@ var (
 		SettingNameAutoCleanupSnapshotAfterOnDemandBackupCompleted,
 		SettingNameDefaultMinNumberOfBackingImageCopies,
 		SettingNameBackupExecutionTimeout,
+		SettingNameStandbyVolumeRPOThreshold,
 		SettingNameRWXVolumeFastFailover,
 		SettingNameOfflineReplicaRebuilding,
 		SettingNameReplicaRebuildingBandwidthLimit,
@@ -351,10 +367,16 @@ var (
 		SettingNameReplicaAutoBalanceDiskPressurePercentage:                 SettingDefinitionReplicaAutoBalanceDiskPressurePercentage,
 		SettingNameStorageOverProvisioningPercentage:                        SettingDefinitionStorageOverProvisioningPercentage,
 		SettingNameStorageMinimalAvailablePercentage:                        SettingDefinitionStorageMinimalAvailablePercentage,
+		SettingNameDiskTimeToFullWarningThreshold:                           SettingDefinitionDiskTimeToFullWarningThreshold,
 		SettingNameStorageReservedPercentageForDefaultDisk:                  SettingDefinitionStorageReservedPercentageForDefaultDisk,
 		SettingNameUpgradeChecker:                                           SettingDefinitionUpgradeChecker,
 		SettingNameUpgradeResponderURL:                                      SettingDefinitionUpgradeResponderURL,
 		SettingNameManagerURL:                                               SettingDefinitionManagerURL,
+		SettingNameAPIAccessMode:                                            SettingDefinitionAPIAccessMode,
+		SettingNameAuditLogEnabled:                                          SettingDefinitionAuditLogEnabled,
+		SettingNameAuditLogMaxSize:                                          SettingDefinitionAuditLogMaxSize,
+		SettingNameAuditLogMaxBackups:                                       SettingDefinitionAuditLogMaxBackups,
+		SettingNameAuditLogWebhookURL:                                       SettingDefinitionAuditLogWebhookURL,
 		SettingNameAllowCollectingLonghornUsage:                             SettingDefinitionAllowCollectingLonghornUsageMetrics,
 		SettingNameCurrentLonghornVersion:                                   SettingDefinitionCurrentLonghornVersion,
 		SettingNameLatestLonghornVersion:                                    SettingDefinitionLatestLonghornVersion,
@@ -425,6 +447,7 @@ var (
 		SettingNameDataEngineLogFlags:                                       SettingDefinitionDataEngineLogFlags,
 		SettingNameDataEngineInterruptModeEnabled:                           SettingDefinitionDataEngineInterruptModeEnabled,
 		SettingNameReplicaDiskSoftAntiAffinity:                              SettingDefinitionReplicaDiskSoftAntiAffinity,
+		SettingNameReplicaSchedulingPolicy:                                  SettingDefinitionReplicaSchedulingPolicy,
 		SettingNameAllowEmptyNodeSelectorVolume:                             SettingDefinitionAllowEmptyNodeSelectorVolume,
 		SettingNameAllowEmptyDiskSelectorVolume:                             SettingDefinitionAllowEmptyDiskSelectorVolume,
 		SettingNameDisableSnapshotPurge:                                     SettingDefinitionDisableSnapshotPurge,
@@ -433,6 +456,7 @@ var (
 		SettingNameAutoCleanupSnapshotAfterOnDemandBackupCompleted:          SettingDefinitionAutoCleanupSnapshotAfterOnDemandBackupCompleted,
 		SettingNameDefaultMinNumberOfBackingImageCopies:                     SettingDefinitionDefaultMinNumberOfBackingImageCopies,
 		SettingNameBackupExecutionTimeout:                                   SettingDefinitionBackupExecutionTimeout,
+		SettingNameStandbyVolumeRPOThreshold:                                SettingDefinitionStandbyVolumeRPOThreshold,
 		SettingNameRWXVolumeFastFailover:                                    SettingDefinitionRWXVolumeFastFailover,
 		SettingNameOfflineReplicaRebuilding:                                 SettingDefinitionOfflineReplicaRebuilding,
 		SettingNameReplicaRebuildingBandwidthLimit:                          SettingDefinitionReplicaRebuildingBandwidthLimit,
@@ -490,6 +514,22 @@ var (
 		},
 	}
 
+	SettingDefinitionStandbyVolumeRPOThreshold = SettingDefinition{
+		DisplayName: "Standby Volume RPO Threshold",
+		Description: "In minutes. If the last backup restored to a DR (standby) volume was created longer ago than this threshold, " +
+			"the RPOExceeded condition of the volume becomes true and a warning event is recorded. \n\n" +
+			"Set to 0 to disable the check.",
+		Category:           SettingCategoryBackup,
+		Type:               SettingTypeInt,
+		Required:           true,
+		ReadOnly:           false,
+		DataEngineSpecific: false,
+		Default:            "0",
+		ValueIntRange: map[string]int{
+			ValueIntRangeMinimum: 0,
+		},
+	}
+
 	SettingDefinitionRestoreVolumeRecurringJobs = SettingDefinition{
 		DisplayName: "Restore Volume Recurring Jobs",
 		Description: "Restore recurring jobs from the backup volume on the backup target and create recurring jobs if not exist during a backup restoration.\n\n" +
@@ -668,6 +708,23 @@ var (
 		},
 	}
 
+	SettingDefinitionDiskTimeToFullWarningThreshold = SettingDefinition{
+		DisplayName: "Disk Time To Full Warning Threshold",
+		Description: "In hours. Longhorn forecasts when the available storage of each disk drops below the Storage Minimal Available Percentage from the growth of the disk usage. " +
+			"If the forecast falls within this number of hours, the FillingUp condition of the disk becomes true and a warning event is recorded. \n\n" +
+			"The forecast is based on the usage history collected by the running longhorn-manager, so it becomes available about an hour after the longhorn-manager starts. " +
+			"Set to 0 to disable the warning.",
+		Category:           SettingCategoryScheduling,
+		Type:               SettingTypeInt,
+		Required:           true,
+		ReadOnly:           false,
+		DataEngineSpecific: false,
+		Default:            "72",
+		ValueIntRange: map[string]int{
+			ValueIntRangeMinimum: 0,
+		},
+	}
+
 	SettingDefinitionStorageReservedPercentageForDefaultDisk = SettingDefinition{
 		DisplayName:        "Storage Reserved Percentage For Default Disk",
 		Description:        "The reserved percentage specifies the percentage of disk space that will not be allocated to the default disk on each new Longhorn node",
@@ -716,6 +773,79 @@ var (
 		Default:            "",
 	}
 
+	SettingDefinitionAPIAccessMode = SettingDefinition{
+		DisplayName: "API Access Mode",
+		Description: "Define how requests to the Longhorn Manager API are authenticated and authorized.\n" +
+			"- **unrestricted** Longhorn will serve all requests without authentication.\n" +
+			"- **read-only** Longhorn will serve read requests without authentication, for example for dashboards. Mutating requests require a Kubernetes bearer token.\n" +
+			"- **authenticated** All requests require a Kubernetes bearer token.\n" +
+			"The bearer token is validated by a TokenReview, and each request is authorized by a SubjectAccessReview of the verb on the resource in the longhorn.io API group, for example verb detach on resource volumes, or verb update on resource settings. " +
+			"The Longhorn UI and other clients without a bearer token are limited to read requests in read-only mode, and are rejected in authenticated mode.",
+		Category:           SettingCategoryGeneral,
+		Type:               SettingTypeString,
+		Required:           true,
+		ReadOnly:           false,
+		DataEngineSpecific: false,
+		Default:            string(APIAccessModeUnrestricted),
+		Choices: []any{
+			string(APIAccessModeUnrestricted),
+			string(APIAccessModeReadOnly),
+			string(APIAccessModeAuthenticated),
+		},
+	}
+
+	SettingDefinitionAuditLogEnabled = SettingDefinition{
+		DisplayName: "Audit Log Enabled",
+		Description: "Setting that allows Longhorn to record every mutating request to the Longhorn Manager API and every admitted change of Longhorn resources to an audit log. " +
+			"Each record contains the user, the resource, the action, the result, and the old and new values for settings. " +
+			"The audit log is written as JSON lines to the file longhorn-manager-audit.log in the directory of the setting log-path on each node.",
+		Category:           SettingCategoryGeneral,
+		Type:               SettingTypeBool,
+		Required:           true,
+		ReadOnly:           false,
+		DataEngineSpecific: false,
+		Default:            "true",
+	}
+
+	SettingDefinitionAuditLogMaxSize = SettingDefinition{
+		DisplayName:        "Audit Log Max Size",
+		Description:        "The maximum size in MiB of the audit log file before it is rotated.",
+		Category:           SettingCategoryGeneral,
+		Type:               SettingTypeInt,
+		Required:           true,
+		ReadOnly:           false,
+		DataEngineSpecific: false,
+		Default:            "100",
+		ValueIntRange: map[string]int{
+			ValueIntRangeMinimum: 1,
+		},
+	}
+
+	SettingDefinitionAuditLogMaxBackups = SettingDefinition{
+		DisplayName:        "Audit Log Max Backups",
+		Description:        "The maximum number of rotated audit log files to retain. The oldest rotated file is removed when the limit is exceeded.",
+		Category:           SettingCategoryGeneral,
+		Type:               SettingTypeInt,
+		Required:           true,
+		ReadOnly:           false,
+		DataEngineSpecific: false,
+		Default:            "5",
+		ValueIntRange: map[string]int{
+			ValueIntRangeMinimum: 1,
+		},
+	}
+
+	SettingDefinitionAuditLogWebhookURL = SettingDefinition{
+		DisplayName:        "Audit Log Webhook URL",
+		Description:        "The http or https URL that receives the audit records as JSON by POST requests, in addition to the audit log file. Leave it empty to disable the webhook sink.",
+		Category:           SettingCategoryGeneral,
+		Type:               SettingTypeString,
+		Required:           false,
+		ReadOnly:           false,
+		DataEngineSpecific: false,
+		Default:            "",
+	}
+
 	SettingDefinitionAllowCollectingLonghornUsageMetrics = SettingDefinition{
 		DisplayName: "Allow Collecting Longhorn Usage Metrics",
 		Description: "Enabling this setting will allow Longhorn to provide additional usage metrics to https://metrics.longhorn.io/.\n" +
@@ -1719,6 +1849,31 @@ var (
 		Default:            "true",
 	}
 
+	SettingDefinitionReplicaSchedulingPolicy = SettingDefinition{
+		DisplayName: "Replica Scheduling Policy",
+		Description: "The policy used to pick a disk among the disks that satisfy the tags, anti-affinity and storage requirements of a replica.\n\n" +
+			"The available global options are: \n\n" +
+			"- **balanced**. This is the default option. Longhorn picks the node and disk that keep the usable storage most evenly distributed.\n" +
+			"- **least-allocated**. Longhorn prefers the disk with the highest ratio of usable storage.\n" +
+			"- **most-allocated**. Longhorn packs replicas onto the disks with the lowest ratio of usable storage, so that fewer nodes are used.\n" +
+			"- **spread-by-replica-count**. Longhorn prefers the nodes and disks hosting the fewest replicas.\n" +
+			"- **prefer-fast-disk-tag**. Longhorn prefers disks tagged with `nvme`, `ssd` or `fast`, then the disk with the highest ratio of usable storage.\n\n" +
+			"Longhorn also supports individual volume setting. The setting can be specified in the `replicaSchedulingPolicy` StorageClass parameter or on the volume, this overrules the global setting.\n",
+		Category:           SettingCategoryScheduling,
+		Type:               SettingTypeString,
+		Required:           true,
+		ReadOnly:           false,
+		DataEngineSpecific: false,
+		Default:            string(longhorn.ReplicaSchedulingPolicyBalanced),
+		Choices: []any{
+			string(longhorn.ReplicaSchedulingPolicyBalanced),
+			string(longhorn.ReplicaSchedulingPolicyLeastAllocated),
+			string(longhorn.ReplicaSchedulingPolicyMostAllocated),
+			string(longhorn.ReplicaSchedulingPolicySpreadByReplicaCount),
+			string(longhorn.ReplicaSchedulingPolicyPreferFastDiskTag),
+		},
+	}
+
 	SettingDefinitionAllowEmptyNodeSelectorVolume = SettingDefinition{
 		DisplayName:        "Allow Scheduling Empty Node Selector Volumes To Any Node",
 		Description:        "Allow replica of the volume without node selector to be scheduled on node with tags, default true",
@@ -1950,6 +2105,14 @@ const (
 	NodeDrainPolicyAlwaysAllow                           = NodeDrainPolicy("always-allow")
 )
 
+type APIAccessMode string
+
+const (
+	APIAccessModeUnrestricted  = APIAccessMode("unrestricted")
+	APIAccessModeReadOnly      = APIAccessMode("read-only")
+	APIAccessModeAuthenticated = APIAccessMode("authenticated")
+)
+
 type SystemManagedPodsImagePullPolicy string
 
 const (
@@ -2680,6 +2843,11 @@ func validateSettingString(name SettingName, definition SettingDefinition, value
 				return errors.Wrapf(err, "the value of %v is invalid", name)
 			}
 
+		case SettingNameAuditLogWebhookURL:
+			if err := ValidateAuditLogWebhookURL(strValue); err != nil {
+				return errors.Wrapf(err, "the value of %v is invalid", name)
+			}
+
 		case SettingNameDataEngineLogFlags:
 			if err := ValidateDataEngineLogFlags(strValue); err != nil {
 				return errors.Wrapf(err, "failed to validate data engine log flags %v", strValue)
@@ -47,6 +47,8 @@ const (
 	LonghornKindSystemBackup        = "SystemBackup"
 	LonghornKindSystemRestore       = "SystemRestore"
 	LonghornKindOrphan              = "Orphan"
+	LonghornKindVolumeGroupSnapshot = "VolumeGroupSnapshot"
+	LonghornKindDRGroup             = "DRGroup"
 
 	LonghornKindBackingImageDataSource = "BackingImageDataSource"
 
@@ -153,6 +155,11 @@ const (
 	DeleteNodeFromLonghorn         = "delete-node-from-longhorn"
 
 	KubernetesStatusLabel = "KubernetesStatus"
+	// the filesystem type and the CSI secret of the PV are carried by the backups of the DR group member volumes,
+	// so that the secondary cluster can recreate the PV the same way
+	PVFSTypeLabel          = "PVFSType"
+	PVSecretNameLabel      = "PVSecretName"
+	PVSecretNamespaceLabel = "PVSecretNamespace"
 
 	RecurringJobLabel = "RecurringJob"
 
@@ -181,6 +188,11 @@ const (
 	LonghornLabelBackingImageDataSource     = "backing-image-data-source"
 	LonghornLabelBackupTarget               = "backup-target"
 	LonghornLabelBackupVolume               = "backup-volume"
+	LonghornLabelBackupRetentionLockedUntil = "backup-retention-locked-until"
+	LonghornLabelVolumeGroupSnapshot        = "volume-group-snapshot"
+	LonghornLabelDRGroup                    = "dr-group"
+	LonghornLabelReplicatedFromBackup       = "replicated-from-backup"
+	LonghornLabelBackupVerification         = "backup-verification"
 	LonghornLabelRecurringJob               = "job"
 	LonghornLabelRecurringJobGroup          = "job-group"
 	LonghornLabelRecurringJobSource         = "source"
@@ -208,6 +220,7 @@ const (
 
 	LonghornLabelExportFromVolume                 = "export-from-volume"
 	LonghornLabelSnapshotForExportingBackingImage = "for-exporting-backing-image"
+	LonghornLabelSnapshotForChecksumCheck         = "for-snapshot-checksum-check"
 
 	KubernetesFailureDomainRegionLabelKey = "failure-domain.beta.kubernetes.io/region"
 	KubernetesFailureDomainZoneLabelKey   = "failure-domain.beta.kubernetes.io/zone"
@@ -232,6 +245,20 @@ const (
 
 	PVAnnotationLonghornVolumeSchedulingError = "longhorn.io/volume-scheduling-error"
 
+	// The annotations of a workload pod declaring the commands executed in the pod before and after
+	// taking a snapshot of its Longhorn volumes. The format verb is the hook type "pre" or "post".
+	// The command is either a JSON array of strings, or a plain string executed by "/bin/sh -c".
+	PodAnnotationSnapshotHookCommandFmt   = "snapshot.longhorn.io/%s-hook-command"
+	PodAnnotationSnapshotHookContainerFmt = "snapshot.longhorn.io/%s-hook-container"
+	PodAnnotationSnapshotHookTimeoutFmt   = "snapshot.longhorn.io/%s-hook-timeout"
+	PodAnnotationSnapshotHookOnErrorFmt   = "snapshot.longhorn.io/%s-hook-on-error"
+
+	// SnapshotHookOnErrorFail fails the snapshot creation if the hook fails, while SnapshotHookOnErrorContinue ignores the failure
+	SnapshotHookOnErrorFail     = "Fail"
+	SnapshotHookOnErrorContinue = "Continue"
+
+	SnapshotHookDefaultTimeout = 30 * time.Second
+
 	CniNetworkNone           = ""
 	StorageNetworkInterface  = "lhnet1" // Data plane network
 	EndpointNetworkInterface = "lhnet2" // RWX volume nfs server endpoint
@@ -249,6 +276,16 @@ const (
 const (
 	RecurringJobParameterFullBackupInterval = "full-backup-interval"
 	RecurringJobParameterVolumeBackupPolicy = "volume-backup-policy"
+
+	RecurringJobParameterBackupVerifyPolicy          = "backup-verify-policy"
+	RecurringJobParameterBackupVerifyNode            = "backup-verify-node"
+	RecurringJobParameterBackupVerifyFilesystemCheck = "backup-verify-filesystem-check"
+)
+
+const (
+	BackupVerifyPolicyLatest = "latest"
+	BackupVerifyPolicyRandom = "random"
+	BackupVerifyPolicyOldest = "oldest"
 )
 
 const (
@@ -297,8 +334,11 @@ const (
 	OptionBaseImage           = "baseImage"
 	OptionFrontend            = "frontend"
 	OptionDiskSelector        = "diskSelector"
+	OptionDiskTiers           = "diskTiers"
 	OptionNodeSelector        = "nodeSelector"
 
+	OptionReplicaTopologySpread = "replicaTopologySpread"
+
 	// DefaultStaleReplicaTimeout in minutes. 48h by default
 	DefaultStaleReplicaTimeout = "2880"
 
@@ -622,6 +662,26 @@ func GetVolumeLabels(volumeName string) map[string]string {
 	}
 }
 
+// GetDRGroupLabels returns the labels of the member volumes of the DR group. The labels are carried by the backups
+// of the member volumes to the other clusters sharing the backup target.
+func GetDRGroupLabels(drGroupName string) map[string]string {
+	return map[string]string{
+		GetLonghornLabelKey(LonghornLabelDRGroup): drGroupName,
+	}
+}
+
+// GetVolumeGroupSnapshotLabels returns the labels of the member snapshots of the volume group snapshot
+func GetVolumeGroupSnapshotLabels(groupSnapshotName string) map[string]string {
+	return map[string]string{
+		GetLonghornLabelKey(LonghornLabelVolumeGroupSnapshot): groupSnapshotName,
+	}
+}
+
+// GetVolumeGroupSnapshotMemberName returns the name of the member snapshot of the volume in the volume group snapshot
+func GetVolumeGroupSnapshotMemberName(groupSnapshotName, volumeName string) string {
+	return groupSnapshotName + "-" + util.GetStringChecksum(volumeName)[:8]
+}
+
 func GetRecurringJobLabelKeyByType(name string, isGroup bool) string {
 	if isGroup {
 		return GetRecurringJobLabelKey(LonghornLabelRecurringJobGroup, name)
@@ -982,6 +1042,27 @@ func ValidateManagerURL(value string) error {
 	return nil
 }
 
+func ValidateAuditLogWebhookURL(value string) error {
+	if value == "" {
+		return nil // Empty is valid (disabled)
+	}
+
+	u, err := url.Parse(value)
+	if err != nil {
+		return errors.Wrapf(err, "invalid URL format")
+	}
+
+	if u.Scheme != "http" && u.Scheme != "https" {
+		return errors.Errorf("scheme must be http or https, got: %s", u.Scheme)
+	}
+
+	if u.Host == "" {
+		return errors.New("host is required")
+	}
+
+	return nil
+}
+
 func ValidateSnapshotDataIntegrity(mode string) error {
 	if mode != string(longhorn.SnapshotDataIntegrityDisabled) &&
 		mode != string(longhorn.SnapshotDataIntegrityEnabled) &&
@@ -1042,6 +1123,18 @@ func ValidateReplicaDiskSoftAntiAffinity(value longhorn.ReplicaDiskSoftAntiAffin
 	return nil
 }
 
+func ValidateReplicaSchedulingPolicy(value longhorn.ReplicaSchedulingPolicy) error {
+	if value != longhorn.ReplicaSchedulingPolicyDefault &&
+		value != longhorn.ReplicaSchedulingPolicyBalanced &&
+		value != longhorn.ReplicaSchedulingPolicyLeastAllocated &&
+		value != longhorn.ReplicaSchedulingPolicyMostAllocated &&
+		value != longhorn.ReplicaSchedulingPolicySpreadByReplicaCount &&
+		value != longhorn.ReplicaSchedulingPolicyPreferFastDiskTag {
+		return fmt.Errorf("invalid ReplicaSchedulingPolicy setting: %v", value)
+	}
+	return nil
+}
+
 func ValidateFreezeFilesystemForSnapshot(value longhorn.FreezeFilesystemForSnapshot) error {
 	if value != longhorn.FreezeFilesystemForSnapshotDefault &&
 		value != longhorn.FreezeFilesystemForSnapshotEnabled &&
@@ -1083,6 +1176,15 @@ func ValidateReplicaRebuildingBandwidthLimit(dataEengine longhorn.DataEngineType
 	return fmt.Errorf("replicaRebuildingBandwidthLimit is not supported for data engine %v", dataEengine)
 }
 
+func ValidateNFSMountOptions(options longhorn.NFSMountOptions) error {
+	switch options.NFSVersion {
+	case "", "4.1", "4.2":
+	default:
+		return fmt.Errorf("invalid NFS version %v: supported versions are 4.1 and 4.2", options.NFSVersion)
+	}
+	return nil
+}
+
 func GetDaemonSetNameFromEngineImageName(engineImageName string) string {
 	return "engine-image-" + engineImageName
 }
@@ -1386,6 +1488,117 @@ func IsSelectorsInTags(tags, selectors []string, allowEmptySelector bool) bool {
 	return true
 }
 
+// IsDiskTierInTiers returns true if the disk tier is one of the requested tiers or no tier is requested
+func IsDiskTierInTiers(tier longhorn.DiskTier, tiers []longhorn.DiskTier) bool {
+	if len(tiers) == 0 {
+		return true
+	}
+	for _, t := range tiers {
+		if t == tier {
+			return true
+		}
+	}
+	return false
+}
+
+// ValidateDiskTiers checks the requested disk tiers are known and not duplicated
+func ValidateDiskTiers(tiers []longhorn.DiskTier) error {
+	seen := map[longhorn.DiskTier]struct{}{}
+	for _, tier := range tiers {
+		switch tier {
+		case longhorn.DiskTierNvme, longhorn.DiskTierSSD, longhorn.DiskTierHDD:
+		default:
+			return fmt.Errorf("invalid disk tier %q, should be one of %v, %v or %v",
+				tier, longhorn.DiskTierNvme, longhorn.DiskTierSSD, longhorn.DiskTierHDD)
+		}
+		if _, ok := seen[tier]; ok {
+			return fmt.Errorf("duplicate disk tier %v", tier)
+		}
+		seen[tier] = struct{}{}
+	}
+	return nil
+}
+
+// ParseDiskTiers parses the comma separated disk tiers in the order of preference
+func ParseDiskTiers(value string) ([]longhorn.DiskTier, error) {
+	tiers := []longhorn.DiskTier{}
+	for _, tier := range strings.Split(value, ",") {
+		tier = strings.TrimSpace(tier)
+		if tier == "" {
+			continue
+		}
+		tiers = append(tiers, longhorn.DiskTier(tier))
+	}
+	if err := ValidateDiskTiers(tiers); err != nil {
+		return nil, err
+	}
+	return tiers, nil
+}
+
+// ValidateReplicaTopologySpread checks the replica topology spread constraints have known modes, positive max skews
+// and distinct topology keys
+func ValidateReplicaTopologySpread(constraints []longhorn.ReplicaTopologySpreadConstraint) error {
+	seen := map[string]struct{}{}
+	for _, constraint := range constraints {
+		if constraint.TopologyKey == "" {
+			return fmt.Errorf("topology key of replica topology spread constraint cannot be empty")
+		}
+		if _, ok := seen[constraint.TopologyKey]; ok {
+			return fmt.Errorf("duplicate topology key %v in replica topology spread constraints", constraint.TopologyKey)
+		}
+		seen[constraint.TopologyKey] = struct{}{}
+
+		switch constraint.Mode {
+		case longhorn.ReplicaTopologySpreadModeSoft, longhorn.ReplicaTopologySpreadModeHard:
+		default:
+			return fmt.Errorf("invalid mode %q of topology key %v, should be %v or %v",
+				constraint.Mode, constraint.TopologyKey, longhorn.ReplicaTopologySpreadModeSoft, longhorn.ReplicaTopologySpreadModeHard)
+		}
+		if constraint.MaxSkew < 1 {
+			return fmt.Errorf("invalid max skew %v of topology key %v, should be at least 1", constraint.MaxSkew, constraint.TopologyKey)
+		}
+	}
+	return nil
+}
+
+// ParseReplicaTopologySpread parses the comma separated replica topology spread constraints in the format of
+// <topology key>[:<soft|hard>[:<max skew>]], e.g. "rack:hard:1,power-domain". The mode defaults to soft and the
+// max skew defaults to 1.
+func ParseReplicaTopologySpread(value string) ([]longhorn.ReplicaTopologySpreadConstraint, error) {
+	constraints := []longhorn.ReplicaTopologySpreadConstraint{}
+	for _, item := range strings.Split(value, ",") {
+		item = strings.TrimSpace(item)
+		if item == "" {
+			continue
+		}
+
+		fields := strings.Split(item, ":")
+		if len(fields) > 3 {
+			return nil, fmt.Errorf("invalid replica topology spread constraint %v", item)
+		}
+		constraint := longhorn.ReplicaTopologySpreadConstraint{
+			TopologyKey: strings.TrimSpace(fields[0]),
+			Mode:        longhorn.ReplicaTopologySpreadModeSoft,
+			MaxSkew:     1,
+		}
+		if len(fields) > 1 {
+			constraint.Mode = longhorn.ReplicaTopologySpreadMode(strings.TrimSpace(fields[1]))
+		}
+		if len(fields) > 2 {
+			maxSkew, err := strconv.Atoi(strings.TrimSpace(fields[2]))
+			if err != nil {
+				return nil, errors.Wrapf(err, "invalid max skew of replica topology spread constraint %v", item)
+			}
+			constraint.MaxSkew = maxSkew
+		}
+		constraints = append(constraints, constraint)
+	}
+	if err := ValidateReplicaTopologySpread(constraints); err != nil {
+		return nil, err
+	}
+	return constraints, nil
+}
+
 func GetKubernetesProviderNameFromURL(providerURL string) string {
 	if providerURL == "" {
 		return ValueEmpty
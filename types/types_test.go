@@ -4,12 +4,15 @@ import (
 	"fmt"
 	"reflect"
 	"testing"
+	"time"
 
 	"github.com/sirupsen/logrus"
 
 	corev1 "k8s.io/api/core/v1"
 
 	. "gopkg.in/check.v1"
+
+	longhorn "github.com/longhorn/longhorn-manager/k8s/pkg/apis/longhorn/v1beta2"
 )
 
 const (
@@ -176,6 +179,62 @@ func (s *TestSuite) TestIsSelectorsInTags(c *C) {
 	}
 }
 
+func (s *TestSuite) TestParseReplicaTopologySpread(c *C) {
+	type testCase struct {
+		input string
+
+		expectError       bool
+		expectConstraints []longhorn.ReplicaTopologySpreadConstraint
+	}
+	testCases := map[string]testCase{
+		"empty": {
+			input:             "",
+			expectConstraints: []longhorn.ReplicaTopologySpreadConstraint{},
+		},
+		"defaults": {
+			input: "rack",
+			expectConstraints: []longhorn.ReplicaTopologySpreadConstraint{
+				{TopologyKey: "rack", Mode: longhorn.ReplicaTopologySpreadModeSoft, MaxSkew: 1},
+			},
+		},
+		"ordered keys": {
+			input: "rack:hard:2, power-domain:soft",
+			expectConstraints: []longhorn.ReplicaTopologySpreadConstraint{
+				{TopologyKey: "rack", Mode: longhorn.ReplicaTopologySpreadModeHard, MaxSkew: 2},
+				{TopologyKey: "power-domain", Mode: longhorn.ReplicaTopologySpreadModeSoft, MaxSkew: 1},
+			},
+		},
+		"invalid mode": {
+			input:       "rack:strict",
+			expectError: true,
+		},
+		"invalid max skew": {
+			input:       "rack:hard:0",
+			expectError: true,
+		},
+		"duplicate key": {
+			input:       "rack,rack:hard",
+			expectError: true,
+		},
+		"too many fields": {
+			input:       "rack:hard:1:1",
+			expectError: true,
+		},
+	}
+
+	for testName, testCase := range testCases {
+		fmt.Printf("testing %v\n", testName)
+
+		constraints, err := ParseReplicaTopologySpread(testCase.input)
+		if testCase.expectError {
+			c.Assert(err, NotNil, Commentf(TestErrResultFmt, testName))
+			continue
+		}
+		c.Assert(err, IsNil, Commentf(TestErrErrorFmt, testName, err))
+		c.Assert(constraints, DeepEquals, testCase.expectConstraints, Commentf(TestErrResultFmt, testName))
+	}
+}
+
 func (s *TestSuite) TestGenerateEngineNameForVolume(c *C) {
 	type testCase struct {
 		volumeName        string
@@ -313,3 +372,87 @@ func (s *TestSuite) TestValidateManagerURL(c *C) {
 		}
 	}
 }
+
+func (s *TestSuite) TestIsInBackupWindow(c *C) {
+	type testCase struct {
+		window string
+		now    time.Time
+
+		expectError     bool
+		expectInWindow  bool
+		expectUntilOpen time.Duration
+	}
+	at := func(hour, minute int) time.Time {
+		return time.Date(2024, 1, 1, hour, minute, 0, 0, time.UTC)
+	}
+	testCases := map[string]testCase{
+		"empty window": {
+			window:         "",
+			now:            at(12, 0),
+			expectInWindow: true,
+		},
+		"inside daytime window": {
+			window:         "09:00-17:00",
+			now:            at(12, 0),
+			expectInWindow: true,
+		},
+		"at the end of daytime window": {
+			window:          "09:00-17:00",
+			now:             at(17, 0),
+			expectInWindow:  false,
+			expectUntilOpen: 16 * time.Hour,
+		},
+		"before daytime window": {
+			window:          "09:00-17:00",
+			now:             at(8, 30),
+			expectInWindow:  false,
+			expectUntilOpen: 30 * time.Minute,
+		},
+		"inside overnight window before midnight": {
+			window:         "22:00-06:00",
+			now:            at(23, 0),
+			expectInWindow: true,
+		},
+		"inside overnight window after midnight": {
+			window:         "22:00-06:00",
+			now:            at(5, 59),
+			expectInWindow: true,
+		},
+		"outside overnight window": {
+			window:          "22:00-06:00",
+			now:             at(12, 0),
+			expectInWindow:  false,
+			expectUntilOpen: 10 * time.Hour,
+		},
+		"non-UTC time": {
+			window:         "22:00-06:00",
+			now:            time.Date(2024, 1, 1, 1, 0, 0, 0, time.FixedZone("UTC+3", 3*60*60)),
+			expectInWindow: true,
+		},
+		"missing end": {
+			window:      "22:00",
+			expectError: true,
+		},
+		"invalid time": {
+			window:      "25:00-06:00",
+			expectError: true,
+		},
+		"same start and end": {
+			window:      "06:00-06:00",
+			expectError: true,
+		},
+	}
+
+	for testName, testCase := range testCases {
+		fmt.Printf("testing %v\n", testName)
+
+		inWindow, untilOpen, err := IsInBackupWindow(testCase.window, testCase.now)
+		if testCase.expectError {
+			c.Assert(err, NotNil, Commentf("Expected error for test case: %s", testName))
+			continue
+		}
+		c.Assert(err, IsNil, Commentf(TestErrErrorFmt, testName, err))
+		c.Assert(inWindow, Equals, testCase.expectInWindow, Commentf(TestErrResultFmt, testName))
+		c.Assert(untilOpen, Equals, testCase.expectUntilOpen, Commentf(TestErrResultFmt, testName))
+	}
+}
@@ -0,0 +1,65 @@
+package util
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/cockroachdb/errors"
+
+	commonsys "github.com/longhorn/go-common-libs/sys"
+
+	longhorn "github.com/longhorn/longhorn-manager/k8s/pkg/apis/longhorn/v1beta2"
+)
+
+const (
+	SysClassBlockDirectory = "/sys/class/block"
+
+	nvmeDevicePrefix = "nvme"
+)
+
+// GetDiskTierFromMountPath detects the media class of the physical device backing the mount path.
+// This is used for V1 filesystem-type disks where the disk path is a mount point.
+func GetDiskTierFromMountPath(mountPath string) (longhorn.DiskTier, error) {
+	physicalDevice, err := resolveMountPathToPhysicalDeviceWithDeps(mountPath)
+	if err != nil {
+		return longhorn.DiskTierUnknown, err
+	}
+
+	return getDiskTierForDevice(physicalDevice, SysClassBlockDirectory)
+}
+
+// GetDiskTierForBlockDevice detects the media class of the physical device backing the block device.
+// This is used for V2 block-type disks using the AIO driver.
+func GetDiskTierForBlockDevice(devicePath string) (longhorn.DiskTier, error) {
+	physicalDevice, err := commonsys.ResolveBlockDeviceToPhysicalDevice(devicePath)
+	if err != nil {
+		return longhorn.DiskTierUnknown, err
+	}
+
+	return getDiskTierForDevice(physicalDevice, SysClassBlockDirectory)
+}
+
+// getDiskTierForDevice returns nvme for the NVMe devices, and otherwise tells SSD from HDD by the rotational
+// flag of the device queue in sysfs.
+func getDiskTierForDevice(devicePath, sysClassBlockDirectory string) (longhorn.DiskTier, error) {
+	deviceName := filepath.Base(devicePath)
+	if strings.HasPrefix(deviceName, nvmeDevicePrefix) {
+		return longhorn.DiskTierNvme, nil
+	}
+
+	rotationalPath := filepath.Join(sysClassBlockDirectory, deviceName, "queue", "rotational")
+	rotational, err := os.ReadFile(rotationalPath)
+	if err != nil {
+		return longhorn.DiskTierUnknown, errors.Wrapf(err, "failed to read %v", rotationalPath)
+	}
+
+	switch strings.TrimSpace(string(rotational)) {
+	case "0":
+		return longhorn.DiskTierSSD, nil
+	case "1":
+		return longhorn.DiskTierHDD, nil
+	default:
+		return longhorn.DiskTierUnknown, errors.Errorf("unknown rotational flag %q of device %v", strings.TrimSpace(string(rotational)), devicePath)
+	}
+}
@@ -0,0 +1,177 @@
+package util
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"os"
+	"strings"
+
+	"github.com/cockroachdb/errors"
+	"github.com/gorilla/websocket"
+
+	"k8s.io/client-go/kubernetes/scheme"
+	"k8s.io/client-go/rest"
+
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	clientset "k8s.io/client-go/kubernetes"
+)
+
+const (
+	// podExecProtocol is the channel based WebSocket subprotocol of the Kubernetes pod exec subresource.
+	// Each message is prefixed with the byte of the stream channel.
+	podExecProtocol = "v4.channel.k8s.io"
+
+	podExecChannelStdout = 1
+	podExecChannelStderr = 2
+	podExecChannelError  = 3
+)
+
+// PodExecutor executes commands in the containers of pods through the pod exec subresource of the Kubernetes API server
+type PodExecutor struct {
+	config     *rest.Config
+	kubeClient clientset.Interface
+}
+
+func NewPodExecutor(config *rest.Config, kubeClient clientset.Interface) *PodExecutor {
+	return &PodExecutor{
+		config:     config,
+		kubeClient: kubeClient,
+	}
+}
+
+// Exec runs the command in the container of the pod and waits for it to complete.
+// It returns the stdout and stderr of the command, and an error if the command cannot be run or exits with non-zero code.
+func (e *PodExecutor) Exec(ctx context.Context, namespace, podName, containerName string, command []string) (stdout, stderr string, err error) {
+	if e.config == nil {
+		return "", "", fmt.Errorf("cannot execute command in pod %v/%v without the Kubernetes client config", namespace, podName)
+	}
+
+	execURL := e.kubeClient.CoreV1().RESTClient().Post().
+		Namespace(namespace).
+		Resource("pods").
+		Name(podName).
+		SubResource("exec").
+		VersionedParams(&corev1.PodExecOptions{
+			Container: containerName,
+			Command:   command,
+			Stdout:    true,
+			Stderr:    true,
+		}, scheme.ParameterCodec).
+		URL()
+	switch execURL.Scheme {
+	case "https":
+		execURL.Scheme = "wss"
+	case "http":
+		execURL.Scheme = "ws"
+	}
+
+	tlsConfig, err := rest.TLSConfigFor(e.config)
+	if err != nil {
+		return "", "", errors.Wrap(err, "failed to get TLS config for pod exec")
+	}
+	header, err := e.getAuthHeader()
+	if err != nil {
+		return "", "", err
+	}
+
+	dialer := &websocket.Dialer{
+		Proxy:           http.ProxyFromEnvironment,
+		TLSClientConfig: tlsConfig,
+		Subprotocols:    []string{podExecProtocol},
+	}
+	conn, resp, err := dialer.DialContext(ctx, execURL.String(), header)
+	if err != nil {
+		if resp != nil {
+			return "", "", errors.Wrapf(err, "failed to connect to pod %v/%v for exec: %v", namespace, podName, resp.Status)
+		}
+		return "", "", errors.Wrapf(err, "failed to connect to pod %v/%v for exec", namespace, podName)
+	}
+	defer conn.Close()
+
+	// Unblock the reading when the context is done
+	done := make(chan struct{})
+	defer close(done)
+	go func() {
+		select {
+		case <-ctx.Done():
+			conn.Close()
+		case <-done:
+		}
+	}()
+
+	var stdoutBuf, stderrBuf, errorBuf bytes.Buffer
+	for {
+		_, message, err := conn.ReadMessage()
+		if err != nil {
+			if websocket.IsCloseError(err, websocket.CloseNormalClosure) {
+				break
+			}
+			if ctx.Err() != nil {
+				return stdoutBuf.String(), stderrBuf.String(), errors.Wrapf(ctx.Err(), "failed to wait for command in pod %v/%v", namespace, podName)
+			}
+			return stdoutBuf.String(), stderrBuf.String(), errors.Wrapf(err, "failed to read command output from pod %v/%v", namespace, podName)
+		}
+		if len(message) == 0 {
+			continue
+		}
+		switch message[0] {
+		case podExecChannelStdout:
+			stdoutBuf.Write(message[1:])
+		case podExecChannelStderr:
+			stderrBuf.Write(message[1:])
+		case podExecChannelError:
+			errorBuf.Write(message[1:])
+		}
+	}
+
+	return stdoutBuf.String(), stderrBuf.String(), parsePodExecStatus(errorBuf.Bytes())
+}
+
+func (e *PodExecutor) getAuthHeader() (http.Header, error) {
+	header := http.Header{}
+
+	token := e.config.BearerToken
+	if e.config.BearerTokenFile != "" {
+		data, err := os.ReadFile(e.config.BearerTokenFile)
+		if err != nil {
+			return nil, errors.Wrapf(err, "failed to read bearer token file %v", e.config.BearerTokenFile)
+		}
+		token = strings.TrimSpace(string(data))
+	}
+	if token != "" {
+		header.Set("Authorization", "Bearer "+token)
+	} else if e.config.Username != "" {
+		req := &http.Request{Header: header}
+		req.SetBasicAuth(e.config.Username, e.config.Password)
+	}
+
+	return header, nil
+}
+
+// parsePodExecStatus converts the status written to the error channel into an error
+func parsePodExecStatus(data []byte) error {
+	if len(data) == 0 {
+		return nil
+	}
+
+	status := &metav1.Status{}
+	if err := json.Unmarshal(data, status); err != nil {
+		return fmt.Errorf("failed to execute command: %v", string(data))
+	}
+	if status.Status == metav1.StatusSuccess {
+		return nil
+	}
+
+	if status.Reason == "NonZeroExitCode" && status.Details != nil {
+		for _, cause := range status.Details.Causes {
+			if cause.Type == "ExitCode" {
+				return fmt.Errorf("command exited with code %v", cause.Message)
+			}
+		}
+	}
+	return fmt.Errorf("failed to execute command: %v", status.Message)
+}
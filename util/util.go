@@ -71,6 +71,8 @@ const (
 
 	DiskConfigFile = "longhorn-disk.cfg"
 
+	ServiceAccountTokenFile = "/var/run/secrets/kubernetes.io/serviceaccount/token"
+
 	SizeAlignment        = 2 * MiB
 	MinimalVolumeSize    = 10 * MiB
 	MinimalVolumeSizeXFS = 300 * MiB // See https://github.com/longhorn/longhorn/issues/8488
@@ -397,6 +399,16 @@ func AutoCorrectName(name string, maxLength int) string {
 	return newName
 }
 
+// GetServiceAccountToken returns the token of the pod service account, or an empty string if the token is not mounted.
+// The Longhorn API clients pass it as the basic authentication password for the api-access-mode setting.
+func GetServiceAccountToken() string {
+	data, err := os.ReadFile(ServiceAccountTokenFile)
+	if err != nil {
+		return ""
+	}
+	return strings.TrimSpace(string(data))
+}
+
 func GetStringChecksum(data string) string {
 	return GetChecksumSHA512([]byte(data))
 }
@@ -705,6 +717,49 @@ func GetPossibleReplicaDirectoryNames(diskPath string) (replicaDirectoryNames ma
 	return replicaDirectoryNames, nil
 }
 
+// GetReplicaDirectoryActualSize returns the space allocated on the host by the files in the replica directory.
+// The snapshot and head files are sparse, so the allocated blocks are counted instead of the file sizes.
+func GetReplicaDirectoryActualSize(diskPath, replicaDirectoryName string) (actualSize int64, err error) {
+	defer func() {
+		err = errors.Wrapf(err, "cannot get the actual size of replica directory %v in the disk %v", replicaDirectoryName, diskPath)
+	}()
+
+	path := filepath.Join(diskPath, "replicas", replicaDirectoryName)
+	fn := func() (interface{}, error) {
+		files, err := os.ReadDir(path)
+		if err != nil {
+			return int64(0), err
+		}
+		size := int64(0)
+		for _, file := range files {
+			if file.IsDir() {
+				continue
+			}
+			info, err := file.Info()
+			if err != nil {
+				if os.IsNotExist(err) {
+					continue
+				}
+				return int64(0), err
+			}
+			if stat, ok := info.Sys().(*syscall.Stat_t); ok {
+				size += stat.Blocks * 512
+			}
+		}
+		return size, nil
+	}
+
+	rawResult, err := lhns.RunFunc(fn, 0)
+	if err != nil {
+		return 0, err
+	}
+	actualSize, ok := rawResult.(int64)
+	if !ok {
+		return 0, errors.Errorf(lhtypes.ErrNamespaceCastResultFmt, actualSize, rawResult)
+	}
+	return actualSize, nil
+}
+
 type VolumeMeta struct {
 	Size            int64
 	Head            string
@@ -766,6 +821,29 @@ func TrimFilesystem(volumeName string, encryptedDevice bool) error {
 	return nil
 }
 
+// CheckFilesystem runs a read-only filesystem check on the block device of the volume on the host.
+// The volume should not be mounted. The device of an encrypted volume only holds the LUKS header and the encrypted
+// data, so it cannot be checked without opening the volume first.
+func CheckFilesystem(volumeName string) (err error) {
+	defer func() {
+		err = errors.Wrapf(err, "failed to check filesystem for Volume %v", volumeName)
+	}()
+
+	devicePath := RegularDeviceDirectory + volumeName
+
+	namespaces := []lhtypes.Namespace{lhtypes.NamespaceMnt}
+	nsexec, err := lhns.NewNamespaceExecutor(lhtypes.ProcessNone, lhtypes.HostProcDirectory, namespaces)
+	if err != nil {
+		return err
+	}
+
+	if _, err = nsexec.Execute(nil, "fsck", []string{"-n", devicePath}, time.Hour); err != nil {
+		return errors.Wrapf(err, "filesystem check on device %v failed", devicePath)
+	}
+
+	return nil
+}
+
 func getValidMountPoint(volumeName, procDir string, encryptedDevice bool) (string, error) {
 	procMountsPath := filepath.Join(procDir, "1", "mounts")
 	content, err := lhio.ReadFileContent(procMountsPath)
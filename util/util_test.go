@@ -17,6 +17,8 @@ import (
 	lhtypes "github.com/longhorn/go-common-libs/types"
 
 	"github.com/longhorn/longhorn-manager/util/fake"
+
+	longhorn "github.com/longhorn/longhorn-manager/k8s/pkg/apis/longhorn/v1beta2"
 )
 
 const (
@@ -212,3 +214,38 @@ func TestTimestampAfterTimestamp(t *testing.T) {
 		})
 	}
 }
+
+func TestGetDiskTierForDevice(t *testing.T) {
+	sysClassBlockDirectory := t.TempDir()
+	for device, rotational := range map[string]string{"sda": "1\n", "sdb": "0\n", "sdc": "2\n"} {
+		queueDirectory := filepath.Join(sysClassBlockDirectory, device, "queue")
+		require.NoError(t, os.MkdirAll(queueDirectory, 0755))
+		require.NoError(t, os.WriteFile(filepath.Join(queueDirectory, "rotational"), []byte(rotational), 0644))
+	}
+
+	tests := map[string]struct {
+		devicePath string
+		want       longhorn.DiskTier
+		wantErr    bool
+	}{
+		"nvmeController":    {"/dev/nvme0", longhorn.DiskTierNvme, false},
+		"nvmeNamespace":     {"/dev/nvme0n1", longhorn.DiskTierNvme, false},
+		"rotational":        {"/dev/sda", longhorn.DiskTierHDD, false},
+		"nonRotational":     {"/dev/sdb", longhorn.DiskTierSSD, false},
+		"unknownRotational": {"/dev/sdc", longhorn.DiskTierUnknown, true},
+		"missingDevice":     {"/dev/vda", longhorn.DiskTierUnknown, true},
+	}
+
+	assert := assert.New(t)
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			got, err := getDiskTierForDevice(tc.devicePath, sysClassBlockDirectory)
+			assert.Equal(tc.want, got)
+			if tc.wantErr {
+				assert.Error(err)
+			} else {
+				assert.NoError(err)
+			}
+		})
+	}
+}
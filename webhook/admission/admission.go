@@ -11,6 +11,9 @@ import (
 
 	admissionv1 "k8s.io/api/admission/v1"
 
+	"github.com/longhorn/longhorn-manager/audit"
+
+	longhorn "github.com/longhorn/longhorn-manager/k8s/pkg/apis/longhorn/v1beta2"
 	werror "github.com/longhorn/longhorn-manager/webhook/error"
 )
 
@@ -51,16 +54,18 @@ type Admitter interface {
 type Handler struct {
 	admitter      Admitter
 	admissionType string
+	auditLogger   *audit.Logger
 	logger        logrus.FieldLogger
 }
 
-func NewHandler(admitter Admitter, admissionType string) *Handler {
+func NewHandler(admitter Admitter, admissionType string, auditLogger *audit.Logger) *Handler {
 	if err := admitter.Resource().Validate(); err != nil {
 		panic(err.Error())
 	}
 	return &Handler{
 		admitter:      admitter,
 		admissionType: admissionType,
+		auditLogger:   auditLogger,
 		logger:        logrus.StandardLogger().WithField("service", "admissionWebhook"),
 	}
 }
@@ -104,6 +109,7 @@ func (v *Handler) admit(response *webhook.Response, req *Request) {
 		response.Allowed = false
 		response.Result = admitErr.AsResult()
 		v.logger.WithError(admitErr).Warnf("Rejected operation: %s", req)
+		v.recordAudit(req, oldObj, newObj, &admitErr)
 		return
 	}
 
@@ -116,4 +122,34 @@ func (v *Handler) admit(response *webhook.Response, req *Request) {
 	}
 
 	response.Allowed = true
+	v.recordAudit(req, oldObj, newObj, nil)
+}
+
+func (v *Handler) recordAudit(req *Request, oldObj, newObj runtime.Object, admitErr *werror.AdmitError) {
+	if v.auditLogger == nil || v.auditLogger.IsLonghornServiceAccount(req.UserInfo.Username) {
+		return
+	}
+
+	event := &audit.Event{
+		Source:    audit.SourceAdmission,
+		User:      req.UserInfo.Username,
+		Groups:    req.UserInfo.Groups,
+		Resource:  req.Resource.Resource,
+		Namespace: req.Namespace,
+		Name:      req.Name,
+		Action:    strings.ToLower(string(req.Operation)),
+		Result:    audit.ResultAllowed,
+	}
+	if admitErr != nil {
+		event.Result = audit.ResultDenied
+		event.StatusCode = int(admitErr.AsResult().Code)
+		event.Message = admitErr.Error()
+	}
+	if setting, ok := oldObj.(*longhorn.Setting); ok {
+		event.OldValue = &setting.Value
+	}
+	if setting, ok := newObj.(*longhorn.Setting); ok {
+		event.NewValue = &setting.Value
+	}
+	v.auditLogger.Record(event)
 }
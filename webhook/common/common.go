@@ -9,9 +9,12 @@ import (
 	"k8s.io/apimachinery/pkg/api/meta"
 	"k8s.io/apimachinery/pkg/runtime"
 
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+
 	"github.com/longhorn/longhorn-manager/datastore"
 	"github.com/longhorn/longhorn-manager/types"
 	"github.com/longhorn/longhorn-manager/util"
+	"github.com/longhorn/longhorn-manager/webhook/admission"
 
 	longhorn "github.com/longhorn/longhorn-manager/k8s/pkg/apis/longhorn/v1beta2"
 	werror "github.com/longhorn/longhorn-manager/webhook/error"
@@ -110,6 +113,42 @@ func ValidateRequiredDataEngineEnabled(ds *datastore.DataStore, dataEngine longh
 	return nil
 }
 
+// IsLonghornServiceAccount returns true if the user is the service account of the Longhorn manager pods.
+func IsLonghornServiceAccount(ds *datastore.DataStore, username string) (bool, error) {
+	managerPods, err := ds.ListManagerPodsRO()
+	if err != nil {
+		return false, errors.Wrap(err, "failed to list manager pods")
+	}
+
+	for _, pod := range managerPods {
+		if pod.Spec.ServiceAccountName == "" {
+			continue
+		}
+		if username == fmt.Sprintf("system:serviceaccount:%v:%v", pod.Namespace, pod.Spec.ServiceAccountName) {
+			return true, nil
+		}
+	}
+	return false, nil
+}
+
+// IsDeletedWithBackupTargetByLonghorn returns true if the request is made by Longhorn while the backup target is being
+// deleted or is already gone.
+func IsDeletedWithBackupTargetByLonghorn(ds *datastore.DataStore, request *admission.Request, backupTargetName string) (bool, error) {
+	backupTarget, err := ds.GetBackupTargetRO(backupTargetName)
+	if err != nil && !apierrors.IsNotFound(err) {
+		return false, errors.Wrapf(err, "failed to get backup target %v", backupTargetName)
+	}
+	if err == nil && backupTarget.DeletionTimestamp.IsZero() {
+		return false, nil
+	}
+
+	isLonghornServiceAccount, err := IsLonghornServiceAccount(ds, request.UserInfo.Username)
+	if err != nil {
+		return false, errors.Wrapf(err, "failed to check user %v", request.UserInfo.Username)
+	}
+	return isLonghornServiceAccount, nil
+}
+
 func IsRemovingLonghornFinalizer(oldObj runtime.Object, newObj runtime.Object) (bool, error) {
 	oldMeta, err := meta.Accessor(oldObj)
 	if err != nil {
@@ -3,11 +3,32 @@ package common
 import (
 	"testing"
 
+	"github.com/rancher/wrangler/v3/pkg/webhook"
 	"github.com/stretchr/testify/assert"
 	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime"
 
+	admissionv1 "k8s.io/api/admission/v1"
+	authenticationv1 "k8s.io/api/authentication/v1"
+	corev1 "k8s.io/api/core/v1"
+	apiextensionsfake "k8s.io/apiextensions-apiserver/pkg/client/clientset/clientset/fake"
+	kubefake "k8s.io/client-go/kubernetes/fake"
+
+	"github.com/longhorn/longhorn-manager/datastore"
+	"github.com/longhorn/longhorn-manager/types"
+	"github.com/longhorn/longhorn-manager/util"
+	"github.com/longhorn/longhorn-manager/webhook/admission"
+
 	longhorn "github.com/longhorn/longhorn-manager/k8s/pkg/apis/longhorn/v1beta2"
+	lhfake "github.com/longhorn/longhorn-manager/k8s/pkg/client/clientset/versioned/fake"
+)
+
+const (
+	testNamespace          = "longhorn-system"
+	testServiceAccount     = "longhorn-service-account"
+	testBackupTarget       = "default"
+	testLonghornUser       = "system:serviceaccount:" + testNamespace + ":" + testServiceAccount
+	testOtherNamespaceUser = "system:serviceaccount:default:" + testServiceAccount
 )
 
 func TestIsRemovingLonghornFinalizer(t *testing.T) {
@@ -119,3 +140,120 @@ func TestIsRemovingLonghornFinalizer(t *testing.T) {
 		})
 	}
 }
+
+func newTestDataStore(t *testing.T, backupTarget *longhorn.BackupTarget) *datastore.DataStore {
+	kubeClient := kubefake.NewSimpleClientset()                // nolint: staticcheck
+	lhClient := lhfake.NewSimpleClientset()                    // nolint: staticcheck
+	extensionsClient := apiextensionsfake.NewSimpleClientset() // nolint: staticcheck
+	informerFactories := util.NewInformerFactories(testNamespace, kubeClient, lhClient, 0)
+
+	managerPod := &corev1.Pod{
+		ObjectMeta: v1.ObjectMeta{
+			Name:      "longhorn-manager",
+			Namespace: testNamespace,
+			Labels:    types.GetManagerLabels(),
+		},
+		Spec: corev1.PodSpec{
+			ServiceAccountName: testServiceAccount,
+		},
+	}
+	err := informerFactories.KubeInformerFactory.Core().V1().Pods().Informer().GetIndexer().Add(managerPod)
+	assert.NoError(t, err)
+
+	if backupTarget != nil {
+		err = informerFactories.LhInformerFactory.Longhorn().V1beta2().BackupTargets().Informer().GetIndexer().Add(backupTarget)
+		assert.NoError(t, err)
+	}
+
+	return datastore.NewDataStore(testNamespace, lhClient, kubeClient, extensionsClient, informerFactories)
+}
+
+func TestIsLonghornServiceAccount(t *testing.T) {
+	assert := assert.New(t)
+	ds := newTestDataStore(t, nil)
+
+	tests := map[string]struct {
+		username string
+		want     bool
+	}{
+		"longhornServiceAccount": {
+			username: testLonghornUser,
+			want:     true,
+		},
+		"serviceAccountInOtherNamespace": {
+			username: testOtherNamespaceUser,
+			want:     false,
+		},
+		"otherServiceAccountInLonghornNamespace": {
+			username: "system:serviceaccount:" + testNamespace + ":default",
+			want:     false,
+		},
+		"user": {
+			username: "kubernetes-admin",
+			want:     false,
+		},
+	}
+
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			got, err := IsLonghornServiceAccount(ds, tc.username)
+			assert.NoError(err)
+			assert.Equal(tc.want, got)
+		})
+	}
+}
+
+func TestIsDeletedWithBackupTargetByLonghorn(t *testing.T) {
+	assert := assert.New(t)
+	now := v1.Now()
+
+	tests := map[string]struct {
+		backupTarget *longhorn.BackupTarget
+		username     string
+		want         bool
+	}{
+		"backupTargetInUseByLonghorn": {
+			backupTarget: &longhorn.BackupTarget{
+				ObjectMeta: v1.ObjectMeta{Name: testBackupTarget, Namespace: testNamespace},
+			},
+			username: testLonghornUser,
+			want:     false,
+		},
+		"backupTargetBeingDeletedByLonghorn": {
+			backupTarget: &longhorn.BackupTarget{
+				ObjectMeta: v1.ObjectMeta{Name: testBackupTarget, Namespace: testNamespace, DeletionTimestamp: &now},
+			},
+			username: testLonghornUser,
+			want:     true,
+		},
+		"backupTargetBeingDeletedByUser": {
+			backupTarget: &longhorn.BackupTarget{
+				ObjectMeta: v1.ObjectMeta{Name: testBackupTarget, Namespace: testNamespace, DeletionTimestamp: &now},
+			},
+			username: "kubernetes-admin",
+			want:     false,
+		},
+		"backupTargetGoneByLonghorn": {
+			username: testLonghornUser,
+			want:     true,
+		},
+		"backupTargetGoneByUser": {
+			username: testOtherNamespaceUser,
+			want:     false,
+		},
+	}
+
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			ds := newTestDataStore(t, tc.backupTarget)
+			request := admission.NewRequest(&webhook.Request{
+				AdmissionRequest: admissionv1.AdmissionRequest{
+					UserInfo: authenticationv1.UserInfo{Username: tc.username},
+				},
+			})
+			got, err := IsDeletedWithBackupTargetByLonghorn(ds, request, testBackupTarget)
+			assert.NoError(err)
+			assert.Equal(tc.want, got)
+		})
+	}
+}
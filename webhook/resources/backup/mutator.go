@@ -11,6 +11,7 @@ import (
 
 	"github.com/longhorn/longhorn-manager/datastore"
 	"github.com/longhorn/longhorn-manager/types"
+	"github.com/longhorn/longhorn-manager/util"
 	"github.com/longhorn/longhorn-manager/webhook/admission"
 	"github.com/longhorn/longhorn-manager/webhook/common"
 
@@ -98,6 +99,30 @@ func (b *backupMutator) Create(request *admission.Request, newObj runtime.Object
 		specLabels[types.GetLonghornLabelKey(types.LonghornLabelVolumeAccessMode)] = string(volumeAccessMode)
 	}
 
+	backupTargetName, ok := backup.Labels[types.LonghornLabelBackupTarget]
+	if !ok {
+		volume, err := b.ds.GetVolumeRO(volumeName)
+		if err != nil {
+			err := errors.Wrapf(err, "failed to get the volume %v of backup %v", volumeName, backup.Name)
+			return nil, werror.NewInvalidError(err.Error(), "")
+		}
+		backupTargetName = volume.Spec.BackupTargetName
+	}
+
+	// Record the retention lock of the new backup in the backup labels, so it is kept in the backup target along with the backup.
+	// The backups pulled from the backup target already have the label if they are locked.
+	retentionLockLabelKey := types.GetLonghornLabelKey(types.LonghornLabelBackupRetentionLockedUntil)
+	if _, isExist := specLabels[retentionLockLabelKey]; !isExist && backup.Spec.SnapshotName != "" {
+		backupTarget, err := b.ds.GetBackupTargetRO(backupTargetName)
+		if err != nil {
+			err := errors.Wrapf(err, "failed to get the backup target %v of backup %v", backupTargetName, backup.Name)
+			return nil, werror.NewInvalidError(err.Error(), "")
+		}
+		if backupTarget.Spec.RetentionLockPeriod.Duration > 0 {
+			specLabels[retentionLockLabelKey] = util.TimestampAfterDuration(backupTarget.Spec.RetentionLockPeriod.Duration)
+		}
+	}
+
 	valueBackupLabels, err := json.Marshal(specLabels)
 	if err != nil {
 		return nil, werror.NewInvalidError(errors.Wrapf(err, "failed to convert backup labels into JSON string").Error(), "")
@@ -117,16 +142,6 @@ func (b *backupMutator) Create(request *admission.Request, newObj runtime.Object
 		}
 	}
 
-	backupTargetName, ok := backup.Labels[types.LonghornLabelBackupTarget]
-	if !ok {
-		volume, err := b.ds.GetVolumeRO(volumeName)
-		if err != nil {
-			err := errors.Wrapf(err, "failed to get the volume %v of backup %v", volumeName, backup.Name)
-			return nil, werror.NewInvalidError(err.Error(), "")
-		}
-		backupTargetName = volume.Spec.BackupTargetName
-	}
-
 	metaLabels[types.LonghornLabelBackupTarget] = backupTargetName
 
 	patchOp, err := common.GetLonghornLabelsPatchOp(backup, metaLabels, nil)
@@ -12,6 +12,7 @@ import (
 	"github.com/longhorn/longhorn-manager/types"
 	"github.com/longhorn/longhorn-manager/util"
 	"github.com/longhorn/longhorn-manager/webhook/admission"
+	"github.com/longhorn/longhorn-manager/webhook/common"
 
 	longhorn "github.com/longhorn/longhorn-manager/k8s/pkg/apis/longhorn/v1beta2"
 	werror "github.com/longhorn/longhorn-manager/webhook/error"
@@ -36,6 +37,7 @@ func (b *backupValidator) Resource() admission.Resource {
 		OperationTypes: []admissionregv1.OperationType{
 			admissionregv1.Create,
 			admissionregv1.Update,
+			admissionregv1.Delete,
 		},
 	}
 }
@@ -94,7 +96,11 @@ func (b *backupValidator) Create(request *admission.Request, newObj runtime.Obje
 		//check if volume backup target matches labelbackup target
 		volumeBackupTargetName := volume.Spec.BackupTargetName
 		if volumeBackupTargetName != backupTargetName {
-			return werror.NewInvalidError(fmt.Sprintf("volume backup target %s and label backup target %s does not match", volumeBackupTargetName, backupTargetName), "")
+			// A copy of a backup replicated to the replication target of the volume backup target is allowed
+			if _, isReplicated := backup.Spec.Labels[types.GetLonghornLabelKey(types.LonghornLabelReplicatedFromBackup)]; !isReplicated ||
+				!b.isReplicationTargetOf(backupTargetName, volumeBackupTargetName) {
+				return werror.NewInvalidError(fmt.Sprintf("volume backup target %s and label backup target %s does not match", volumeBackupTargetName, backupTargetName), "")
+			}
 		}
 	}
 
@@ -109,6 +115,14 @@ func (b *backupValidator) Create(request *admission.Request, newObj runtime.Obje
 	return nil
 }
 
+func (b *backupValidator) isReplicationTargetOf(replicationTargetName, backupTargetName string) bool {
+	backupTarget, err := b.ds.GetBackupTargetRO(backupTargetName)
+	if err != nil {
+		return false
+	}
+	return backupTarget.Spec.ReplicationTargetName == replicationTargetName
+}
+
 func (b *backupValidator) Update(request *admission.Request, oldObj runtime.Object, newObj runtime.Object) error {
 	oldBackup, ok := oldObj.(*longhorn.Backup)
 	if !ok {
@@ -131,9 +145,42 @@ func (b *backupValidator) Update(request *admission.Request, oldObj runtime.Obje
 		}
 	}
 
+	retentionLockLabelKey := types.GetLonghornLabelKey(types.LonghornLabelBackupRetentionLockedUntil)
+	if oldLockedUntil, ok := oldBackup.Spec.Labels[retentionLockLabelKey]; ok && newBackup.Spec.Labels[retentionLockLabelKey] != oldLockedUntil {
+		err := fmt.Errorf("changing label %v for backup %v is not supported", retentionLockLabelKey, oldBackup.Name)
+		return werror.NewInvalidError(err.Error(), "")
+	}
+
 	return nil
 }
 
+func (b *backupValidator) Delete(request *admission.Request, oldObj runtime.Object) error {
+	backup, ok := oldObj.(*longhorn.Backup)
+	if !ok {
+		return werror.NewInvalidError(fmt.Sprintf("%v is not a *longhorn.Backup", oldObj), "")
+	}
+
+	if !datastore.IsBackupRetentionLocked(backup) {
+		return nil
+	}
+
+	// Longhorn removes the custom resources of the backups along with the backup target, and the backups are kept in the backup target
+	backupTargetName := backup.Status.BackupTargetName
+	if backupTargetName == "" {
+		backupTargetName = backup.Labels[types.LonghornLabelBackupTarget]
+	}
+	isDeletedByLonghorn, err := common.IsDeletedWithBackupTargetByLonghorn(b.ds, request, backupTargetName)
+	if err != nil {
+		return werror.NewInvalidError(err.Error(), "")
+	}
+	if isDeletedByLonghorn {
+		return nil
+	}
+
+	return werror.NewInvalidError(fmt.Sprintf("cannot delete backup %v since it is retention locked until %v",
+		backup.Name, util.FormatTimeZ(backup.Status.RetentionLockedUntil.Time)), "")
+}
+
 func (b *backupValidator) validateBackupBlockSize(backup *longhorn.Backup, allowInvalid bool) error {
 	// types.BackupBlockSizeInvalid indicates the block size information is unavailable. This broken backup exists but is unable to restore a volume.
 	if allowInvalid && backup.Spec.BackupBlockSize == types.BackupBlockSizeInvalid {
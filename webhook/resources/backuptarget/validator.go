@@ -67,6 +67,18 @@ func (b *backupTargetValidator) Create(request *admission.Request, newObj runtim
 		return werror.NewInvalidError(err.Error(), "")
 	}
 
+	if backupTarget.Spec.RetentionLockPeriod.Duration < 0 {
+		return werror.NewInvalidError(fmt.Sprintf("invalid retention lock period %v", backupTarget.Spec.RetentionLockPeriod.Duration), "")
+	}
+
+	if err := validateBackupWindow(backupTarget); err != nil {
+		return werror.NewInvalidError(err.Error(), "spec.backupWindow")
+	}
+
+	if err := b.validateReplicationTarget(backupTarget); err != nil {
+		return werror.NewInvalidError(err.Error(), "")
+	}
+
 	return nil
 }
 
@@ -119,6 +131,48 @@ func (b *backupTargetValidator) Update(request *admission.Request, oldObj runtim
 		}
 	}
 
+	if newBackupTarget.Spec.RetentionLockPeriod.Duration < 0 {
+		return werror.NewInvalidError(fmt.Sprintf("invalid retention lock period %v", newBackupTarget.Spec.RetentionLockPeriod.Duration), "")
+	}
+	// Shortening the period would unlock the backups taken after it was set, so it can only be extended.
+	if newBackupTarget.Spec.RetentionLockPeriod.Duration < oldBackupTarget.Spec.RetentionLockPeriod.Duration {
+		return werror.NewInvalidError(fmt.Sprintf("cannot decrease retention lock period from %v to %v", oldBackupTarget.Spec.RetentionLockPeriod.Duration, newBackupTarget.Spec.RetentionLockPeriod.Duration), "")
+	}
+
+	if err := validateBackupWindow(newBackupTarget); err != nil {
+		return werror.NewInvalidError(err.Error(), "spec.backupWindow")
+	}
+
+	if urlChanged || oldBackupTarget.Spec.ReplicationTargetName != newBackupTarget.Spec.ReplicationTargetName {
+		if err := b.validateReplicationTarget(newBackupTarget); err != nil {
+			return werror.NewInvalidError(err.Error(), "")
+		}
+	}
+
+	return nil
+}
+
+// validateReplicationTarget checks the replication target is another existing backup target with a different URL,
+// which does not replicate its backups back to the backup target.
+func (b *backupTargetValidator) validateReplicationTarget(backupTarget *longhorn.BackupTarget) error {
+	replicationTargetName := backupTarget.Spec.ReplicationTargetName
+	if replicationTargetName == "" {
+		return nil
+	}
+	if replicationTargetName == backupTarget.Name {
+		return fmt.Errorf("backup target %v cannot be the replication target of itself", backupTarget.Name)
+	}
+
+	replicationTarget, err := b.ds.GetBackupTargetRO(replicationTargetName)
+	if err != nil {
+		return errors.Wrapf(err, "failed to get replication target %v", replicationTargetName)
+	}
+	if replicationTarget.Spec.BackupTargetURL != "" && replicationTarget.Spec.BackupTargetURL == backupTarget.Spec.BackupTargetURL {
+		return fmt.Errorf("replication target %v has the same URL as backup target %v", replicationTargetName, backupTarget.Name)
+	}
+	if replicationTarget.Spec.ReplicationTargetName == backupTarget.Name {
+		return fmt.Errorf("replication target %v already replicates its backups to backup target %v", replicationTargetName, backupTarget.Name)
+	}
 	return nil
 }
 
@@ -218,5 +272,26 @@ func (b *backupTargetValidator) Delete(request *admission.Request, oldObj runtim
 		return werror.NewInvalidError(err.Error(), "")
 	}
 
+	// All the backup targets are deleted by Longhorn during uninstalling.
+	if _, exists := backupTarget.Annotations[types.GetLonghornLabelKey(types.DeleteBackupTargetFromLonghorn)]; !exists {
+		backupTargets, err := b.ds.ListBackupTargetsRO()
+		if err != nil {
+			return werror.NewInternalError(fmt.Sprintf("failed to list backup targets: %v", err))
+		}
+		for _, bt := range backupTargets {
+			if bt.Spec.ReplicationTargetName == backupTarget.Name {
+				return werror.NewInvalidError(fmt.Sprintf("backup target %v is the replication target of backup target %v", backupTarget.Name, bt.Name), "")
+			}
+		}
+	}
+
 	return nil
 }
+
+func validateBackupWindow(backupTarget *longhorn.BackupTarget) error {
+	if backupTarget.Spec.BackupWindow == "" {
+		return nil
+	}
+	_, _, err := types.ParseBackupWindow(backupTarget.Spec.BackupWindow)
+	return err
+}
@@ -9,6 +9,7 @@ import (
 
 	"github.com/longhorn/longhorn-manager/datastore"
 	"github.com/longhorn/longhorn-manager/webhook/admission"
+	"github.com/longhorn/longhorn-manager/webhook/common"
 
 	longhorn "github.com/longhorn/longhorn-manager/k8s/pkg/apis/longhorn/v1beta2"
 	werror "github.com/longhorn/longhorn-manager/webhook/error"
@@ -33,6 +34,7 @@ func (bv *backupVolumeValidator) Resource() admission.Resource {
 		OperationTypes: []admissionregv1.OperationType{
 			admissionregv1.Create,
 			admissionregv1.Update,
+			admissionregv1.Delete,
 		},
 	}
 }
@@ -68,3 +70,37 @@ func (bv *backupVolumeValidator) Update(request *admission.Request, oldObj runti
 
 	return nil
 }
+
+func (bv *backupVolumeValidator) Delete(request *admission.Request, oldObj runtime.Object) error {
+	backupVolume, ok := oldObj.(*longhorn.BackupVolume)
+	if !ok {
+		return werror.NewInvalidError(fmt.Sprintf("%v is not a *longhorn.BackupVolume", oldObj), "")
+	}
+
+	backups, err := bv.ds.ListBackupsWithBackupVolumeName(backupVolume.Spec.BackupTargetName, backupVolume.Spec.VolumeName)
+	if err != nil {
+		return werror.NewInvalidError(fmt.Sprintf("failed to list backups of backup volume %v: %v", backupVolume.Name, err), "")
+	}
+	var lockedBackup *longhorn.Backup
+	for _, backup := range backups {
+		if datastore.IsBackupRetentionLocked(backup) {
+			lockedBackup = backup
+			break
+		}
+	}
+	if lockedBackup == nil {
+		return nil
+	}
+
+	// Longhorn removes the custom resources of the backup volumes along with the backup target, and the backup volumes are
+	// kept in the backup target
+	isDeletedByLonghorn, err := common.IsDeletedWithBackupTargetByLonghorn(bv.ds, request, backupVolume.Spec.BackupTargetName)
+	if err != nil {
+		return werror.NewInvalidError(err.Error(), "")
+	}
+	if isDeletedByLonghorn {
+		return nil
+	}
+
+	return werror.NewInvalidError(fmt.Sprintf("cannot delete backup volume %v since its backup %v is retention locked", backupVolume.Name, lockedBackup.Name), "")
+}
@@ -0,0 +1,97 @@
+package drgroup
+
+import (
+	"fmt"
+	"reflect"
+
+	admissionregv1 "k8s.io/api/admissionregistration/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+
+	"github.com/longhorn/longhorn-manager/datastore"
+	"github.com/longhorn/longhorn-manager/webhook/admission"
+
+	longhorn "github.com/longhorn/longhorn-manager/k8s/pkg/apis/longhorn/v1beta2"
+	werror "github.com/longhorn/longhorn-manager/webhook/error"
+)
+
+type drGroupValidator struct {
+	admission.DefaultValidator
+	ds *datastore.DataStore
+}
+
+func NewValidator(ds *datastore.DataStore) admission.Validator {
+	return &drGroupValidator{ds: ds}
+}
+
+func (v *drGroupValidator) Resource() admission.Resource {
+	return admission.Resource{
+		Name:       "drgroups",
+		Scope:      admissionregv1.NamespacedScope,
+		APIGroup:   longhorn.SchemeGroupVersion.Group,
+		APIVersion: longhorn.SchemeGroupVersion.Version,
+		ObjectType: &longhorn.DRGroup{},
+		OperationTypes: []admissionregv1.OperationType{
+			admissionregv1.Create,
+			admissionregv1.Update,
+		},
+	}
+}
+
+func (v *drGroupValidator) Create(request *admission.Request, newObj runtime.Object) error {
+	drGroup, ok := newObj.(*longhorn.DRGroup)
+	if !ok {
+		return werror.NewInvalidError(fmt.Sprintf("%v is not a *longhorn.DRGroup", newObj), "")
+	}
+
+	if drGroup.Spec.Role != longhorn.DRGroupRolePrimary && drGroup.Spec.Role != longhorn.DRGroupRoleSecondary {
+		return werror.NewInvalidError(fmt.Sprintf("invalid role %v", drGroup.Spec.Role), "spec.role")
+	}
+	if len(drGroup.Spec.VolumeSelector) == 0 {
+		return werror.NewInvalidError("spec.volumeSelector is required", "spec.volumeSelector")
+	}
+	if drGroup.Spec.NumberOfReplicas < 0 {
+		return werror.NewInvalidError(fmt.Sprintf("invalid number of replicas %v", drGroup.Spec.NumberOfReplicas), "spec.numberOfReplicas")
+	}
+
+	if _, err := v.ds.GetBackupTargetRO(drGroup.Spec.BackupTargetName); err != nil {
+		if datastore.ErrorIsNotFound(err) {
+			return werror.NewInvalidError(fmt.Sprintf("backup target %v not found", drGroup.Spec.BackupTargetName), "spec.backupTargetName")
+		}
+		return werror.NewInternalError(fmt.Sprintf("failed to get backup target %v: %v", drGroup.Spec.BackupTargetName, err))
+	}
+
+	return nil
+}
+
+func (v *drGroupValidator) Update(request *admission.Request, oldObj runtime.Object, newObj runtime.Object) error {
+	oldDRGroup, ok := oldObj.(*longhorn.DRGroup)
+	if !ok {
+		return werror.NewInvalidError(fmt.Sprintf("%v is not a *longhorn.DRGroup", oldObj), "")
+	}
+	newDRGroup, ok := newObj.(*longhorn.DRGroup)
+	if !ok {
+		return werror.NewInvalidError(fmt.Sprintf("%v is not a *longhorn.DRGroup", newObj), "")
+	}
+
+	if newDRGroup.Spec.BackupTargetName != oldDRGroup.Spec.BackupTargetName {
+		return werror.NewInvalidError("spec.backupTargetName field is immutable", "spec.backupTargetName")
+	}
+	if !reflect.DeepEqual(newDRGroup.Spec.VolumeSelector, oldDRGroup.Spec.VolumeSelector) && len(newDRGroup.Spec.VolumeSelector) == 0 {
+		return werror.NewInvalidError("spec.volumeSelector is required", "spec.volumeSelector")
+	}
+	if newDRGroup.Spec.NumberOfReplicas < 0 {
+		return werror.NewInvalidError(fmt.Sprintf("invalid number of replicas %v", newDRGroup.Spec.NumberOfReplicas), "spec.numberOfReplicas")
+	}
+
+	if newDRGroup.Spec.Role != oldDRGroup.Spec.Role {
+		if newDRGroup.Spec.Role != longhorn.DRGroupRolePrimary && newDRGroup.Spec.Role != longhorn.DRGroupRoleSecondary {
+			return werror.NewInvalidError(fmt.Sprintf("invalid role %v", newDRGroup.Spec.Role), "spec.role")
+		}
+		// The role cannot be changed again before the cluster takes over the previous role
+		if oldDRGroup.Status.Role != oldDRGroup.Spec.Role {
+			return werror.NewInvalidError(fmt.Sprintf("DR group %v is changing the role from %v to %v", oldDRGroup.Name, oldDRGroup.Status.Role, oldDRGroup.Spec.Role), "spec.role")
+		}
+	}
+
+	return nil
+}
@@ -149,6 +149,18 @@ func (v *volumeMutator) Create(request *admission.Request, newObj runtime.Object
 		if err != nil {
 			return nil, werror.NewInvalidError(fmt.Sprintf("cannot get backup %s: %v", bName, err), "")
 		}
+		// Restore from a replicated copy of the backup if the backup target of the backup is unreachable
+		reachableBackup, err := v.ds.GetReachableBackupRO(backup)
+		if err != nil {
+			return nil, werror.NewInvalidError(fmt.Sprintf("cannot find reachable copy of backup %s: %v", bName, err), "")
+		}
+		if reachableBackup != backup {
+			logrus.Infof("Restoring volume %v from backup %v on backup target %v since backup target %v of backup %v is unavailable",
+				name, reachableBackup.Name, reachableBackup.Status.BackupTargetName, backup.Status.BackupTargetName, bName)
+			backup = reachableBackup
+			volume.Spec.FromBackup = backup.Status.URL
+			patchOps = append(patchOps, fmt.Sprintf(`{"op": "replace", "path": "/spec/fromBackup", "value": "%s"}`, backup.Status.URL))
+		}
 		backupTargetName = backup.Status.BackupTargetName
 
 		bv, err := v.ds.GetBackupVolumeByBackupTargetAndVolumeRO(backupTargetName, canonicalBVName)
@@ -348,9 +360,23 @@ func (v *volumeMutator) mutate(newObj runtime.Object, moreLabels map[string]stri
 	if volume.Spec.DiskSelector == nil {
 		patchOps = append(patchOps, `{"op": "replace", "path": "/spec/diskSelector", "value": []}`)
 	}
+	if volume.Spec.DiskTiers == nil {
+		patchOps = append(patchOps, `{"op": "replace", "path": "/spec/diskTiers", "value": []}`)
+	}
 	if volume.Spec.NodeSelector == nil {
 		patchOps = append(patchOps, `{"op": "replace", "path": "/spec/nodeSelector", "value": []}`)
 	}
+	if volume.Spec.ReplicaTopologySpread == nil {
+		patchOps = append(patchOps, `{"op": "replace", "path": "/spec/replicaTopologySpread", "value": []}`)
+	}
+	for i, constraint := range volume.Spec.ReplicaTopologySpread {
+		if constraint.Mode == "" {
+			patchOps = append(patchOps, fmt.Sprintf(`{"op": "add", "path": "/spec/replicaTopologySpread/%d/mode", "value": "%s"}`, i, longhorn.ReplicaTopologySpreadModeSoft))
+		}
+		if constraint.MaxSkew == 0 {
+			patchOps = append(patchOps, fmt.Sprintf(`{"op": "add", "path": "/spec/replicaTopologySpread/%d/maxSkew", "value": 1}`, i))
+		}
+	}
 	if string(volume.Spec.SnapshotDataIntegrity) == "" {
 		patchOps = append(patchOps, fmt.Sprintf(`{"op": "replace", "path": "/spec/snapshotDataIntegrity", "value": "%s"}`, longhorn.SnapshotDataIntegrityIgnored))
 	}
@@ -374,6 +400,9 @@ func (v *volumeMutator) mutate(newObj runtime.Object, moreLabels map[string]stri
 	if string(volume.Spec.ReplicaDiskSoftAntiAffinity) == "" {
 		patchOps = append(patchOps, fmt.Sprintf(`{"op": "replace", "path": "/spec/replicaDiskSoftAntiAffinity", "value": "%s"}`, longhorn.ReplicaDiskSoftAntiAffinityDefault))
 	}
+	if string(volume.Spec.ReplicaSchedulingPolicy) == "" {
+		patchOps = append(patchOps, fmt.Sprintf(`{"op": "replace", "path": "/spec/replicaSchedulingPolicy", "value": "%s"}`, longhorn.ReplicaSchedulingPolicyDefault))
+	}
 	if string(volume.Spec.DataEngine) == "" {
 		patchOps = append(patchOps, fmt.Sprintf(`{"op": "replace", "path": "/spec/dataEngine", "value": "%s"}`, longhorn.DataEngineTypeV1))
 	}
@@ -109,6 +109,10 @@ func (v *volumeValidator) Create(request *admission.Request, newObj runtime.Obje
 		return werror.NewInvalidError(err.Error(), "spec.replicaDiskSoftAntiAffinity")
 	}
 
+	if err := types.ValidateReplicaSchedulingPolicy(volume.Spec.ReplicaSchedulingPolicy); err != nil {
+		return werror.NewInvalidError(err.Error(), "spec.replicaSchedulingPolicy")
+	}
+
 	if err := types.ValidateOfflineRebuild(volume.Spec.OfflineRebuilding); err != nil {
 		return werror.NewInvalidError(err.Error(), "spec.offlineRebuilding")
 	}
@@ -121,6 +125,18 @@ func (v *volumeValidator) Create(request *admission.Request, newObj runtime.Obje
 		return werror.NewInvalidError(err.Error(), "spec.replicaRebuildingBandwidthLimit")
 	}
 
+	if err := types.ValidateNFSMountOptions(volume.Spec.NFSMountOptions); err != nil {
+		return werror.NewInvalidError(err.Error(), "spec.nfsMountOptions")
+	}
+
+	if err := types.ValidateDiskTiers(volume.Spec.DiskTiers); err != nil {
+		return werror.NewInvalidError(err.Error(), "spec.diskTiers")
+	}
+
+	if err := types.ValidateReplicaTopologySpread(volume.Spec.ReplicaTopologySpread); err != nil {
+		return werror.NewInvalidError(err.Error(), "spec.replicaTopologySpread")
+	}
+
 	if volume.Spec.BackingImage != "" {
 		backingImage, err := v.ds.GetBackingImage(volume.Spec.BackingImage)
 		if err != nil {
@@ -265,6 +281,10 @@ func (v *volumeValidator) Update(request *admission.Request, oldObj runtime.Obje
 		return werror.NewInvalidError(err.Error(), "spec.replicaDiskSoftAntiAffinity")
 	}
 
+	if err := types.ValidateReplicaSchedulingPolicy(newVolume.Spec.ReplicaSchedulingPolicy); err != nil {
+		return werror.NewInvalidError(err.Error(), "spec.replicaSchedulingPolicy")
+	}
+
 	if err := types.ValidateOfflineRebuild(newVolume.Spec.OfflineRebuilding); err != nil {
 		return werror.NewInvalidError(err.Error(), "spec.offlineRebuilding")
 	}
@@ -322,6 +342,18 @@ func (v *volumeValidator) Update(request *admission.Request, oldObj runtime.Obje
 		return werror.NewInvalidError(err.Error(), "spec.replicaRebuildingBandwidthLimit")
 	}
 
+	if err := types.ValidateNFSMountOptions(newVolume.Spec.NFSMountOptions); err != nil {
+		return werror.NewInvalidError(err.Error(), "spec.nfsMountOptions")
+	}
+
+	if err := types.ValidateDiskTiers(newVolume.Spec.DiskTiers); err != nil {
+		return werror.NewInvalidError(err.Error(), "spec.diskTiers")
+	}
+
+	if err := types.ValidateReplicaTopologySpread(newVolume.Spec.ReplicaTopologySpread); err != nil {
+		return werror.NewInvalidError(err.Error(), "spec.replicaTopologySpread")
+	}
+
 	if oldVolume.Spec.DataEngine != "" {
 		if oldVolume.Spec.DataEngine != newVolume.Spec.DataEngine {
 			err := fmt.Errorf("changing data engine for volume %v is not supported", oldVolume.Name)
@@ -375,6 +407,10 @@ func (v *volumeValidator) Update(request *admission.Request, oldObj runtime.Obje
 		return werror.NewInvalidError(err.Error(), "spec.snapshotHashingRequestedAt")
 	}
 
+	if err := validateSnapshotChecksumCheckRequestTime(oldVolume, newVolume); err != nil {
+		return werror.NewInvalidError(err.Error(), "spec.snapshotChecksumCheckRequestedAt")
+	}
+
 	return nil
 }
 
@@ -713,3 +749,21 @@ func validateSnapshotHashingRequestTime(oldVolume *longhorn.Volume, newVolume *l
 
 	return nil
 }
+
+func validateSnapshotChecksumCheckRequestTime(oldVolume *longhorn.Volume, newVolume *longhorn.Volume) error {
+	newReq := newVolume.Spec.SnapshotChecksumCheckRequestedAt
+	if newReq == oldVolume.Spec.SnapshotChecksumCheckRequestedAt || newReq == "" {
+		return nil
+	}
+
+	if _, err := util.ParseTime(newReq); err != nil {
+		return errors.Wrapf(err, "invalid snapshot checksum check request time %v", newReq)
+	}
+
+	// Reject a new request while the previous one is still in progress.
+	if oldVolume.Spec.SnapshotChecksumCheckRequestedAt != "" && oldVolume.Spec.SnapshotChecksumCheckRequestedAt != oldVolume.Status.LastSnapshotChecksumCheckCompleteAt {
+		return fmt.Errorf("previous snapshot checksum check request is still in progress")
+	}
+
+	return nil
+}
@@ -0,0 +1,97 @@
+package volumegroupsnapshot
+
+import (
+	"fmt"
+	"reflect"
+
+	admissionregv1 "k8s.io/api/admissionregistration/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+
+	"github.com/longhorn/longhorn-manager/datastore"
+	"github.com/longhorn/longhorn-manager/util"
+	"github.com/longhorn/longhorn-manager/webhook/admission"
+
+	longhorn "github.com/longhorn/longhorn-manager/k8s/pkg/apis/longhorn/v1beta2"
+	werror "github.com/longhorn/longhorn-manager/webhook/error"
+)
+
+type volumeGroupSnapshotValidator struct {
+	admission.DefaultValidator
+	ds *datastore.DataStore
+}
+
+func NewValidator(ds *datastore.DataStore) admission.Validator {
+	return &volumeGroupSnapshotValidator{ds: ds}
+}
+
+func (v *volumeGroupSnapshotValidator) Resource() admission.Resource {
+	return admission.Resource{
+		Name:       "volumegroupsnapshots",
+		Scope:      admissionregv1.NamespacedScope,
+		APIGroup:   longhorn.SchemeGroupVersion.Group,
+		APIVersion: longhorn.SchemeGroupVersion.Version,
+		ObjectType: &longhorn.VolumeGroupSnapshot{},
+		OperationTypes: []admissionregv1.OperationType{
+			admissionregv1.Create,
+			admissionregv1.Update,
+		},
+	}
+}
+
+func (v *volumeGroupSnapshotValidator) Create(request *admission.Request, newObj runtime.Object) error {
+	groupSnapshot, ok := newObj.(*longhorn.VolumeGroupSnapshot)
+	if !ok {
+		return werror.NewInvalidError(fmt.Sprintf("%v is not a *longhorn.VolumeGroupSnapshot", newObj), "")
+	}
+
+	if len(groupSnapshot.Spec.Volumes) == 0 {
+		return werror.NewInvalidError("spec.volumes is required", "spec.volumes")
+	}
+
+	if err := util.VerifySnapshotLabels(groupSnapshot.Spec.Labels); err != nil {
+		return werror.NewInvalidError(err.Error(), "spec.labels")
+	}
+
+	volumeNames := map[string]struct{}{}
+	for _, volumeName := range groupSnapshot.Spec.Volumes {
+		if _, ok := volumeNames[volumeName]; ok {
+			return werror.NewInvalidError(fmt.Sprintf("duplicate volume %v in spec.volumes", volumeName), "spec.volumes")
+		}
+		volumeNames[volumeName] = struct{}{}
+
+		volume, err := v.ds.GetVolumeRO(volumeName)
+		if err != nil {
+			if datastore.ErrorIsNotFound(err) {
+				return werror.NewInvalidError(fmt.Sprintf("volume %v not found", volumeName), "spec.volumes")
+			}
+			return werror.NewInternalError(fmt.Sprintf("failed to get volume %v: %v", volumeName, err))
+		}
+		if !volume.DeletionTimestamp.IsZero() {
+			return werror.NewInvalidError(fmt.Sprintf("volume %v is being deleted", volumeName), "spec.volumes")
+		}
+		if isLinkedClone, err := v.ds.IsVolumeLinkedCloneVolume(volumeName); err != nil {
+			return werror.NewInvalidError(fmt.Sprintf("failed to check IsVolumeLinkedCloneVolume: %v", err), "")
+		} else if isLinkedClone {
+			return werror.NewInvalidError(fmt.Sprintf("snapshot is not allowed for linked-clone volume %v", volumeName), "spec.volumes")
+		}
+	}
+
+	return nil
+}
+
+func (v *volumeGroupSnapshotValidator) Update(request *admission.Request, oldObj runtime.Object, newObj runtime.Object) error {
+	oldGroupSnapshot, ok := oldObj.(*longhorn.VolumeGroupSnapshot)
+	if !ok {
+		return werror.NewInvalidError(fmt.Sprintf("%v is not a *longhorn.VolumeGroupSnapshot", oldObj), "")
+	}
+	newGroupSnapshot, ok := newObj.(*longhorn.VolumeGroupSnapshot)
+	if !ok {
+		return werror.NewInvalidError(fmt.Sprintf("%v is not a *longhorn.VolumeGroupSnapshot", newObj), "")
+	}
+
+	if !reflect.DeepEqual(newGroupSnapshot.Spec, oldGroupSnapshot.Spec) {
+		return werror.NewInvalidError("spec field is immutable", "spec")
+	}
+
+	return nil
+}
@@ -7,13 +7,14 @@ import (
 	"github.com/rancher/wrangler/v3/pkg/webhook"
 	"github.com/sirupsen/logrus"
 
+	"github.com/longhorn/longhorn-manager/audit"
 	"github.com/longhorn/longhorn-manager/webhook/admission"
 )
 
-func addHandler(router *webhook.Router, admissionType string, admitter admission.Admitter) {
+func addHandler(router *webhook.Router, admissionType string, admitter admission.Admitter, auditLogger *audit.Logger) {
 	rsc := admitter.Resource()
 	kind := reflect.Indirect(reflect.ValueOf(rsc.ObjectType)).Type().Name()
-	router.Kind(kind).Group(rsc.APIGroup).Type(rsc.ObjectType).Handle(admission.NewHandler(admitter, admissionType))
+	router.Kind(kind).Group(rsc.APIGroup).Type(rsc.ObjectType).Handle(admission.NewHandler(admitter, admissionType, auditLogger))
 	logrus.Infof("Add %s handler for %s.%s (%s)", admissionType, rsc.Name, rsc.APIGroup, kind)
 }
 
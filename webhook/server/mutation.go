@@ -14,6 +14,7 @@ import (
 	"github.com/longhorn/longhorn-manager/webhook/resources/backupbackingimage"
 	"github.com/longhorn/longhorn-manager/webhook/resources/backuptarget"
 	"github.com/longhorn/longhorn-manager/webhook/resources/backupvolume"
+	"github.com/longhorn/longhorn-manager/webhook/resources/drgroup"
 	"github.com/longhorn/longhorn-manager/webhook/resources/engine"
 	"github.com/longhorn/longhorn-manager/webhook/resources/engineimage"
 	"github.com/longhorn/longhorn-manager/webhook/resources/instancemanager"
@@ -28,6 +29,7 @@ import (
 	"github.com/longhorn/longhorn-manager/webhook/resources/systembackup"
 	"github.com/longhorn/longhorn-manager/webhook/resources/volume"
 	"github.com/longhorn/longhorn-manager/webhook/resources/volumeattachment"
+	"github.com/longhorn/longhorn-manager/webhook/resources/volumegroupsnapshot"
 )
 
 func Mutation(ds *datastore.DataStore) (http.Handler, []admission.Resource, error) {
@@ -51,6 +53,8 @@ func Mutation(ds *datastore.DataStore) (http.Handler, []admission.Resource, erro
 		supportbundle.NewMutator(ds),
 		systembackup.NewMutator(ds),
 		volumeattachment.NewMutator(ds),
+		volumegroupsnapshot.NewMutator(ds),
+		drgroup.NewMutator(ds),
 		instancemanager.NewMutator(ds),
 		backupbackingimage.NewMutator(ds),
 		setting.NewMutator(ds),
@@ -58,7 +62,7 @@ func Mutation(ds *datastore.DataStore) (http.Handler, []admission.Resource, erro
 
 	router := webhook.NewRouter()
 	for _, m := range mutators {
-		addHandler(router, admission.AdmissionTypeMutation, m)
+		addHandler(router, admission.AdmissionTypeMutation, m, nil)
 		resources = append(resources, m.Resource())
 	}
 
@@ -5,6 +5,7 @@ import (
 
 	"github.com/rancher/wrangler/v3/pkg/webhook"
 
+	"github.com/longhorn/longhorn-manager/audit"
 	"github.com/longhorn/longhorn-manager/datastore"
 	"github.com/longhorn/longhorn-manager/types"
 	"github.com/longhorn/longhorn-manager/util"
@@ -14,6 +15,7 @@ import (
 	"github.com/longhorn/longhorn-manager/webhook/resources/backupbackingimage"
 	"github.com/longhorn/longhorn-manager/webhook/resources/backuptarget"
 	"github.com/longhorn/longhorn-manager/webhook/resources/backupvolume"
+	"github.com/longhorn/longhorn-manager/webhook/resources/drgroup"
 	"github.com/longhorn/longhorn-manager/webhook/resources/engine"
 	"github.com/longhorn/longhorn-manager/webhook/resources/engineimage"
 	"github.com/longhorn/longhorn-manager/webhook/resources/instancemanager"
@@ -29,6 +31,7 @@ import (
 	"github.com/longhorn/longhorn-manager/webhook/resources/systemrestore"
 	"github.com/longhorn/longhorn-manager/webhook/resources/volume"
 	"github.com/longhorn/longhorn-manager/webhook/resources/volumeattachment"
+	"github.com/longhorn/longhorn-manager/webhook/resources/volumegroupsnapshot"
 )
 
 func Validation(ds *datastore.DataStore) (http.Handler, []admission.Resource, error) {
@@ -54,6 +57,8 @@ func Validation(ds *datastore.DataStore) (http.Handler, []admission.Resource, er
 		systembackup.NewValidator(ds),
 		systemrestore.NewValidator(ds),
 		volumeattachment.NewValidator(ds),
+		volumegroupsnapshot.NewValidator(ds),
+		drgroup.NewValidator(ds),
 		engine.NewValidator(ds),
 		replica.NewValidator(ds),
 		instancemanager.NewValidator(ds),
@@ -61,9 +66,12 @@ func Validation(ds *datastore.DataStore) (http.Handler, []admission.Resource, er
 		engineimage.NewValidator(ds),
 	}
 
+	// The validation is the last admission of a change, so the admitted and rejected changes are recorded here
+	auditLogger := audit.NewLogger(ds, currentNodeID)
+
 	router := webhook.NewRouter()
 	for _, v := range validators {
-		addHandler(router, admission.AdmissionTypeValidation, admission.NewValidatorAdapter(v))
+		addHandler(router, admission.AdmissionTypeValidation, admission.NewValidatorAdapter(v), auditLogger)
 		resources = append(resources, v.Resource())
 	}
 